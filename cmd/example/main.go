@@ -62,7 +62,7 @@ func demonstrateSliceComparison() {
 	result := sliceutil.CompareSlicesWithResult(a, c)
 	fmt.Printf("   Detailed comparison result: %s\n", result.Message)
 	if !result.Equal {
-		fmt.Printf("   Difference count: %d\n", result.Details["difference_count"])
+		fmt.Printf("   Difference count: %d\n", result.Details.DifferenceCount)
 	}
 
 	fmt.Println()
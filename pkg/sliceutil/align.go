@@ -0,0 +1,56 @@
+package sliceutil
+
+// AlignByKey matches the elements of two slices that share the same key.
+// Matched elements are returned as pairs in the order they appear in slice A,
+// while elements without a counterpart are returned separately in onlyA and onlyB,
+// each preserving the order of its source slice.
+//
+// When a key occurs several times, occurrences are matched positionally: the
+// first occurrence in A is paired with the first occurrence in B, and so on.
+// Surplus occurrences end up in onlyA or onlyB.
+//
+// Time complexity: O(n + m) where n and m are the lengths of the slices
+// Space complexity: O(n + m) for the lookup index and results
+//
+// Example:
+//
+//	a := []User{{ID: 1}, {ID: 2}}
+//	b := []User{{ID: 2}, {ID: 3}}
+//	pairs, onlyA, onlyB := AlignByKey(a, b, func(u User) int { return u.ID })
+//	// pairs: [{First: {ID: 2}, Second: {ID: 2}}], onlyA: [{ID: 1}], onlyB: [{ID: 3}]
+func AlignByKey[T any, K comparable](a, b []T, keyFn func(T) K) (pairs []Pair[T, T], onlyA, onlyB []T) {
+	pairs = make([]Pair[T, T], 0)
+	onlyA = make([]T, 0)
+	onlyB = make([]T, 0)
+
+	// Index the positions of every key in slice b
+	positions := make(map[K][]int, len(b))
+	for i, v := range b {
+		k := keyFn(v)
+		positions[k] = append(positions[k], i)
+	}
+
+	matched := make([]bool, len(b))
+	for _, v := range a {
+		k := keyFn(v)
+		queue := positions[k]
+		if len(queue) == 0 {
+			onlyA = append(onlyA, v)
+			continue
+		}
+
+		// Consume the earliest unmatched occurrence in slice b
+		j := queue[0]
+		positions[k] = queue[1:]
+		matched[j] = true
+		pairs = append(pairs, Pair[T, T]{First: v, Second: b[j]})
+	}
+
+	for i, v := range b {
+		if !matched[i] {
+			onlyB = append(onlyB, v)
+		}
+	}
+
+	return pairs, onlyA, onlyB
+}
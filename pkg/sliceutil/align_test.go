@@ -0,0 +1,51 @@
+package sliceutil
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestAlignByKey tests the AlignByKey function
+func TestAlignByKey(t *testing.T) {
+	type record struct {
+		ID    int
+		Value string
+	}
+	key := func(r record) int { return r.ID }
+
+	t.Run("Matched And Unmatched Elements", func(t *testing.T) {
+		a := []record{{1, "a1"}, {2, "a2"}, {3, "a3"}}
+		b := []record{{4, "b4"}, {2, "b2"}, {1, "b1"}}
+
+		pairs, onlyA, onlyB := AlignByKey(a, b, key)
+
+		assert.Equal(t, []Pair[record, record]{
+			{First: record{1, "a1"}, Second: record{1, "b1"}},
+			{First: record{2, "a2"}, Second: record{2, "b2"}},
+		}, pairs)
+		assert.Equal(t, []record{{3, "a3"}}, onlyA)
+		assert.Equal(t, []record{{4, "b4"}}, onlyB)
+	})
+
+	t.Run("Duplicate Keys Are Matched Positionally", func(t *testing.T) {
+		a := []record{{1, "a1"}, {1, "a1'"}, {1, "a1''"}}
+		b := []record{{1, "b1"}, {1, "b1'"}}
+
+		pairs, onlyA, onlyB := AlignByKey(a, b, key)
+
+		assert.Len(t, pairs, 2)
+		assert.Equal(t, "b1", pairs[0].Second.Value)
+		assert.Equal(t, "b1'", pairs[1].Second.Value)
+		assert.Equal(t, []record{{1, "a1''"}}, onlyA)
+		assert.Empty(t, onlyB)
+	})
+
+	t.Run("Nil Slices", func(t *testing.T) {
+		pairs, onlyA, onlyB := AlignByKey(nil, []record{{1, "b1"}}, key)
+
+		assert.Empty(t, pairs)
+		assert.Empty(t, onlyA)
+		assert.Equal(t, []record{{1, "b1"}}, onlyB)
+	})
+}
@@ -0,0 +1,140 @@
+package sliceutil
+
+import "math"
+
+// AddSlices returns the element-wise sum of two numeric slices of equal length.
+// Each result element is computed in the element type, so it may overflow for small
+// integer types. If both slices are nil, the result is nil.
+// The function returns an error wrapping ErrLengthMismatch if the lengths differ.
+//
+// Time complexity: O(n) where n is the length of the slices
+// Space complexity: O(n) for the result slice
+//
+// Example:
+//
+//	sums, err := AddSlices([]int{1, 2, 3}, []int{10, 20, 30})
+//	// returns []int{11, 22, 33}, nil
+func AddSlices[T Number](a, b []T) ([]T, error) {
+	return ZipWith(a, b, func(x, y T) T { return x + y }, LengthError)
+}
+
+// SubtractSlices returns the element-wise difference a[i] - b[i] of two numeric slices
+// of equal length, following the same rules as AddSlices.
+//
+// Example:
+//
+//	deltas, err := SubtractSlices([]float64{1.5, 4}, []float64{0.5, 1})
+//	// returns []float64{1, 3}, nil
+func SubtractSlices[T Number](a, b []T) ([]T, error) {
+	return ZipWith(a, b, func(x, y T) T { return x - y }, LengthError)
+}
+
+// MultiplySlices returns the element-wise (Hadamard) product of two numeric slices of
+// equal length, following the same rules as AddSlices.
+//
+// Example:
+//
+//	totals, err := MultiplySlices(prices, quantities)
+func MultiplySlices[T Number](a, b []T) ([]T, error) {
+	return ZipWith(a, b, func(x, y T) T { return x * y }, LengthError)
+}
+
+// DotProduct returns the sum of the products of paired elements of two numeric slices
+// of equal length. The sum is accumulated in the element type, so it may overflow for
+// small integer types. Empty or nil slices have a dot product of zero.
+// The function returns an error wrapping ErrLengthMismatch if the lengths differ.
+//
+// Time complexity: O(n) where n is the length of the slices
+// Space complexity: O(1)
+//
+// Example:
+//
+//	dot, err := DotProduct([]int{1, 2, 3}, []int{4, 5, 6}) // returns 32, nil
+func DotProduct[T Number](a, b []T) (T, error) {
+	var sum T
+	if _, err := zipLength(len(a), len(b), LengthError); err != nil {
+		return sum, err
+	}
+
+	for i := range a {
+		sum += a[i] * b[i]
+	}
+	return sum, nil
+}
+
+// Scale returns a new slice with every element multiplied by factor.
+// If the slice is nil, the result is nil.
+//
+// Example:
+//
+//	cents := Scale([]int{1, 5, 20}, 100) // returns []int{100, 500, 2000}
+func Scale[T Number](s []T, factor T) []T {
+	if s == nil {
+		return nil
+	}
+
+	result := make([]T, len(s))
+	for i, v := range s {
+		result[i] = v * factor
+	}
+	return result
+}
+
+// Normalize rescales a numeric slice linearly to [0, 1] (min-max normalization), so the
+// smallest element maps to 0 and the largest to 1. If all elements are equal, every
+// element maps to 0. The original slice is not modified.
+// The function returns an error if the slice is empty or nil.
+//
+// Time complexity: O(n) where n is the length of the slice
+// Space complexity: O(n) for the result slice
+//
+// Example:
+//
+//	scores, err := Normalize([]int{10, 15, 30}) // returns []float64{0, 0.25, 1}, nil
+func Normalize[T Number](s []T) ([]float64, error) {
+	lo, err := Min(s)
+	if err != nil {
+		return nil, err
+	}
+	hi, _ := Max(s)
+
+	result := make([]float64, len(s))
+	valueRange := float64(hi) - float64(lo)
+	if valueRange == 0 {
+		return result, nil
+	}
+	for i, v := range s {
+		result[i] = (float64(v) - float64(lo)) / valueRange
+	}
+	return result, nil
+}
+
+// Standardize converts a numeric slice to z-scores, i.e. the number of population
+// standard deviations each element lies from the mean, so the result has mean 0 and
+// standard deviation 1. The mean is computed once and shared with the variance. If all
+// elements are equal, every element maps to 0. The original slice is not modified.
+// The function returns an error if the slice is empty or nil.
+//
+// Time complexity: O(n) where n is the length of the slice
+// Space complexity: O(n) for the result slice
+//
+// Example:
+//
+//	z, err := Standardize([]int{2, 4, 4, 4, 5, 5, 7, 9})
+//	// returns []float64{-1.5, -0.5, -0.5, -0.5, 0, 0, 1, 2}, nil
+func Standardize[T Number](s []T) ([]float64, error) {
+	mean, variance, err := meanAndVariance(s)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]float64, len(s))
+	stddev := math.Sqrt(variance)
+	if stddev == 0 {
+		return result, nil
+	}
+	for i, v := range s {
+		result[i] = (float64(v) - mean) / stddev
+	}
+	return result, nil
+}
@@ -0,0 +1,158 @@
+package sliceutil
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestAddSubtractMultiplySlices tests the element-wise arithmetic functions
+func TestAddSubtractMultiplySlices(t *testing.T) {
+	t.Run("AddSlices", func(t *testing.T) {
+		result, err := AddSlices([]int{1, 2, 3}, []int{10, 20, 30})
+		require.NoError(t, err)
+		assert.Equal(t, []int{11, 22, 33}, result)
+	})
+
+	t.Run("SubtractSlices", func(t *testing.T) {
+		result, err := SubtractSlices([]float64{1.5, 4}, []float64{0.5, 1})
+		require.NoError(t, err)
+		assert.Equal(t, []float64{1, 3}, result)
+	})
+
+	t.Run("MultiplySlices", func(t *testing.T) {
+		result, err := MultiplySlices([]uint{2, 3}, []uint{4, 5})
+		require.NoError(t, err)
+		assert.Equal(t, []uint{8, 15}, result)
+	})
+
+	t.Run("Does Not Modify Inputs", func(t *testing.T) {
+		a, b := []int{1, 2}, []int{3, 4}
+		_, err := AddSlices(a, b)
+		require.NoError(t, err)
+		assert.Equal(t, []int{1, 2}, a)
+		assert.Equal(t, []int{3, 4}, b)
+	})
+
+	t.Run("Length Mismatch", func(t *testing.T) {
+		_, err := AddSlices([]int{1, 2}, []int{1})
+		assert.ErrorIs(t, err, ErrLengthMismatch)
+		_, err = SubtractSlices([]int{1}, nil)
+		assert.ErrorIs(t, err, ErrLengthMismatch)
+		_, err = MultiplySlices([]int{}, []int{1})
+		assert.ErrorIs(t, err, ErrLengthMismatch)
+	})
+
+	t.Run("Nil and Empty", func(t *testing.T) {
+		result, err := AddSlices[int](nil, nil)
+		require.NoError(t, err)
+		assert.Nil(t, result)
+
+		result, err = AddSlices([]int{}, []int{})
+		require.NoError(t, err)
+		assert.Equal(t, []int{}, result)
+	})
+}
+
+// TestDotProduct tests the DotProduct function
+func TestDotProduct(t *testing.T) {
+	t.Run("Integers And Floats", func(t *testing.T) {
+		dot, err := DotProduct([]int{1, 2, 3}, []int{4, 5, 6})
+		require.NoError(t, err)
+		assert.Equal(t, 32, dot)
+
+		fdot, err := DotProduct([]float64{0.5, -1}, []float64{2, 3})
+		require.NoError(t, err)
+		assert.Equal(t, -2.0, fdot)
+	})
+
+	t.Run("Orthogonal Vectors", func(t *testing.T) {
+		dot, err := DotProduct([]int{1, 0}, []int{0, 1})
+		require.NoError(t, err)
+		assert.Equal(t, 0, dot)
+	})
+
+	t.Run("Length Mismatch", func(t *testing.T) {
+		_, err := DotProduct([]int{1, 2}, []int{1})
+		assert.ErrorIs(t, err, ErrLengthMismatch)
+	})
+
+	t.Run("Nil and Empty", func(t *testing.T) {
+		dot, err := DotProduct[int](nil, []int{})
+		require.NoError(t, err)
+		assert.Equal(t, 0, dot)
+	})
+}
+
+// TestScale tests the Scale function
+func TestScale(t *testing.T) {
+	t.Run("Integers And Floats", func(t *testing.T) {
+		assert.Equal(t, []int{100, 500, 2000}, Scale([]int{1, 5, 20}, 100))
+		assert.Equal(t, []float64{-0.5, 1}, Scale([]float64{1, -2}, -0.5))
+	})
+
+	t.Run("Does Not Modify Input", func(t *testing.T) {
+		s := []int{1, 2}
+		Scale(s, 3)
+		assert.Equal(t, []int{1, 2}, s)
+	})
+
+	t.Run("Nil and Empty", func(t *testing.T) {
+		assert.Nil(t, Scale[int](nil, 2))
+		assert.Equal(t, []int{}, Scale([]int{}, 2))
+	})
+}
+
+// TestNormalize tests the Normalize function
+func TestNormalize(t *testing.T) {
+	t.Run("Min Max To Unit Range", func(t *testing.T) {
+		result, err := Normalize([]int{10, 15, 30})
+		require.NoError(t, err)
+		assert.Equal(t, []float64{0, 0.25, 1}, result)
+
+		result, err = Normalize([]float64{-1, 1, 0})
+		require.NoError(t, err)
+		assert.Equal(t, []float64{0, 1, 0.5}, result)
+	})
+
+	t.Run("Constant Slice", func(t *testing.T) {
+		result, err := Normalize([]int{7, 7})
+		require.NoError(t, err)
+		assert.Equal(t, []float64{0, 0}, result)
+	})
+
+	t.Run("Nil and Empty", func(t *testing.T) {
+		_, err := Normalize[float64](nil)
+		assert.ErrorIs(t, err, ErrNilSlice)
+		_, err = Normalize([]float64{})
+		assert.ErrorIs(t, err, ErrEmptySlice)
+	})
+}
+
+// TestStandardize tests the Standardize function
+func TestStandardize(t *testing.T) {
+	t.Run("Z Scores", func(t *testing.T) {
+		result, err := Standardize([]int{2, 4, 4, 4, 5, 5, 7, 9})
+		require.NoError(t, err)
+		assert.Equal(t, []float64{-1.5, -0.5, -0.5, -0.5, 0, 0, 1, 2}, result)
+
+		mean, _ := Average(result)
+		stddev, _ := StdDev(result)
+		assert.InDelta(t, 0, mean, 1e-15)
+		assert.InDelta(t, 1, stddev, 1e-15)
+	})
+
+	t.Run("Constant Slice", func(t *testing.T) {
+		result, err := Standardize([]float64{3, 3, 3})
+		require.NoError(t, err)
+		assert.Equal(t, []float64{0, 0, 0}, result)
+	})
+
+	t.Run("Nil and Empty", func(t *testing.T) {
+		_, err := Standardize[int](nil)
+		assert.ErrorIs(t, err, ErrNilSlice)
+		_, err = Standardize([]int{})
+		assert.ErrorIs(t, err, ErrEmptySlice)
+	})
+}
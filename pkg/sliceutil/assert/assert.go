@@ -0,0 +1,68 @@
+// Package assert provides test assertions for slices and structs built on package
+// sliceutil. Unlike comparing with CompareSlices and checking a bool, a failing
+// assertion reports the rendered difference, so the test log shows which elements or
+// fields differ rather than only that something does.
+package assert
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/devrob-go/sliceutil/pkg/sliceutil"
+)
+
+// AssertSlicesEqual asserts that two slices have the same elements in the same order,
+// treating a nil slice and an empty slice as different. On failure it marks the test
+// as failed with the differing indices and values, and the test continues.
+// It returns whether the assertion succeeded.
+//
+// Example:
+//
+//	assert.AssertSlicesEqual(t, got, []int{1, 2, 3})
+//	// slices are not equal: Slices differ at specific indices
+//	//   [1]: 5 != 2
+func AssertSlicesEqual[T comparable](t testing.TB, a, b []T) bool {
+	t.Helper()
+	return check(t, "slices are not equal", sliceutil.CompareSlicesWithResult(a, b))
+}
+
+// AssertSlicesEqualUnordered asserts that two slices hold the same elements with the
+// same number of occurrences, regardless of order. On failure it marks the test as
+// failed with the elements whose counts differ, and the test continues.
+// It returns whether the assertion succeeded.
+//
+// Example:
+//
+//	assert.AssertSlicesEqualUnordered(t, []string{"b", "a"}, []string{"a", "b", "b"})
+//	// slices do not contain the same elements: Slices have different lengths
+//	//   length: 2 != 3
+func AssertSlicesEqualUnordered[T comparable](t testing.TB, a, b []T) bool {
+	t.Helper()
+	return check(t, "slices do not contain the same elements", sliceutil.CompareSlicesUnorderedWithResult(a, b))
+}
+
+// AssertStructsEqual asserts that two structs are deeply equal under the given
+// comparison options. On failure it marks the test as failed with a table of the
+// differing field paths and their values, and the test continues.
+// It returns whether the assertion succeeded.
+//
+// Example:
+//
+//	assert.AssertStructsEqual(t, got, want, sliceutil.IgnoreFields("UpdatedAt"))
+//	// structs are not equal: Structs differ in specific fields
+//	//   PATH  OLD  NEW
+//	//   Age   30   31
+func AssertStructsEqual(t testing.TB, a, b interface{}, opts ...sliceutil.StructCompareOption) bool {
+	t.Helper()
+	return check(t, "structs are not equal", sliceutil.CompareStructsWithResult(a, b, opts...))
+}
+
+// check is a helper function that reports a failed comparison result on t.
+func check(t testing.TB, summary string, result sliceutil.CompareResult) bool {
+	t.Helper()
+	if result.Equal {
+		return true
+	}
+	t.Errorf("%s: %s", summary, strings.TrimSpace(result.Pretty()))
+	return false
+}
@@ -0,0 +1,119 @@
+package assert
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/devrob-go/sliceutil/pkg/sliceutil"
+)
+
+// recorder is a testing.TB that records failures instead of failing the test.
+type recorder struct {
+	testing.TB
+	failed bool
+	output string
+}
+
+func (r *recorder) Helper() {}
+
+func (r *recorder) Errorf(format string, args ...any) {
+	r.failed = true
+	r.output += fmt.Sprintf(format, args...)
+}
+
+// TestAssertSlicesEqual tests the AssertSlicesEqual function
+func TestAssertSlicesEqual(t *testing.T) {
+	t.Run("Equal", func(t *testing.T) {
+		r := &recorder{TB: t}
+		if !AssertSlicesEqual(r, []int{1, 2, 3}, []int{1, 2, 3}) || r.failed {
+			t.Fatalf("expected success, got %q", r.output)
+		}
+	})
+
+	t.Run("Different Elements", func(t *testing.T) {
+		r := &recorder{TB: t}
+		if AssertSlicesEqual(r, []int{1, 5, 3}, []int{1, 2, 3}) || !r.failed {
+			t.Fatal("expected failure")
+		}
+		want := "slices are not equal: Slices differ at specific indices\n  [1]: 5 != 2"
+		if r.output != want {
+			t.Errorf("got %q, want %q", r.output, want)
+		}
+	})
+
+	t.Run("Nil and Empty", func(t *testing.T) {
+		r := &recorder{TB: t}
+		if AssertSlicesEqual(r, nil, []string{}) {
+			t.Fatal("expected failure")
+		}
+		if !strings.Contains(r.output, "a is nil: true, b is nil: false") {
+			t.Errorf("unexpected output %q", r.output)
+		}
+	})
+}
+
+// TestAssertSlicesEqualUnordered tests the AssertSlicesEqualUnordered function
+func TestAssertSlicesEqualUnordered(t *testing.T) {
+	t.Run("Same Elements", func(t *testing.T) {
+		r := &recorder{TB: t}
+		if !AssertSlicesEqualUnordered(r, []string{"b", "a", "b"}, []string{"b", "b", "a"}) {
+			t.Fatalf("expected success, got %q", r.output)
+		}
+	})
+
+	t.Run("Different Counts", func(t *testing.T) {
+		r := &recorder{TB: t}
+		if AssertSlicesEqualUnordered(r, []string{"a", "a", "b"}, []string{"a", "b", "b"}) {
+			t.Fatal("expected failure")
+		}
+		for _, want := range []string{"slices do not contain the same elements", `"a": +1 occurrences in a`, `"b": -1 occurrences in a`} {
+			if !strings.Contains(r.output, want) {
+				t.Errorf("output %q does not contain %q", r.output, want)
+			}
+		}
+	})
+
+	t.Run("Nil and Empty", func(t *testing.T) {
+		r := &recorder{TB: t}
+		if !AssertSlicesEqualUnordered[int](r, nil, nil) {
+			t.Errorf("unexpected failure %q", r.output)
+		}
+	})
+}
+
+// TestAssertStructsEqual tests the AssertStructsEqual function
+func TestAssertStructsEqual(t *testing.T) {
+	type Person struct {
+		Name string
+		Age  int
+	}
+
+	t.Run("Equal", func(t *testing.T) {
+		r := &recorder{TB: t}
+		if !AssertStructsEqual(r, Person{"Alice", 30}, Person{"Alice", 30}) {
+			t.Fatalf("expected success, got %q", r.output)
+		}
+	})
+
+	t.Run("Different Fields", func(t *testing.T) {
+		r := &recorder{TB: t}
+		if AssertStructsEqual(r, Person{"Alice", 30}, Person{"Bob", 31}) {
+			t.Fatal("expected failure")
+		}
+		want := "structs are not equal: Structs differ in specific fields\n" +
+			"  PATH  OLD      NEW\n" +
+			"  Name  \"Alice\"  \"Bob\"\n" +
+			"  Age   30       31"
+		if r.output != want {
+			t.Errorf("got %q, want %q", r.output, want)
+		}
+	})
+
+	t.Run("With Options", func(t *testing.T) {
+		r := &recorder{TB: t}
+		if !AssertStructsEqual(r, Person{"Alice", 30}, Person{"Alice", 31}, sliceutil.IgnoreFields("Age")) {
+			t.Errorf("expected success, got %q", r.output)
+		}
+	})
+}
@@ -0,0 +1,145 @@
+package sliceutil
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"runtime"
+	"sync"
+)
+
+// ProcessInBatches splits a slice into consecutive batches of batchSize elements and
+// processes them concurrently with a pool of workers, then reassembles the results of
+// every batch in the original order. It replaces the hand-rolled fan-out/fan-in of
+// typical ETL jobs.
+//
+// Each batch is a view of s, capped so that appending to it cannot overwrite the next
+// batch; f must not modify its elements. If workers is not positive, GOMAXPROCS workers
+// are used. If batchSize is not positive, the function returns ErrOutOfRange.
+//
+// Every batch is processed even if another one fails, and the errors of all failed
+// batches are joined, each prefixed with its batch number, so errors.Is works on the
+// result. When ctx is cancelled, no further batches are started and ctx.Err() is
+// included in the returned error. On any error the results are discarded.
+//
+// Example:
+//
+//	ids, err := ProcessInBatches(ctx, records, 500, 8, func(batch []Record) ([]int, error) {
+//		return db.InsertAll(ctx, batch)
+//	})
+func ProcessInBatches[T, U any](ctx context.Context, s []T, batchSize, workers int, f func([]T) ([]U, error)) ([]U, error) {
+	if batchSize <= 0 {
+		return nil, fmt.Errorf("%w: batch size %d", ErrOutOfRange, batchSize)
+	}
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+
+	// Count the partial batch separately so that a huge batch size cannot overflow
+	batchCount := len(s) / batchSize
+	if len(s)%batchSize != 0 {
+		batchCount++
+	}
+	results := make([][]U, batchCount)
+	errs := make([]error, batchCount)
+
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for range min(workers, batchCount) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				start := i * batchSize
+				end := start + min(batchSize, len(s)-start)
+				results[i], errs[i] = f(s[start:end:end])
+			}
+		}()
+	}
+
+	var ctxErr error
+dispatch:
+	for i := range batchCount {
+		if ctxErr = ctx.Err(); ctxErr != nil {
+			break
+		}
+		select {
+		case jobs <- i:
+		case <-ctx.Done():
+			ctxErr = ctx.Err()
+			break dispatch
+		}
+	}
+	close(jobs)
+	wg.Wait()
+
+	if err := joinBatchErrors(ctxErr, errs); err != nil {
+		return nil, err
+	}
+
+	if s == nil {
+		return nil, nil
+	}
+	total := 0
+	for _, r := range results {
+		total += len(r)
+	}
+	merged := make([]U, 0, total)
+	for _, r := range results {
+		merged = append(merged, r...)
+	}
+	return merged, nil
+}
+
+// ForEachBatch processes consecutive batches of a slice concurrently, like
+// ProcessInBatches, for work that produces no results.
+//
+// Example:
+//
+//	err := ForEachBatch(ctx, events, 1000, 4, func(batch []Event) error {
+//		return publisher.Send(ctx, batch)
+//	})
+func ForEachBatch[T any](ctx context.Context, s []T, batchSize, workers int, f func([]T) error) error {
+	_, err := ProcessInBatches(ctx, s, batchSize, workers, func(batch []T) ([]struct{}, error) {
+		return nil, f(batch)
+	})
+	return err
+}
+
+// MapBatch transforms every element of a slice, processing consecutive batches
+// concurrently like ProcessInBatches. Within a batch, elements are transformed in
+// order and the batch stops at its first error. The result keeps the order of s.
+//
+// Example:
+//
+//	users, err := MapBatch(ctx, ids, 100, 8, func(id int) (User, error) {
+//		return client.FetchUser(ctx, id)
+//	})
+func MapBatch[T, U any](ctx context.Context, s []T, batchSize, workers int, f func(T) (U, error)) ([]U, error) {
+	return ProcessInBatches(ctx, s, batchSize, workers, func(batch []T) ([]U, error) {
+		result := make([]U, len(batch))
+		for i, v := range batch {
+			u, err := f(v)
+			if err != nil {
+				return nil, err
+			}
+			result[i] = u
+		}
+		return result, nil
+	})
+}
+
+// joinBatchErrors is a helper function that joins a context error and the errors of
+// failed batches, in batch order, into a single error. It returns nil if there are none.
+func joinBatchErrors(ctxErr error, errs []error) error {
+	var all []error
+	if ctxErr != nil {
+		all = append(all, ctxErr)
+	}
+	for i, err := range errs {
+		if err != nil {
+			all = append(all, fmt.Errorf("batch %d: %w", i, err))
+		}
+	}
+	return errors.Join(all...)
+}
@@ -0,0 +1,157 @@
+package sliceutil
+
+import (
+	"context"
+	"errors"
+	"math"
+	"strconv"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestProcessInBatches tests the ProcessInBatches function
+func TestProcessInBatches(t *testing.T) {
+	double := func(batch []int) ([]int, error) {
+		return Map(batch, func(v int) int { return v * 2 }), nil
+	}
+
+	t.Run("Ordered Results", func(t *testing.T) {
+		result, err := ProcessInBatches(context.Background(), Range(0, 1000), 7, 4, double)
+		require.NoError(t, err)
+		assert.Equal(t, Map(Range(0, 1000), func(v int) int { return v * 2 }), result)
+	})
+
+	t.Run("Batches", func(t *testing.T) {
+		var sizes atomic.Int64
+		var count atomic.Int64
+		_, err := ProcessInBatches(context.Background(), Range(0, 10), 4, 2, func(batch []int) ([]int, error) {
+			sizes.Add(int64(len(batch)))
+			count.Add(1)
+			assert.Equal(t, len(batch), cap(batch))
+			return nil, nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, int64(10), sizes.Load())
+		assert.Equal(t, int64(3), count.Load())
+	})
+
+	t.Run("Huge Batch Size", func(t *testing.T) {
+		for _, size := range []int{math.MaxInt, math.MaxInt - 1} {
+			result, err := ProcessInBatches(context.Background(), []int{1, 2, 3}, size, 2, double)
+			require.NoError(t, err)
+			assert.Equal(t, []int{2, 4, 6}, result)
+		}
+	})
+
+	t.Run("Different Result Type", func(t *testing.T) {
+		result, err := ProcessInBatches(context.Background(), []int{1, 2, 3}, 2, 0, func(batch []int) ([]string, error) {
+			return Map(batch, strconv.Itoa), nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"1", "2", "3"}, result)
+	})
+
+	t.Run("Error Aggregation", func(t *testing.T) {
+		errBad := errors.New("bad batch")
+		var processed atomic.Int64
+		result, err := ProcessInBatches(context.Background(), Range(0, 10), 2, 3, func(batch []int) ([]int, error) {
+			processed.Add(1)
+			if batch[0] == 2 || batch[0] == 6 {
+				return nil, errBad
+			}
+			return batch, nil
+		})
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errBad)
+		assert.Equal(t, "batch 1: bad batch\nbatch 3: bad batch", err.Error())
+		assert.Equal(t, int64(5), processed.Load())
+	})
+
+	t.Run("Cancelled Context", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		var processed atomic.Int64
+		_, err := ProcessInBatches(ctx, Range(0, 10), 1, 2, func(batch []int) ([]int, error) {
+			processed.Add(1)
+			return batch, nil
+		})
+		assert.ErrorIs(t, err, context.Canceled)
+		assert.Equal(t, int64(0), processed.Load())
+	})
+
+	t.Run("Cancelled While Processing", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		var processed atomic.Int64
+		_, err := ProcessInBatches(ctx, Range(0, 100), 1, 1, func(batch []int) ([]int, error) {
+			if processed.Add(1) == 3 {
+				cancel()
+			}
+			return batch, nil
+		})
+		assert.ErrorIs(t, err, context.Canceled)
+		assert.Less(t, processed.Load(), int64(100))
+	})
+
+	t.Run("Invalid Batch Size", func(t *testing.T) {
+		_, err := ProcessInBatches(context.Background(), []int{1}, 0, 1, double)
+		assert.ErrorIs(t, err, ErrOutOfRange)
+	})
+
+	t.Run("Nil and Empty", func(t *testing.T) {
+		result, err := ProcessInBatches(context.Background(), nil, 2, 2, double)
+		require.NoError(t, err)
+		assert.Nil(t, result)
+
+		result, err = ProcessInBatches(context.Background(), []int{}, 2, 2, double)
+		require.NoError(t, err)
+		assert.Equal(t, []int{}, result)
+	})
+}
+
+// TestForEachBatch tests the ForEachBatch function
+func TestForEachBatch(t *testing.T) {
+	t.Run("Visits Every Element", func(t *testing.T) {
+		var sum atomic.Int64
+		err := ForEachBatch(context.Background(), Range(1, 101), 10, 4, func(batch []int) error {
+			for _, v := range batch {
+				sum.Add(int64(v))
+			}
+			return nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, int64(5050), sum.Load())
+	})
+
+	t.Run("Error", func(t *testing.T) {
+		errBad := errors.New("bad batch")
+		err := ForEachBatch(context.Background(), []int{1, 2}, 1, 1, func(batch []int) error {
+			return errBad
+		})
+		assert.ErrorIs(t, err, errBad)
+	})
+}
+
+// TestMapBatch tests the MapBatch function
+func TestMapBatch(t *testing.T) {
+	t.Run("Ordered Results", func(t *testing.T) {
+		result, err := MapBatch(context.Background(), Range(0, 50), 8, 3, func(v int) (string, error) {
+			return strconv.Itoa(v), nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, Map(Range(0, 50), strconv.Itoa), result)
+	})
+
+	t.Run("Element Error", func(t *testing.T) {
+		_, err := MapBatch(context.Background(), []string{"1", "x", "3"}, 2, 2, strconv.Atoi)
+		var numErr *strconv.NumError
+		assert.ErrorAs(t, err, &numErr)
+		assert.Contains(t, err.Error(), "batch 0")
+	})
+}
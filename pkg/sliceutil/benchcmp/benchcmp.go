@@ -0,0 +1,66 @@
+// Package benchcmp is the entry point for the sliceutil benchmark suite. The suite
+// runs every benchmarked function at three input sizes so that the complexity claims
+// in the doc comments can be checked: an O(n) function should take roughly 64 times
+// longer on Large than on Medium, while an O(n log n) one takes somewhat longer still.
+// Allocation counts are reported for every size.
+//
+// Benchmarks that use the suite are named Benchmark<Function>_SmallMediumLarge. To
+// compare a change against the main branch, record both runs and diff them with
+// benchstat (golang.org/x/perf/cmd/benchstat):
+//
+//	go test -run='^$' -bench=_SmallMediumLarge -benchmem -count=10 ./pkg/sliceutil > old.txt
+//	# apply the change
+//	go test -run='^$' -bench=_SmallMediumLarge -benchmem -count=10 ./pkg/sliceutil > new.txt
+//	benchstat old.txt new.txt
+//
+// "make bench-compare" runs the same command, and CI runs every size once on each
+// push so the suite keeps building and running.
+//
+// The package depends only on the standard library, so it can be imported from the
+// tests of package sliceutil itself as well as from downstream benchmarks.
+package benchcmp
+
+import (
+	"fmt"
+	"testing"
+)
+
+// Size names an input length used by the benchmark suite.
+type Size struct {
+	// Name is the sub-benchmark name, e.g. "Small"
+	Name string
+	// N is the number of elements in each input slice
+	N int
+}
+
+// Sizes are the input lengths used by SmallMediumLarge, in increasing order.
+// Each size is 64 times the previous one, so a linear function's time per operation
+// grows by about the same factor between consecutive sizes.
+var Sizes = []Size{
+	{Name: "Small", N: 16},
+	{Name: "Medium", N: 1024},
+	{Name: "Large", N: 65536},
+}
+
+// SmallMediumLarge runs bench once for every entry of Sizes as a sub-benchmark named
+// "<Name>/<N>", with allocation reporting enabled. bench should build its inputs from
+// n before entering its b.Loop, so that setup is not measured.
+//
+// Example:
+//
+//	func BenchmarkRemoveDuplicates_SmallMediumLarge(b *testing.B) {
+//		benchcmp.SmallMediumLarge(b, func(b *testing.B, n int) {
+//			s := makeInput(n)
+//			for b.Loop() {
+//				RemoveDuplicates(s)
+//			}
+//		})
+//	}
+func SmallMediumLarge(b *testing.B, bench func(b *testing.B, n int)) {
+	for _, size := range Sizes {
+		b.Run(fmt.Sprintf("%s/%d", size.Name, size.N), func(b *testing.B) {
+			b.ReportAllocs()
+			bench(b, size.N)
+		})
+	}
+}
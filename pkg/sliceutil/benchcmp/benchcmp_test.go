@@ -0,0 +1,31 @@
+package benchcmp
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestSizes tests the Sizes used by SmallMediumLarge
+func TestSizes(t *testing.T) {
+	t.Run("Named In Order", func(t *testing.T) {
+		assert.Equal(t, []Size{{"Small", 16}, {"Medium", 1024}, {"Large", 65536}}, Sizes)
+	})
+
+	t.Run("Grow By Constant Factor", func(t *testing.T) {
+		for i := 1; i < len(Sizes); i++ {
+			assert.Equal(t, 64*Sizes[i-1].N, Sizes[i].N)
+		}
+	})
+}
+
+// BenchmarkSmallMediumLarge benchmarks an empty loop through SmallMediumLarge, which
+// measures the overhead of the suite itself
+func BenchmarkSmallMediumLarge(b *testing.B) {
+	SmallMediumLarge(b, func(b *testing.B, n int) {
+		s := make([]int, n)
+		for b.Loop() {
+			_ = s
+		}
+	})
+}
@@ -0,0 +1,63 @@
+package sliceutil
+
+import "cmp"
+
+// ClampSlice returns a new slice in which every element is limited to the range
+// [lo, hi]: smaller elements are replaced by lo and larger ones by hi. This sanitizes
+// user-provided values before they are passed to statistics or merge functions.
+// The bounds are expected to satisfy lo <= hi; otherwise every element becomes hi.
+// NaN elements are neither smaller nor larger than the bounds and are kept as is.
+// If the slice is nil, the result is nil.
+//
+// Time complexity: O(n) where n is the length of the slice
+// Space complexity: O(n) for the result slice
+//
+// Example:
+//
+//	percentages := ClampSlice([]int{-5, 40, 120}, 0, 100) // returns []int{0, 40, 100}
+func ClampSlice[T cmp.Ordered](s []T, lo, hi T) []T {
+	if s == nil {
+		return nil
+	}
+
+	result := make([]T, len(s))
+	copy(result, s)
+	ClampInPlace(result, lo, hi)
+	return result
+}
+
+// ClampInPlace limits every element of a slice to the range [lo, hi] in place,
+// following the same rules as ClampSlice.
+//
+// Example:
+//
+//	ratios := []float64{-0.2, 0.5, 1.3}
+//	ClampInPlace(ratios, 0, 1) // ratios is now []float64{0, 0.5, 1}
+func ClampInPlace[T cmp.Ordered](s []T, lo, hi T) {
+	for i, v := range s {
+		if v < lo {
+			v = lo
+		}
+		if v > hi {
+			v = hi
+		}
+		s[i] = v
+	}
+}
+
+// CountOutOfRange returns the number of elements that are smaller than lo or larger
+// than hi, i.e. the number of elements ClampSlice would change. NaN elements are not
+// counted.
+//
+// Example:
+//
+//	invalid := CountOutOfRange([]int{-5, 40, 120}, 0, 100) // returns 2
+func CountOutOfRange[T cmp.Ordered](s []T, lo, hi T) int {
+	count := 0
+	for _, v := range s {
+		if v < lo || v > hi {
+			count++
+		}
+	}
+	return count
+}
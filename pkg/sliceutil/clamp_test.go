@@ -0,0 +1,69 @@
+package sliceutil
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestClampSlice tests the ClampSlice and ClampInPlace functions
+func TestClampSlice(t *testing.T) {
+	t.Run("Clamps Both Sides", func(t *testing.T) {
+		assert.Equal(t, []int{0, 40, 100}, ClampSlice([]int{-5, 40, 120}, 0, 100))
+		assert.Equal(t, []string{"b", "b", "c", "d"}, ClampSlice([]string{"a", "b", "c", "z"}, "b", "d"))
+	})
+
+	t.Run("Does Not Modify Input", func(t *testing.T) {
+		s := []int{-1, 2}
+		ClampSlice(s, 0, 1)
+		assert.Equal(t, []int{-1, 2}, s)
+	})
+
+	t.Run("In Place", func(t *testing.T) {
+		ratios := []float64{-0.2, 0.5, 1.3}
+		ClampInPlace(ratios, 0, 1)
+		assert.Equal(t, []float64{0, 0.5, 1}, ratios)
+	})
+
+	t.Run("Inverted Bounds", func(t *testing.T) {
+		assert.Equal(t, []int{1, 1, 1}, ClampSlice([]int{0, 5, 10}, 5, 1))
+	})
+
+	t.Run("NaN And Infinity", func(t *testing.T) {
+		result := ClampSlice([]float64{math.Inf(-1), math.NaN(), math.Inf(1)}, 0, 1)
+		assert.Equal(t, 0.0, result[0])
+		assert.True(t, math.IsNaN(result[1]))
+		assert.Equal(t, 1.0, result[2])
+	})
+
+	t.Run("Nil and Empty", func(t *testing.T) {
+		assert.Nil(t, ClampSlice[int](nil, 0, 1))
+		assert.Equal(t, []int{}, ClampSlice([]int{}, 0, 1))
+		ClampInPlace[int](nil, 0, 1)
+	})
+}
+
+// TestCountOutOfRange tests the CountOutOfRange function
+func TestCountOutOfRange(t *testing.T) {
+	t.Run("Counts Both Sides", func(t *testing.T) {
+		assert.Equal(t, 2, CountOutOfRange([]int{-5, 40, 120}, 0, 100))
+		assert.Equal(t, 0, CountOutOfRange([]int{0, 100}, 0, 100))
+	})
+
+	t.Run("Matches ClampSlice", func(t *testing.T) {
+		s := []float64{-1, 0.5, 2, math.NaN()}
+		clamped := ClampSlice(s, 0, 1)
+		changed := 0
+		for i := range s {
+			if s[i] != clamped[i] && !math.IsNaN(s[i]) {
+				changed++
+			}
+		}
+		assert.Equal(t, changed, CountOutOfRange(s, 0, 1))
+	})
+
+	t.Run("Nil and Empty", func(t *testing.T) {
+		assert.Equal(t, 0, CountOutOfRange[int](nil, 0, 1))
+	})
+}
@@ -0,0 +1,167 @@
+package sliceutil
+
+import (
+	"reflect"
+	"slices"
+)
+
+// Clone returns a shallow copy of a slice: the elements are copied, but pointers,
+// slices and maps held by them still refer to the same data as the original.
+// If the slice is nil, the result is nil.
+//
+// Time complexity: O(n) where n is the length of the slice
+// Space complexity: O(n) for the result slice
+//
+// Example:
+//
+//	backup := Clone(values)
+//	Sort(values, OrderAsc) // backup keeps the original order
+func Clone[T any](s []T) []T {
+	return slices.Clone(s)
+}
+
+// DeepClone returns a deep copy of a slice, so that the result can be mutated without
+// affecting the original. Pointers, slices, arrays, maps, interfaces and exported struct
+// fields are copied recursively. Pointers, maps and slices that are shared within the
+// slice remain shared within the copy, and cyclic structures, including slices that
+// contain themselves, are copied as cycles. Slices count as shared only if they have the
+// same start, length and capacity; overlapping subslices are copied separately.
+//
+// Unexported struct fields, channels and functions are copied as they are, because they
+// cannot be accessed via reflection. Values such as time.Time are therefore copied
+// correctly, but data reachable only through unexported fields is shared with the
+// original. If the slice is nil, the result is nil.
+//
+// Time complexity: O(n) where n is the total size of the data reachable from the slice
+// Space complexity: O(n) for the copy
+//
+// Example:
+//
+//	merged, _ := MergeByKey(current, incoming, key, ConflictKeepLast, nil)
+//	snapshot := DeepClone(merged)
+//	merged[0].Tags[0] = "changed" // snapshot[0].Tags is unaffected
+func DeepClone[T any](s []T) []T {
+	if s == nil || !hasReferences(reflect.TypeFor[T]()) {
+		return Clone(s)
+	}
+
+	c := &deepCloner{seen: make(map[cloneKey]reflect.Value)}
+	result := make([]T, len(s))
+	c.seen[sliceCloneKey(reflect.ValueOf(s))] = reflect.ValueOf(result)
+	for i := range s {
+		reflect.ValueOf(&result[i]).Elem().Set(c.clone(reflect.ValueOf(&s[i]).Elem()))
+	}
+	return result
+}
+
+// deepCloner copies values recursively and remembers the copies of pointers, maps and
+// slices, so that shared and cyclic references are preserved.
+type deepCloner struct {
+	seen map[cloneKey]reflect.Value
+}
+
+// cloneKey identifies a pointer, map or slice that has already been copied. The length
+// and capacity are only set for slices, whose data pointer alone is ambiguous.
+type cloneKey struct {
+	ptr uintptr
+	len int
+	cap int
+	typ reflect.Type
+}
+
+// sliceCloneKey is a helper function that returns the cloneKey of a non-nil slice.
+func sliceCloneKey(v reflect.Value) cloneKey {
+	return cloneKey{ptr: v.Pointer(), len: v.Len(), cap: v.Cap(), typ: v.Type()}
+}
+
+// clone returns a deep copy of v as a new value of the same type.
+func (c *deepCloner) clone(v reflect.Value) reflect.Value {
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() {
+			return v
+		}
+		key := cloneKey{ptr: v.Pointer(), typ: v.Type()}
+		if copied, ok := c.seen[key]; ok {
+			return copied
+		}
+		copied := reflect.New(v.Type().Elem())
+		c.seen[key] = copied
+		copied.Elem().Set(c.clone(v.Elem()))
+		return copied
+	case reflect.Struct:
+		// Copy every field, then replace the exported ones with deep copies
+		copied := reflect.New(v.Type()).Elem()
+		copied.Set(v)
+		for i := 0; i < v.NumField(); i++ {
+			if v.Type().Field(i).IsExported() {
+				copied.Field(i).Set(c.clone(v.Field(i)))
+			}
+		}
+		return copied
+	case reflect.Slice:
+		if v.IsNil() {
+			return v
+		}
+		key := sliceCloneKey(v)
+		if copied, ok := c.seen[key]; ok {
+			return copied
+		}
+		copied := reflect.MakeSlice(v.Type(), v.Len(), v.Len())
+		c.seen[key] = copied
+		for i := 0; i < v.Len(); i++ {
+			copied.Index(i).Set(c.clone(v.Index(i)))
+		}
+		return copied
+	case reflect.Array:
+		copied := reflect.New(v.Type()).Elem()
+		for i := 0; i < v.Len(); i++ {
+			copied.Index(i).Set(c.clone(v.Index(i)))
+		}
+		return copied
+	case reflect.Map:
+		if v.IsNil() {
+			return v
+		}
+		key := cloneKey{ptr: v.Pointer(), typ: v.Type()}
+		if copied, ok := c.seen[key]; ok {
+			return copied
+		}
+		copied := reflect.MakeMapWithSize(v.Type(), v.Len())
+		c.seen[key] = copied
+		iter := v.MapRange()
+		for iter.Next() {
+			copied.SetMapIndex(c.clone(iter.Key()), c.clone(iter.Value()))
+		}
+		return copied
+	case reflect.Interface:
+		if v.IsNil() {
+			return v
+		}
+		copied := reflect.New(v.Type()).Elem()
+		copied.Set(c.clone(v.Elem()))
+		return copied
+	default:
+		return v
+	}
+}
+
+// hasReferences is a helper function that reports whether values of a type can refer
+// to other data that DeepClone has to copy.
+func hasReferences(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Ptr, reflect.Slice, reflect.Map, reflect.Interface:
+		return true
+	case reflect.Array:
+		return hasReferences(t.Elem())
+	case reflect.Struct:
+		for i := 0; i < t.NumField(); i++ {
+			if t.Field(i).IsExported() && hasReferences(t.Field(i).Type) {
+				return true
+			}
+		}
+		return false
+	default:
+		return false
+	}
+}
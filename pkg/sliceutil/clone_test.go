@@ -0,0 +1,150 @@
+package sliceutil
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestClone tests the Clone function
+func TestClone(t *testing.T) {
+	t.Run("Copies Elements", func(t *testing.T) {
+		s := []int{1, 2, 3}
+		c := Clone(s)
+		c[0] = 100
+		assert.Equal(t, []int{1, 2, 3}, s)
+		assert.Equal(t, []int{100, 2, 3}, c)
+	})
+
+	t.Run("Is Shallow", func(t *testing.T) {
+		s := [][]int{{1}}
+		c := Clone(s)
+		c[0][0] = 100
+		assert.Equal(t, 100, s[0][0])
+	})
+
+	t.Run("Nil and Empty", func(t *testing.T) {
+		assert.Nil(t, Clone[int](nil))
+		assert.Equal(t, []int{}, Clone([]int{}))
+	})
+}
+
+// TestDeepClone tests the DeepClone function
+func TestDeepClone(t *testing.T) {
+	type Address struct {
+		City string
+	}
+	type Person struct {
+		Name      string
+		Address   *Address
+		Tags      []string
+		Labels    map[string][]int
+		Extra     interface{}
+		Scores    [2][]int
+		UpdatedAt time.Time
+		secret    *Address
+	}
+
+	newPeople := func() []Person {
+		shared := &Address{City: "NY"}
+		return []Person{
+			{
+				Name:      "Alice",
+				Address:   shared,
+				Tags:      []string{"admin"},
+				Labels:    map[string][]int{"team": {1, 2}},
+				Extra:     &Address{City: "LA"},
+				Scores:    [2][]int{{1}, {2}},
+				UpdatedAt: time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC),
+				secret:    shared,
+			},
+			{Name: "Bob", Address: shared},
+		}
+	}
+
+	t.Run("Equal To Source", func(t *testing.T) {
+		people := newPeople()
+		clone := DeepClone(people)
+		require.Len(t, clone, len(people))
+		for i := range people {
+			assert.True(t, CompareStructs(people[i], clone[i]))
+		}
+		assert.True(t, CompareStructSlices(people, clone).Equal)
+	})
+
+	t.Run("Mutations Do Not Affect Source", func(t *testing.T) {
+		people := newPeople()
+		clone := DeepClone(people)
+
+		clone[0].Address.City = "Boston"
+		clone[0].Tags[0] = "guest"
+		clone[0].Labels["team"][0] = 9
+		clone[0].Extra.(*Address).City = "SF"
+		clone[0].Scores[1][0] = 9
+
+		assert.Equal(t, "NY", people[0].Address.City)
+		assert.Equal(t, []string{"admin"}, people[0].Tags)
+		assert.Equal(t, []int{1, 2}, people[0].Labels["team"])
+		assert.Equal(t, "LA", people[0].Extra.(*Address).City)
+		assert.Equal(t, []int{2}, people[0].Scores[1])
+	})
+
+	t.Run("Shared Pointers Stay Shared", func(t *testing.T) {
+		people := newPeople()
+		clone := DeepClone(people)
+		assert.Same(t, clone[0].Address, clone[1].Address)
+		assert.NotSame(t, people[0].Address, clone[0].Address)
+
+		// Unexported fields are copied as they are
+		assert.Same(t, people[0].secret, clone[0].secret)
+	})
+
+	t.Run("Cycles", func(t *testing.T) {
+		type Node struct {
+			Value int
+			Next  *Node
+		}
+		a := &Node{Value: 1}
+		b := &Node{Value: 2, Next: a}
+		a.Next = b
+
+		clone := DeepClone([]*Node{a})
+		assert.NotSame(t, a, clone[0])
+		assert.Equal(t, 2, clone[0].Next.Value)
+		assert.Same(t, clone[0], clone[0].Next.Next)
+
+		// A slice that contains itself
+		s := []interface{}{0}
+		s[0] = s
+		selfClone := DeepClone(s)
+		inner := selfClone[0].([]interface{})
+		assert.Same(t, &selfClone[0], &inner[0])
+		assert.NotSame(t, &s[0], &selfClone[0])
+
+		type Holder struct {
+			Items []interface{}
+		}
+		items := []interface{}{1, nil}
+		items[1] = items
+		holders := DeepClone([]Holder{{Items: items}, {Items: items}})
+		assert.Same(t, &holders[0].Items[0], &holders[1].Items[0])
+		assert.Same(t, &holders[0].Items[0], &holders[0].Items[1].([]interface{})[0])
+		assert.NotSame(t, &items[0], &holders[0].Items[0])
+	})
+
+	t.Run("Values Without References", func(t *testing.T) {
+		s := []Address{{City: "NY"}}
+		clone := DeepClone(s)
+		clone[0].City = "LA"
+		assert.Equal(t, "NY", s[0].City)
+	})
+
+	t.Run("Nil and Empty", func(t *testing.T) {
+		assert.Nil(t, DeepClone[*Person](nil))
+		assert.Equal(t, []*Person{}, DeepClone([]*Person{}))
+		assert.Equal(t, []*Person{nil}, DeepClone([]*Person{nil}))
+		assert.Equal(t, []interface{}{nil, 1}, DeepClone([]interface{}{nil, 1}))
+	})
+}
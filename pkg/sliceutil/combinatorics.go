@@ -0,0 +1,211 @@
+package sliceutil
+
+import (
+	"iter"
+	"math"
+)
+
+// CartesianProduct returns every ordered pair combining an element of slice A
+// with an element of slice B. Pairs are ordered by slice A first, then slice B.
+// The function returns ErrSizeOverflow if the number of pairs cannot be represented as an int.
+//
+// Time complexity: O(n * m) where n and m are the lengths of the slices
+// Space complexity: O(n * m) for the result slice
+//
+// Example:
+//
+//	pairs, err := CartesianProduct([]int{1, 2}, []string{"a", "b"})
+//	// returns [{1 a} {1 b} {2 a} {2 b}], nil
+func CartesianProduct[A, B any](a []A, b []B) ([]Pair[A, B], error) {
+	size, ok := productSize(len(a), len(b))
+	if !ok {
+		return nil, ErrSizeOverflow
+	}
+
+	result := make([]Pair[A, B], 0, size)
+	for _, x := range a {
+		for _, y := range b {
+			result = append(result, Pair[A, B]{First: x, Second: y})
+		}
+	}
+
+	return result, nil
+}
+
+// CartesianProductN returns every combination that picks one element from each of the given slices.
+// Combinations are produced in lexicographic order of the input positions, with the last slice
+// varying fastest. If no slices are given or any slice is empty, the result is empty.
+// The function returns ErrSizeOverflow if the number of combinations cannot be represented as an int.
+//
+// Example:
+//
+//	combos, err := CartesianProductN([]int{1, 2}, []int{3, 4})
+//	// returns [[1 3] [1 4] [2 3] [2 4]], nil
+func CartesianProductN[T any](slices ...[]T) ([][]T, error) {
+	if len(slices) == 0 {
+		return [][]T{}, nil
+	}
+
+	size := 1
+	for _, s := range slices {
+		var ok bool
+		if size, ok = productSize(size, len(s)); !ok {
+			return nil, ErrSizeOverflow
+		}
+	}
+
+	result := make([][]T, 0, size)
+	if size == 0 {
+		return result, nil
+	}
+
+	// indices acts as an odometer over the input slices
+	indices := make([]int, len(slices))
+	for {
+		combo := make([]T, len(slices))
+		for i, idx := range indices {
+			combo[i] = slices[i][idx]
+		}
+		result = append(result, combo)
+
+		// Advance the odometer starting from the last slice
+		pos := len(indices) - 1
+		for pos >= 0 {
+			indices[pos]++
+			if indices[pos] < len(slices[pos]) {
+				break
+			}
+			indices[pos] = 0
+			pos--
+		}
+		if pos < 0 {
+			return result, nil
+		}
+	}
+}
+
+// Combinations returns a lazy sequence of every k-element combination of the slice.
+// Combinations are produced in lexicographic order of element positions, and each
+// yielded slice is a fresh copy that the caller may keep or modify.
+// If k is negative or greater than the length of the slice, the sequence is empty.
+// A k of zero yields a single empty combination.
+//
+// Example:
+//
+//	for c := range Combinations([]int{1, 2, 3}, 2) {
+//		fmt.Println(c) // [1 2], [1 3], [2 3]
+//	}
+func Combinations[T any](s []T, k int) iter.Seq[[]T] {
+	return func(yield func([]T) bool) {
+		n := len(s)
+		if k < 0 || k > n {
+			return
+		}
+
+		// indices holds the positions of the current combination
+		indices := make([]int, k)
+		for i := range indices {
+			indices[i] = i
+		}
+
+		for {
+			combo := make([]T, k)
+			for i, idx := range indices {
+				combo[i] = s[idx]
+			}
+			if !yield(combo) {
+				return
+			}
+
+			// Find the rightmost index that can still be incremented
+			i := k - 1
+			for i >= 0 && indices[i] == n-k+i {
+				i--
+			}
+			if i < 0 {
+				return
+			}
+			indices[i]++
+			for j := i + 1; j < k; j++ {
+				indices[j] = indices[j-1] + 1
+			}
+		}
+	}
+}
+
+// CollectCombinations materializes every k-element combination of the slice.
+// Use Combinations directly when the number of results may be large.
+func CollectCombinations[T any](s []T, k int) [][]T {
+	result := make([][]T, 0)
+	for c := range Combinations(s, k) {
+		result = append(result, c)
+	}
+	return result
+}
+
+// Permutations returns a lazy sequence of every ordering of the slice.
+// Permutations are produced in lexicographic order of element positions, so the
+// first permutation is the slice itself. Each yielded slice is a fresh copy.
+// An empty or nil slice yields a single empty permutation.
+//
+// Example:
+//
+//	for p := range Permutations([]int{1, 2, 3}) {
+//		fmt.Println(p) // [1 2 3], [1 3 2], [2 1 3], ...
+//	}
+func Permutations[T any](s []T) iter.Seq[[]T] {
+	return func(yield func([]T) bool) {
+		n := len(s)
+		indices := make([]int, n)
+		for i := range indices {
+			indices[i] = i
+		}
+
+		for {
+			perm := make([]T, n)
+			for i, idx := range indices {
+				perm[i] = s[idx]
+			}
+			if !yield(perm) {
+				return
+			}
+
+			// Advance to the next permutation of the positions
+			i := n - 2
+			for i >= 0 && indices[i] >= indices[i+1] {
+				i--
+			}
+			if i < 0 {
+				return
+			}
+			j := n - 1
+			for indices[j] <= indices[i] {
+				j--
+			}
+			indices[i], indices[j] = indices[j], indices[i]
+			Reverse(indices[i+1:])
+		}
+	}
+}
+
+// CollectPermutations materializes every ordering of the slice.
+// Use Permutations directly when the number of results may be large.
+func CollectPermutations[T any](s []T) [][]T {
+	result := make([][]T, 0)
+	for p := range Permutations(s) {
+		result = append(result, p)
+	}
+	return result
+}
+
+// productSize is a helper function that multiplies two lengths and reports
+// whether the result fits into an int.
+func productSize(a, b int) (int, bool) {
+	if a == 0 || b == 0 {
+		return 0, true
+	}
+	if a > math.MaxInt/b {
+		return 0, false
+	}
+	return a * b, true
+}
@@ -0,0 +1,132 @@
+package sliceutil
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestCartesianProduct tests the CartesianProduct function
+func TestCartesianProduct(t *testing.T) {
+	t.Run("Pairs All Elements", func(t *testing.T) {
+		result, err := CartesianProduct([]int{1, 2}, []string{"a", "b"})
+		require.NoError(t, err)
+
+		expected := []Pair[int, string]{
+			{First: 1, Second: "a"},
+			{First: 1, Second: "b"},
+			{First: 2, Second: "a"},
+			{First: 2, Second: "b"},
+		}
+		assert.Equal(t, expected, result)
+	})
+
+	t.Run("Empty Input", func(t *testing.T) {
+		result, err := CartesianProduct([]int{1, 2}, []string{})
+		require.NoError(t, err)
+		assert.Empty(t, result)
+
+		result, err = CartesianProduct[int, string](nil, nil)
+		require.NoError(t, err)
+		assert.Empty(t, result)
+	})
+}
+
+// TestCartesianProductN tests the CartesianProductN function
+func TestCartesianProductN(t *testing.T) {
+	t.Run("Three Slices", func(t *testing.T) {
+		result, err := CartesianProductN([]int{1, 2}, []int{3}, []int{4, 5})
+		require.NoError(t, err)
+
+		assert.Equal(t, [][]int{{1, 3, 4}, {1, 3, 5}, {2, 3, 4}, {2, 3, 5}}, result)
+	})
+
+	t.Run("Single Slice", func(t *testing.T) {
+		result, err := CartesianProductN([]string{"a", "b"})
+		require.NoError(t, err)
+		assert.Equal(t, [][]string{{"a"}, {"b"}}, result)
+	})
+
+	t.Run("No Slices Or Empty Slice", func(t *testing.T) {
+		result, err := CartesianProductN[int]()
+		require.NoError(t, err)
+		assert.Empty(t, result)
+
+		result, err = CartesianProductN([]int{1, 2}, []int{})
+		require.NoError(t, err)
+		assert.Empty(t, result)
+	})
+}
+
+// TestCombinations tests the Combinations and CollectCombinations functions
+func TestCombinations(t *testing.T) {
+	t.Run("Choose Two Of Four", func(t *testing.T) {
+		result := CollectCombinations([]int{1, 2, 3, 4}, 2)
+		expected := [][]int{{1, 2}, {1, 3}, {1, 4}, {2, 3}, {2, 4}, {3, 4}}
+		assert.Equal(t, expected, result)
+	})
+
+	t.Run("Edge Values Of K", func(t *testing.T) {
+		assert.Equal(t, [][]int{{}}, CollectCombinations([]int{1, 2}, 0))
+		assert.Equal(t, [][]int{{1, 2}}, CollectCombinations([]int{1, 2}, 2))
+		assert.Empty(t, CollectCombinations([]int{1, 2}, 3))
+		assert.Empty(t, CollectCombinations([]int{1, 2}, -1))
+	})
+
+	t.Run("Stops Early", func(t *testing.T) {
+		count := 0
+		for range Combinations([]int{1, 2, 3, 4, 5}, 3) {
+			count++
+			if count == 2 {
+				break
+			}
+		}
+		assert.Equal(t, 2, count)
+	})
+}
+
+// TestPermutations tests the Permutations and CollectPermutations functions
+func TestPermutations(t *testing.T) {
+	t.Run("Three Elements", func(t *testing.T) {
+		result := CollectPermutations([]string{"a", "b", "c"})
+		expected := [][]string{
+			{"a", "b", "c"}, {"a", "c", "b"},
+			{"b", "a", "c"}, {"b", "c", "a"},
+			{"c", "a", "b"}, {"c", "b", "a"},
+		}
+		assert.Equal(t, expected, result)
+	})
+
+	t.Run("Duplicates Are Treated By Position", func(t *testing.T) {
+		assert.Len(t, CollectPermutations([]int{1, 1, 2}), 6)
+	})
+
+	t.Run("Nil And Empty", func(t *testing.T) {
+		assert.Equal(t, [][]int{{}}, CollectPermutations[int](nil))
+		assert.Equal(t, [][]int{{1}}, CollectPermutations([]int{1}))
+	})
+
+	t.Run("Yielded Slices Are Independent", func(t *testing.T) {
+		s := []int{1, 2}
+		for p := range Permutations(s) {
+			p[0] = 99
+		}
+		assert.Equal(t, []int{1, 2}, s)
+	})
+}
+
+// TestProductSize tests the overflow guard used by the cartesian product functions
+func TestProductSize(t *testing.T) {
+	size, ok := productSize(3, 4)
+	assert.True(t, ok)
+	assert.Equal(t, 12, size)
+
+	_, ok = productSize(math.MaxInt, 2)
+	assert.False(t, ok)
+
+	size, ok = productSize(math.MaxInt, 0)
+	assert.True(t, ok)
+	assert.Equal(t, 0, size)
+}
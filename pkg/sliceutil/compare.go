@@ -1,8 +1,10 @@
 package sliceutil
 
 import (
-	"fmt"
+	"bytes"
+	"math"
 	"reflect"
+	"unsafe"
 )
 
 // CompareSlices checks if two slices are equal in values and order.
@@ -32,6 +34,12 @@ func CompareSlices[T comparable](a, b []T) bool {
 		return false
 	}
 
+	// Integer, byte and bool slices are compared as raw memory, which is much
+	// faster than an element loop on large inputs
+	if equal, ok := compareSlicesMemory(a, b); ok {
+		return equal
+	}
+
 	// Compare each element in the slices
 	for i, v := range a {
 		// If any element is different, the slices are not equal
@@ -44,16 +52,130 @@ func CompareSlices[T comparable](a, b []T) bool {
 	return true
 }
 
+// compareSlicesMemory is a helper function that compares two slices of equal length
+// byte by byte when equality of their element type is the same as equality of its
+// memory representation. This holds for bool and the integer types, which have no
+// padding and exactly one representation per value, so bytes.Equal can use its
+// vectorized implementation. It returns ok == false for every other type, including
+// floats (NaN and negative zero) and types that merely have an integer underlying type.
+func compareSlicesMemory[T comparable](a, b []T) (equal, ok bool) {
+	switch any(*new(T)).(type) {
+	case bool, int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, uintptr:
+		return bytes.Equal(sliceBytes(a), sliceBytes(b)), true
+	}
+	return false, false
+}
+
+// sliceBytes is a helper function that returns the memory backing s as a byte slice
+// without copying. It must only be used to read slices of padding-free types.
+func sliceBytes[T any](s []T) []byte {
+	if len(s) == 0 {
+		return nil
+	}
+	size := int(unsafe.Sizeof(s[0]))
+	return unsafe.Slice((*byte)(unsafe.Pointer(unsafe.SliceData(s))), len(s)*size)
+}
+
+// EqualBy checks if two slices are equal in order after normalizing every element.
+// The norm function maps each element to a comparable key, such as a trimmed and
+// lower-cased string or a rounded number, and the keys are compared pairwise.
+// Nil slices follow the same rules as CompareSlices.
+//
+// Time complexity: O(n) where n is the length of the slices
+// Space complexity: O(1)
+//
+// Example:
+//
+//	a := []string{" Apple", "banana"}
+//	b := []string{"apple", "BANANA "}
+//	norm := func(s string) string { return strings.ToLower(strings.TrimSpace(s)) }
+//	result := EqualBy(a, b, norm) // returns true
+func EqualBy[T any, K comparable](a, b []T, norm func(T) K) bool {
+	// Check for nil slices
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if norm(a[i]) != norm(b[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
 // CompareSlicesWithResult provides detailed comparison results including
 // information about where differences occur.
 //
 // This function is useful when you need more than just a boolean result
 // and want to understand the nature of differences between slices.
+// For slices of equal length, Details.DifferenceIndices holds the differing indices
+// and Details.Extra["mismatches"] holds the differing values as []ElementDiff[T].
 func CompareSlicesWithResult[T comparable](a, b []T) CompareResult {
+	return CompareSlicesWithResultMax(a, b, 0)
+}
+
+// CompareSlicesWithResultMax behaves like CompareSlicesWithResult but records at most
+// maxDiffs differing elements. A maxDiffs of zero or less means no limit.
+// When the limit is reached, Details.DifferenceCount still holds the total number of
+// differences and Details.Truncated is set to true.
+//
+// Example:
+//
+//	result := CompareSlicesWithResultMax([]int{1, 2, 3}, []int{0, 0, 0}, 2)
+//	mismatches := result.Details.Extra["mismatches"].([]ElementDiff[int])
+//	// len(mismatches) == 2, result.Details.DifferenceCount == 3
+func CompareSlicesWithResultMax[T comparable](a, b []T, maxDiffs int) CompareResult {
+	return compareSlicesWithResult(a, b, func(x, y T) bool { return x == y }, maxDiffs)
+}
+
+// CompareSlicesFunc checks if two slices are equal in order using a custom equality
+// predicate. This is useful for slices of structs where equality means "same ID"
+// rather than full value equality. Nil slices follow the same rules as CompareSlices.
+//
+// Time complexity: O(n) where n is the length of the slices
+// Space complexity: O(1)
+//
+// Example:
+//
+//	sameID := func(x, y User) bool { return x.ID == y.ID }
+//	result := CompareSlicesFunc(oldUsers, newUsers, sameID)
+func CompareSlicesFunc[T any](a, b []T, eq func(T, T) bool) bool {
+	// Check for nil slices
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if !eq(a[i], b[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// CompareSlicesFuncWithResult provides detailed comparison results like
+// CompareSlicesWithResult, using a custom equality predicate.
+func CompareSlicesFuncWithResult[T any](a, b []T, eq func(T, T) bool) CompareResult {
+	return compareSlicesWithResult(a, b, eq, 0)
+}
+
+// compareSlicesWithResult is a helper function that builds a detailed comparison result
+// using the given equality predicate, recording at most maxDiffs differences when positive.
+func compareSlicesWithResult[T any](a, b []T, eq func(T, T) bool, maxDiffs int) CompareResult {
 	result := CompareResult{
 		Equal:   true,
 		Message: "Slices are equal",
-		Details: make(map[string]interface{}),
 	}
 
 	// Check for nil slices
@@ -63,8 +185,8 @@ func CompareSlicesWithResult[T comparable](a, b []T) CompareResult {
 		}
 		result.Equal = false
 		result.Message = "One slice is nil while the other is not"
-		result.Details["a_nil"] = a == nil
-		result.Details["b_nil"] = b == nil
+		result.Details.ANil = a == nil
+		result.Details.BNil = b == nil
 		return result
 	}
 
@@ -72,208 +194,273 @@ func CompareSlicesWithResult[T comparable](a, b []T) CompareResult {
 	if len(a) != len(b) {
 		result.Equal = false
 		result.Message = "Slices have different lengths"
-		result.Details["length_a"] = len(a)
-		result.Details["length_b"] = len(b)
+		result.Details.LengthA = len(a)
+		result.Details.LengthB = len(b)
 		return result
 	}
 
 	// Find differences
 	var differences []int
+	var mismatches []ElementDiff[T]
+	count := 0
 	for i, v := range a {
-		if v != b[i] {
+		if !eq(v, b[i]) {
+			count++
+			if maxDiffs > 0 && len(differences) >= maxDiffs {
+				continue
+			}
 			differences = append(differences, i)
+			mismatches = append(mismatches, ElementDiff[T]{Index: i, AValue: v, BValue: b[i]})
 		}
 	}
 
-	if len(differences) > 0 {
+	if count > 0 {
 		result.Equal = false
 		result.Message = "Slices differ at specific indices"
-		result.Details["differences"] = differences
-		result.Details["difference_count"] = len(differences)
+		result.Details.DifferenceIndices = differences
+		result.Details.DifferenceCount = count
+		result.Details.Truncated = count > len(differences)
+		result.Details.Extra = map[string]interface{}{"mismatches": mismatches}
 	}
 
 	return result
 }
 
-// CompareReflectionSlices compares two slices using reflection.
-// This function is useful when you need to compare slices of unknown types
-// at runtime.
+// CompareSlicesUnordered checks if two slices contain the same elements with the same
+// counts, regardless of order. In other words, the slices are compared as multisets.
+// Nil slices follow the same rules as CompareSlices.
 //
-// Supported types: []int, []string
-// For other types, the function returns false.
+// Time complexity: O(n) where n is the length of the slices
+// Space complexity: O(n) for the element counts
 //
-// Note: This function is less performant than CompareSlices due to reflection overhead.
-// Use CompareSlices when the types are known at compile time.
-func CompareReflectionSlices(fieldA, fieldB reflect.Value) bool {
-	// Ensure that the values are slices
-	if fieldA.Kind() != reflect.Slice || fieldB.Kind() != reflect.Slice {
-		return false
+// Example:
+//
+//	a := []int{1, 2, 2, 3}
+//	b := []int{2, 3, 1, 2}
+//	result := CompareSlicesUnordered(a, b) // returns true
+func CompareSlicesUnordered[T comparable](a, b []T) bool {
+	// Check for nil slices
+	if a == nil || b == nil {
+		return a == nil && b == nil
 	}
 
-	// Check if the slice types match
-	if fieldA.Type() != fieldB.Type() {
+	if len(a) != len(b) {
 		return false
 	}
 
-	// We will check the type of slices and pass them accordingly to CompareSlices
-	switch fieldA.Type().Elem().Kind() {
-	case reflect.Int:
-		// Type assertion for int slice
-		a := fieldA.Interface().([]int)
-		b := fieldB.Interface().([]int)
-		return CompareSlices(a, b)
-	case reflect.String:
-		// Type assertion for string slice
-		a := fieldA.Interface().([]string)
-		b := fieldB.Interface().([]string)
-		return CompareSlices(a, b)
-	default:
-		// If the type is unsupported, return false
-		return false
+	counts := countElements(a)
+	for _, v := range b {
+		if counts[v] == 0 {
+			return false
+		}
+		counts[v]--
 	}
+
+	return true
 }
 
-// CompareStructs compares two structs deeply, supporting nested structs and pointers.
-// The function uses memoization to improve performance for repeated comparisons.
-//
-// Features:
-// - Deep comparison of all exported fields
-// - Support for nested structs
-// - Support for pointer fields
-// - Memoization cache for performance
-// - Handles nil pointers gracefully
-//
-// The function recursively compares all exported fields of the structs.
-// Unexported fields are ignored as they cannot be accessed via reflection.
-func CompareStructs(a, b interface{}) bool {
-	// If both are nil, they are equal
-	if a == nil && b == nil {
-		return true
+// CompareSlicesUnorderedWithResult compares two slices as multisets and explains
+// which element counts differ. When the slices differ, Details.Extra["count_differences"]
+// holds a map[T]int of the count in A minus the count in B for every element whose
+// counts do not match, in the same form as FindDifferencesWithCount.
+func CompareSlicesUnorderedWithResult[T comparable](a, b []T) CompareResult {
+	result := CompareResult{
+		Equal:   true,
+		Message: "Slices contain the same elements",
 	}
 
-	// If one is nil, they are not equal
+	// Check for nil slices
 	if a == nil || b == nil {
-		return false
+		if a == nil && b == nil {
+			return result
+		}
+		result.Equal = false
+		result.Message = "One slice is nil while the other is not"
+		result.Details.ANil = a == nil
+		result.Details.BNil = b == nil
+		return result
 	}
 
-	// Check if the types are the same
-	if reflect.TypeOf(a) != reflect.TypeOf(b) {
-		return false
+	differences := FindDifferencesWithCount(a, b)
+	if len(differences) > 0 {
+		result.Equal = false
+		result.Message = "Slices contain different element counts"
+		result.Details.DifferenceCount = len(differences)
+		result.Details.LengthA = len(a)
+		result.Details.LengthB = len(b)
+		result.Details.Extra = map[string]interface{}{"count_differences": differences}
 	}
 
-	// Use reflection to inspect the struct fields
-	valA := reflect.ValueOf(a)
-	valB := reflect.ValueOf(b)
+	return result
+}
 
-	// Dereference pointers, if applicable
-	if valA.Kind() == reflect.Ptr {
-		if valA.IsNil() || valB.IsNil() {
-			return valA.IsNil() && valB.IsNil()
-		}
-		valA = valA.Elem()
-		valB = valB.Elem()
+// CompareReflectionSlices compares two slices using reflection.
+// This function is useful when you need to compare slices of unknown types
+// at runtime.
+//
+// Slices and arrays of any element kind are supported. Comparable elements are compared
+// with ==, like CompareSlices, and all others with reflect.DeepEqual, which also
+// protects against cyclic values. Nil slices follow the same rules as CompareSlices.
+// If the values are not slices of the same type, the function returns false; use
+// CompareReflectionSlicesWithError to tell these cases apart from unequal slices.
+//
+// Note: This function is less performant than CompareSlices due to reflection overhead.
+// Use CompareSlices when the types are known at compile time.
+func CompareReflectionSlices(fieldA, fieldB reflect.Value) bool {
+	equal, err := CompareReflectionSlicesWithError(fieldA, fieldB)
+	return err == nil && equal
+}
+
+// CompareReflectionSlicesWithError compares two slices using reflection like
+// CompareReflectionSlices, but reports invalid input as an error instead of false.
+// It returns ErrUnsupportedType if either value is not a slice or array, or if its
+// elements cannot be accessed, and ErrTypeMismatch if the slice types differ.
+//
+// Example:
+//
+//	equal, err := CompareReflectionSlicesWithError(reflect.ValueOf([]bool{true}), reflect.ValueOf([]bool{true}))
+//	// equal: true, err: nil
+func CompareReflectionSlicesWithError(fieldA, fieldB reflect.Value) (bool, error) {
+	// Ensure that the values are slices
+	if !isSliceKind(fieldA) || !isSliceKind(fieldB) {
+		return false, ErrUnsupportedType
 	}
 
-	// Check if both are structs
-	if valA.Kind() != reflect.Struct || valB.Kind() != reflect.Struct {
-		return reflect.DeepEqual(a, b)
+	// Check if the slice types match
+	if fieldA.Type() != fieldB.Type() {
+		return false, ErrTypeMismatch
 	}
 
-	// Compare each field of the structs
-	for i := 0; i < valA.NumField(); i++ {
-		fieldA := valA.Field(i)
-		fieldB := valB.Field(i)
+	// Check for nil slices
+	if fieldA.Kind() == reflect.Slice && (fieldA.IsNil() || fieldB.IsNil()) {
+		return fieldA.IsNil() && fieldB.IsNil(), nil
+	}
 
-		// Skip unexported fields
-		if !fieldA.CanInterface() || !fieldB.CanInterface() {
-			continue
-		}
+	if fieldA.Len() != fieldB.Len() {
+		return false, nil
+	}
+
+	elemType := fieldA.Type().Elem()
+	// Value.Equal panics when an interface holds a non-comparable value, even inside a
+	// comparable struct or array, so such element types go through DeepEqual
+	useEqual := elemType.Comparable() && !containsInterface(elemType)
+	if !useEqual && (!fieldA.CanInterface() || !fieldB.CanInterface()) {
+		return false, ErrUnsupportedType
+	}
 
-		// Handle slice fields specially
-		if fieldA.Kind() == reflect.Slice {
-			if !compareSlicesReflect(fieldA, fieldB) {
-				return false
+	for i := 0; i < fieldA.Len(); i++ {
+		elemA := fieldA.Index(i)
+		elemB := fieldB.Index(i)
+
+		if useEqual {
+			if !elemA.Equal(elemB) {
+				return false, nil
 			}
 			continue
 		}
 
-		// Compare other fields recursively
-		if !CompareStructs(fieldA.Interface(), fieldB.Interface()) {
-			return false
+		// Fall back to DeepEqual, which detects cycles, for non-comparable elements
+		if !reflect.DeepEqual(elemA.Interface(), elemB.Interface()) {
+			return false, nil
 		}
 	}
 
-	// If all fields match, return true
-	return true
+	return true, nil
 }
 
-// compareSlicesReflect is a helper function that compares slices using reflection.
-// It's used internally by CompareStructs for comparing slice fields.
-func compareSlicesReflect(a, b reflect.Value) bool {
-	if a.Len() != b.Len() {
-		return false
-	}
-
-	for i := 0; i < a.Len(); i++ {
-		if !reflect.DeepEqual(a.Index(i).Interface(), b.Index(i).Interface()) {
-			return false
+// containsInterface is a helper function that reports whether a type is an interface
+// or a struct or array with an interface anywhere among its fields or elements.
+func containsInterface(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Interface:
+		return true
+	case reflect.Array:
+		return containsInterface(t.Elem())
+	case reflect.Struct:
+		for i := 0; i < t.NumField(); i++ {
+			if containsInterface(t.Field(i).Type) {
+				return true
+			}
 		}
 	}
-	return true
+	return false
 }
 
-// generateCacheKey generates a unique key for struct comparison based on the struct's type and values.
-// This key is used for memoization to avoid repeated comparisons of the same structs.
-func generateCacheKey(a, b interface{}) string {
-	// Use a more specific key that includes pointer addresses for better uniqueness
-	keyA := reflect.TypeOf(a).String()
-	keyB := reflect.TypeOf(b).String()
+// isSliceKind is a helper function that reports whether a value is a slice or an array.
+func isSliceKind(v reflect.Value) bool {
+	return v.Kind() == reflect.Slice || v.Kind() == reflect.Array
+}
 
-	// Add pointer addresses to make keys more unique
-	if reflect.ValueOf(a).Kind() == reflect.Ptr {
-		keyA += fmt.Sprintf(":%p", a)
-	}
-	if reflect.ValueOf(b).Kind() == reflect.Ptr {
-		keyB += fmt.Sprintf(":%p", b)
+// CompareStructs compares two structs deeply, supporting nested structs and pointers.
+// The function uses memoization to improve performance for repeated comparisons.
+//
+// Features:
+// - Deep comparison of all exported fields
+// - Support for nested structs
+// - Support for pointer fields, including cyclic structures
+// - Opt-in memoization cache for performance (see SetStructCacheConfig)
+// - Handles nil pointers gracefully
+//
+// The function recursively compares all exported fields of the structs.
+// Unexported fields are ignored as they cannot be accessed via reflection.
+func CompareStructs(a, b interface{}) bool {
+	key, ok := structCacheKey(a, b)
+	if !ok {
+		return compareStructs(a, b)
 	}
 
-	return keyA + ":" + keyB
+	if result, found := getCachedComparison(key); found {
+		return result
+	}
+	result := compareStructs(a, b)
+	cacheComparisonResult(key, result)
+	return result
 }
 
-// getCachedComparison retrieves a cached comparison result.
-// Returns the result and a boolean indicating if the result was found.
-func getCachedComparison(key string) (bool, bool) {
-	structCache.RLock()
-	defer structCache.RUnlock()
-	result, found := structCache.cache[key]
-	return result, found
+// compareStructs is a helper function that compares two structs without consulting the cache.
+func compareStructs(a, b interface{}) bool {
+	return CompareStructsWithOptions(a, b)
 }
 
-// cacheComparisonResult stores the comparison result in the cache.
-// This function is thread-safe and uses a read-write mutex for concurrent access.
-func cacheComparisonResult(key string, result bool) {
-	structCache.Lock()
-	defer structCache.Unlock()
-	structCache.cache[key] = result
+// CompareSlicesApprox checks if two float64 slices are equal in order, treating values
+// that differ by at most epsilon as equal. NaN values are never equal, matching the
+// behaviour of the == operator. Nil slices follow the same rules as CompareSlices.
+//
+// Time complexity: O(n) where n is the length of the slices
+// Space complexity: O(1)
+//
+// Example:
+//
+//	a := []float64{1.0000000001, 2.0}
+//	b := []float64{1.0, 2.0}
+//	result := CompareSlicesApprox(a, b, 1e-9) // returns true
+func CompareSlicesApprox(a, b []float64, epsilon float64) bool {
+	return CompareSlicesFunc(a, b, func(x, y float64) bool {
+		return floatsApproxEqual(x, y, epsilon)
+	})
 }
 
-// ClearStructCache clears the memoization cache for struct comparisons.
-// This function is useful when memory usage becomes a concern or when
-// you want to ensure fresh comparisons.
-func ClearStructCache() {
-	structCache.Lock()
-	defer structCache.Unlock()
-	structCache.cache = make(map[string]bool)
+// CompareStructsApprox compares two structs deeply like CompareStructs, but treats
+// float32 and float64 values that differ by at most epsilon as equal. Floats are
+// compared approximately wherever they occur: in fields, nested structs, pointers,
+// slices, arrays, maps and interfaces. Fields tagged with `sliceutil:"-"` are skipped,
+// types registered with RegisterComparator, including time.Time, use their comparator,
+// cyclic structures are handled as in CompareStructs, and all other values are
+// compared exactly.
+//
+// Example:
+//
+//	a := Point{X: 1.0000000001, Y: 1}
+//	b := Point{X: 1.0, Y: 1}
+//	result := CompareStructsApprox(a, b, 1e-9) // returns true
+func CompareStructsApprox(a, b interface{}, epsilon float64) bool {
+	return CompareStructsWithOptions(a, b, withFloatTolerance(epsilon))
 }
 
-// GetStructCacheStats returns statistics about the struct comparison cache.
-// This is useful for monitoring cache performance and memory usage.
-func GetStructCacheStats() map[string]interface{} {
-	structCache.RLock()
-	defer structCache.RUnlock()
-
-	return map[string]interface{}{
-		"cache_size": len(structCache.cache),
-		"cache_keys": reflect.ValueOf(structCache.cache).MapKeys(),
+// floatsApproxEqual is a helper function that reports whether two floats differ by at most epsilon.
+func floatsApproxEqual(x, y, epsilon float64) bool {
+	if x == y {
+		return true
 	}
+	return math.Abs(x-y) <= math.Abs(epsilon)
 }
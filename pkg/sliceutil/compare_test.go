@@ -1,9 +1,13 @@
 package sliceutil
 
 import (
+	"fmt"
+	"math"
 	"reflect"
+	"strings"
 	"testing"
 
+	"github.com/devrob-go/sliceutil/pkg/sliceutil/benchcmp"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -94,6 +98,131 @@ func TestCompareStructs(t *testing.T) {
 	})
 }
 
+// TestCompareStructsCollections tests CompareStructs on map, array and interface fields
+func TestCompareStructsCollections(t *testing.T) {
+	type Point struct {
+		X, Y int
+	}
+
+	type Shape struct {
+		Labels  map[string]string
+		Corners map[string]*Point
+		Grid    [2][2]int
+		Anchors [2]Point
+		Meta    interface{}
+	}
+
+	t.Run("Maps Compared Key By Key", func(t *testing.T) {
+		a := Shape{Labels: map[string]string{"env": "prod", "team": "core"}}
+		b := Shape{Labels: map[string]string{"team": "core", "env": "prod"}}
+		assert.True(t, CompareStructs(a, b))
+
+		b.Labels["env"] = "dev"
+		assert.False(t, CompareStructs(a, b))
+
+		delete(b.Labels, "env")
+		b.Labels["region"] = "eu"
+		assert.False(t, CompareStructs(a, b))
+	})
+
+	t.Run("Map Values Compared Deeply", func(t *testing.T) {
+		a := Shape{Corners: map[string]*Point{"tl": {0, 0}}}
+		b := Shape{Corners: map[string]*Point{"tl": {0, 0}}}
+		assert.True(t, CompareStructs(a, b))
+
+		b.Corners["tl"].X = 1
+		assert.False(t, CompareStructs(a, b))
+	})
+
+	t.Run("Nil and Empty Maps", func(t *testing.T) {
+		assert.True(t, CompareStructs(Shape{}, Shape{}))
+		assert.False(t, CompareStructs(Shape{Labels: map[string]string{}}, Shape{}))
+	})
+
+	t.Run("Arrays Compared Element By Element", func(t *testing.T) {
+		a := Shape{Grid: [2][2]int{{1, 2}, {3, 4}}, Anchors: [2]Point{{1, 1}, {2, 2}}}
+		b := a
+		assert.True(t, CompareStructs(a, b))
+
+		b.Grid[1][0] = 9
+		assert.False(t, CompareStructs(a, b))
+
+		b = a
+		b.Anchors[1].Y = 3
+		assert.False(t, CompareStructs(a, b))
+	})
+
+	t.Run("Interfaces Compared By Dynamic Type And Value", func(t *testing.T) {
+		assert.True(t, CompareStructs(Shape{Meta: Point{1, 2}}, Shape{Meta: Point{1, 2}}))
+		assert.True(t, CompareStructs(Shape{Meta: &Point{1, 2}}, Shape{Meta: &Point{1, 2}}))
+		assert.False(t, CompareStructs(Shape{Meta: Point{1, 2}}, Shape{Meta: Point{2, 1}}))
+		assert.False(t, CompareStructs(Shape{Meta: 1}, Shape{Meta: int64(1)}))
+		assert.False(t, CompareStructs(Shape{Meta: Point{}}, Shape{}))
+		assert.True(t, CompareStructs(Shape{Meta: []int{1}}, Shape{Meta: []int{1}}))
+	})
+}
+
+// TestCompareStructsCycles tests CompareStructs on self-referential structures
+func TestCompareStructsCycles(t *testing.T) {
+	type Node struct {
+		Value int
+		Prev  *Node
+		Next  *Node
+	}
+
+	// list builds a doubly linked list and returns its head
+	list := func(values ...int) *Node {
+		var head, tail *Node
+		for _, v := range values {
+			node := &Node{Value: v, Prev: tail}
+			if tail == nil {
+				head = node
+			} else {
+				tail.Next = node
+			}
+			tail = node
+		}
+		return head
+	}
+
+	t.Run("Equal Doubly Linked Lists", func(t *testing.T) {
+		assert.True(t, CompareStructs(list(1, 2, 3), list(1, 2, 3)))
+	})
+
+	t.Run("Different Doubly Linked Lists", func(t *testing.T) {
+		assert.False(t, CompareStructs(list(1, 2, 3), list(1, 5, 3)))
+		assert.False(t, CompareStructs(list(1, 2, 3), list(1, 2)))
+	})
+
+	t.Run("Self Loop", func(t *testing.T) {
+		a := &Node{Value: 1}
+		a.Next = a
+		b := &Node{Value: 1}
+		b.Next = b
+		assert.True(t, CompareStructs(a, b))
+		assert.True(t, CompareStructs(*a, *b))
+
+		c := &Node{Value: 2}
+		c.Next = c
+		assert.False(t, CompareStructs(a, c))
+	})
+
+	t.Run("Matches DeepEqual", func(t *testing.T) {
+		// A ring of two nodes versus a self loop unrolls to the same infinite value
+		a := &Node{Value: 1}
+		a.Next = &Node{Value: 1, Next: a}
+		b := &Node{Value: 1}
+		b.Next = b
+		assert.Equal(t, reflect.DeepEqual(a, b), CompareStructs(a, b))
+	})
+
+	t.Run("Cyclic Result Report", func(t *testing.T) {
+		result := CompareStructsWithResult(list(1, 2), list(1, 3))
+		assert.False(t, result.Equal)
+		assert.Equal(t, "Next.Value", result.Details.FieldDiffs[0].Path)
+	})
+}
+
 // TestCompareReflectionSlices tests the CompareReflectionSlices function
 func TestCompareReflectionSlices(t *testing.T) {
 	t.Run("Int Slices", func(t *testing.T) {
@@ -120,10 +249,96 @@ func TestCompareReflectionSlices(t *testing.T) {
 		assert.False(t, CompareReflectionSlices(a, b))
 	})
 
-	t.Run("Unsupported Slice Type", func(t *testing.T) {
-		a := reflect.ValueOf([]bool{true, false})
-		b := reflect.ValueOf([]bool{true, false})
-		assert.False(t, CompareReflectionSlices(a, b))
+	t.Run("Bool and Float Slices", func(t *testing.T) {
+		assert.True(t, CompareReflectionSlices(reflect.ValueOf([]bool{true, false}), reflect.ValueOf([]bool{true, false})))
+		assert.False(t, CompareReflectionSlices(reflect.ValueOf([]bool{true}), reflect.ValueOf([]bool{false})))
+		assert.True(t, CompareReflectionSlices(reflect.ValueOf([]float64{1.5}), reflect.ValueOf([]float64{1.5})))
+		assert.False(t, CompareReflectionSlices(reflect.ValueOf([]float64{math.NaN()}), reflect.ValueOf([]float64{math.NaN()})))
+	})
+
+	t.Run("Struct Slices", func(t *testing.T) {
+		type item struct {
+			ID   int
+			Tags []string
+		}
+		a := []item{{1, []string{"x"}}, {2, nil}}
+		b := []item{{1, []string{"x"}}, {2, nil}}
+		assert.True(t, CompareReflectionSlices(reflect.ValueOf(a), reflect.ValueOf(b)))
+
+		b[0].Tags = []string{"y"}
+		assert.False(t, CompareReflectionSlices(reflect.ValueOf(a), reflect.ValueOf(b)))
+	})
+
+	t.Run("Interface Elements", func(t *testing.T) {
+		a := []interface{}{1, "a", []int{1}}
+		b := []interface{}{1, "a", []int{1}}
+		assert.True(t, CompareReflectionSlices(reflect.ValueOf(a), reflect.ValueOf(b)))
+	})
+
+	t.Run("Interface Fields Holding Non-Comparable Values", func(t *testing.T) {
+		type item struct {
+			ID    int
+			Value any
+		}
+		a := []item{{1, []int{1, 2}}}
+		b := []item{{1, []int{1, 2}}}
+		assert.True(t, CompareReflectionSlices(reflect.ValueOf(a), reflect.ValueOf(b)))
+
+		b[0].Value = []int{3}
+		assert.False(t, CompareReflectionSlices(reflect.ValueOf(a), reflect.ValueOf(b)))
+
+		arrA := [][1]any{{map[string]int{"a": 1}}}
+		arrB := [][1]any{{map[string]int{"a": 1}}}
+		assert.True(t, CompareReflectionSlices(reflect.ValueOf(arrA), reflect.ValueOf(arrB)))
+	})
+
+	t.Run("Cyclic Elements", func(t *testing.T) {
+		type node struct {
+			Next *node
+			Data []int
+		}
+		x := &node{Data: []int{1}}
+		x.Next = x
+		y := &node{Data: []int{1}}
+		y.Next = y
+		a := []node{*x}
+		b := []node{*y}
+		assert.True(t, CompareReflectionSlices(reflect.ValueOf(a), reflect.ValueOf(b)))
+	})
+
+	t.Run("Arrays", func(t *testing.T) {
+		assert.True(t, CompareReflectionSlices(reflect.ValueOf([2]int{1, 2}), reflect.ValueOf([2]int{1, 2})))
+	})
+
+	t.Run("Nil and Empty", func(t *testing.T) {
+		assert.True(t, CompareReflectionSlices(reflect.ValueOf([]bool(nil)), reflect.ValueOf([]bool(nil))))
+		assert.False(t, CompareReflectionSlices(reflect.ValueOf([]bool(nil)), reflect.ValueOf([]bool{})))
+		assert.True(t, CompareReflectionSlices(reflect.ValueOf([]bool{}), reflect.ValueOf([]bool{})))
+	})
+}
+
+// TestCompareReflectionSlicesWithError tests the CompareReflectionSlicesWithError function
+func TestCompareReflectionSlicesWithError(t *testing.T) {
+	t.Run("Equal Slices", func(t *testing.T) {
+		equal, err := CompareReflectionSlicesWithError(reflect.ValueOf([]bool{true}), reflect.ValueOf([]bool{true}))
+		assert.NoError(t, err)
+		assert.True(t, equal)
+	})
+
+	t.Run("Unequal Slices", func(t *testing.T) {
+		equal, err := CompareReflectionSlicesWithError(reflect.ValueOf([]int{1}), reflect.ValueOf([]int{2}))
+		assert.NoError(t, err)
+		assert.False(t, equal)
+	})
+
+	t.Run("Non-Slice Values", func(t *testing.T) {
+		_, err := CompareReflectionSlicesWithError(reflect.ValueOf("a"), reflect.ValueOf([]int{}))
+		assert.ErrorIs(t, err, ErrUnsupportedType)
+	})
+
+	t.Run("Different Types", func(t *testing.T) {
+		_, err := CompareReflectionSlicesWithError(reflect.ValueOf([]int{1}), reflect.ValueOf([]int64{1}))
+		assert.ErrorIs(t, err, ErrTypeMismatch)
 	})
 }
 
@@ -208,6 +423,86 @@ func TestCompareSlicesEdgeCases(t *testing.T) {
 	})
 }
 
+// TestCompareSlicesFastPath tests the memory comparison fast path of CompareSlices
+func TestCompareSlicesFastPath(t *testing.T) {
+	t.Run("Byte Int32 And Int64 Slices", func(t *testing.T) {
+		assert.True(t, CompareSlices([]byte("hello"), []byte("hello")))
+		assert.False(t, CompareSlices([]byte("hello"), []byte("hellO")))
+		assert.True(t, CompareSlices([]int32{1, -2, 3}, []int32{1, -2, 3}))
+		assert.False(t, CompareSlices([]int32{1, -2, 3}, []int32{1, 2, 3}))
+		assert.True(t, CompareSlices([]int64{math.MaxInt64, math.MinInt64}, []int64{math.MaxInt64, math.MinInt64}))
+		assert.False(t, CompareSlices([]int64{0, 1 << 40}, []int64{0, 1 << 41}))
+		assert.False(t, CompareSlices([]bool{true, false}, []bool{true, true}))
+	})
+
+	t.Run("Difference In Last Element", func(t *testing.T) {
+		a := make([]int64, 1027)
+		b := make([]int64, 1027)
+		assert.True(t, CompareSlices(a, b))
+		b[len(b)-1] = 1
+		assert.False(t, CompareSlices(a, b))
+	})
+
+	t.Run("Sub Slices Of The Same Array", func(t *testing.T) {
+		data := []int32{1, 2, 1, 2, 3}
+		assert.True(t, CompareSlices(data[0:2], data[2:4]))
+		assert.False(t, CompareSlices(data[1:3], data[2:4]))
+	})
+
+	t.Run("Nil and Empty", func(t *testing.T) {
+		assert.True(t, CompareSlices([]byte(nil), []byte(nil)))
+		assert.False(t, CompareSlices([]byte(nil), []byte{}))
+		assert.True(t, CompareSlices([]int64{}, []int64{}))
+	})
+
+	t.Run("Floats Keep Value Semantics", func(t *testing.T) {
+		// -0 and +0 differ in memory but are equal, NaN has identical memory but is not
+		assert.True(t, CompareSlices([]float64{math.Copysign(0, -1)}, []float64{0}))
+		assert.False(t, CompareSlices([]float64{math.NaN()}, []float64{math.NaN()}))
+	})
+
+	t.Run("Selection", func(t *testing.T) {
+		type id int64
+		_, ok := compareSlicesMemory([]int64{1}, []int64{1})
+		assert.True(t, ok)
+		_, ok = compareSlicesMemory([]float32{1}, []float32{1})
+		assert.False(t, ok)
+		_, ok = compareSlicesMemory([]string{"a"}, []string{"a"})
+		assert.False(t, ok)
+		_, ok = compareSlicesMemory([]id{1}, []id{1})
+		assert.False(t, ok)
+		assert.True(t, CompareSlices([]id{1, 2}, []id{1, 2}))
+	})
+}
+
+// TestEqualBy tests the EqualBy function
+func TestEqualBy(t *testing.T) {
+	t.Run("Normalized Strings", func(t *testing.T) {
+		norm := func(s string) string { return strings.ToLower(strings.TrimSpace(s)) }
+		a := []string{" Apple", "banana"}
+		b := []string{"apple", "BANANA "}
+
+		assert.True(t, EqualBy(a, b, norm))
+		assert.False(t, EqualBy(a, []string{"banana", "apple"}, norm))
+	})
+
+	t.Run("Rounded Floats", func(t *testing.T) {
+		norm := func(f float64) float64 { return math.Round(f*100) / 100 }
+		assert.True(t, EqualBy([]float64{1.001, 2.499}, []float64{1.0, 2.5}, norm))
+		assert.False(t, EqualBy([]float64{1.01}, []float64{1.02}, norm))
+	})
+
+	t.Run("Different Lengths", func(t *testing.T) {
+		assert.False(t, EqualBy([]int{1}, []int{1, 2}, func(v int) int { return v }))
+	})
+
+	t.Run("Nil Slices", func(t *testing.T) {
+		id := func(v int) int { return v }
+		assert.True(t, EqualBy[int](nil, nil, id))
+		assert.False(t, EqualBy(nil, []int{}, id))
+	})
+}
+
 // TestCompareSlicesWithResultEdgeCases tests edge cases for detailed comparison
 func TestCompareSlicesWithResultEdgeCases(t *testing.T) {
 	t.Run("Large Difference Count", func(t *testing.T) {
@@ -222,14 +517,236 @@ func TestCompareSlicesWithResultEdgeCases(t *testing.T) {
 
 		result := CompareSlicesWithResult(a, b)
 		assert.False(t, result.Equal)
-		assert.Equal(t, size, result.Details["difference_count"])
+		assert.Equal(t, size, result.Details.DifferenceCount)
 	})
 
 	t.Run("Mixed Nil and Non-Nil", func(t *testing.T) {
 		result := CompareSlicesWithResult[int](nil, []int{1, 2, 3})
 		assert.False(t, result.Equal)
-		assert.True(t, result.Details["a_nil"].(bool))
-		assert.False(t, result.Details["b_nil"].(bool))
+		assert.True(t, result.Details.ANil)
+		assert.False(t, result.Details.BNil)
+	})
+}
+
+// TestCompareSlicesWithResultMismatches tests the element-level details of slice comparison
+func TestCompareSlicesWithResultMismatches(t *testing.T) {
+	t.Run("Reports Differing Values", func(t *testing.T) {
+		a := []string{"a", "b", "c"}
+		b := []string{"a", "x", "y"}
+		result := CompareSlicesWithResult(a, b)
+
+		expected := []ElementDiff[string]{
+			{Index: 1, AValue: "b", BValue: "x"},
+			{Index: 2, AValue: "c", BValue: "y"},
+		}
+		assert.Equal(t, expected, result.Details.Extra["mismatches"])
+		assert.False(t, result.Details.Truncated)
+	})
+
+	t.Run("Caps Recorded Differences", func(t *testing.T) {
+		a := []int{1, 2, 3, 4}
+		b := []int{0, 0, 0, 0}
+		result := CompareSlicesWithResultMax(a, b, 2)
+
+		assert.False(t, result.Equal)
+		assert.Equal(t, 4, result.Details.DifferenceCount)
+		assert.Equal(t, []int{0, 1}, result.Details.DifferenceIndices)
+		assert.Len(t, result.Details.Extra["mismatches"], 2)
+		assert.True(t, result.Details.Truncated)
+	})
+
+	t.Run("Cap Not Reached", func(t *testing.T) {
+		result := CompareSlicesWithResultMax([]int{1, 2}, []int{1, 3}, 5)
+
+		assert.Equal(t, 1, result.Details.DifferenceCount)
+		assert.False(t, result.Details.Truncated)
+	})
+
+	t.Run("Equal Slices Have No Details", func(t *testing.T) {
+		result := CompareSlicesWithResultMax([]int{1, 2}, []int{1, 2}, 1)
+
+		assert.True(t, result.Equal)
+		assert.Empty(t, result.Details)
+	})
+}
+
+// TestCompareSlicesUnordered tests the multiset comparison functions
+func TestCompareSlicesUnordered(t *testing.T) {
+	t.Run("Same Elements Different Order", func(t *testing.T) {
+		assert.True(t, CompareSlicesUnordered([]int{1, 2, 2, 3}, []int{2, 3, 1, 2}))
+		assert.True(t, CompareSlicesUnordered([]string{}, []string{}))
+	})
+
+	t.Run("Different Counts", func(t *testing.T) {
+		assert.False(t, CompareSlicesUnordered([]int{1, 1, 2}, []int{1, 2, 2}))
+		assert.False(t, CompareSlicesUnordered([]int{1, 2}, []int{1, 2, 2}))
+	})
+
+	t.Run("Nil Slices", func(t *testing.T) {
+		assert.True(t, CompareSlicesUnordered[int](nil, nil))
+		assert.False(t, CompareSlicesUnordered(nil, []int{}))
+	})
+
+	t.Run("With Result", func(t *testing.T) {
+		result := CompareSlicesUnorderedWithResult([]int{1, 2, 3}, []int{3, 2, 1})
+		assert.True(t, result.Equal)
+		assert.Empty(t, result.Details)
+
+		result = CompareSlicesUnorderedWithResult([]int{1, 1, 2}, []int{1, 2, 2, 4})
+		assert.False(t, result.Equal)
+		assert.Equal(t, "Slices contain different element counts", result.Message)
+		assert.Equal(t, map[int]int{1: 1, 2: -1, 4: -1}, result.Details.Extra["count_differences"])
+		assert.Equal(t, 3, result.Details.DifferenceCount)
+	})
+
+	t.Run("With Result Nil", func(t *testing.T) {
+		result := CompareSlicesUnorderedWithResult([]int{1}, nil)
+		assert.False(t, result.Equal)
+		assert.False(t, result.Details.ANil)
+		assert.True(t, result.Details.BNil)
+	})
+}
+
+// TestCompareSlicesFunc tests the predicate-based comparison functions
+func TestCompareSlicesFunc(t *testing.T) {
+	type user struct {
+		ID   int
+		Name string
+	}
+	sameID := func(x, y user) bool { return x.ID == y.ID }
+
+	t.Run("Custom Equality", func(t *testing.T) {
+		a := []user{{1, "Alice"}, {2, "Bob"}}
+		b := []user{{1, "Alicia"}, {2, "Robert"}}
+
+		assert.True(t, CompareSlicesFunc(a, b, sameID))
+		assert.False(t, CompareSlicesFunc(a, []user{{2, "Bob"}, {1, "Alice"}}, sameID))
+		assert.False(t, CompareSlicesFunc(a, a[:1], sameID))
+	})
+
+	t.Run("Nil Slices", func(t *testing.T) {
+		assert.True(t, CompareSlicesFunc[user](nil, nil, sameID))
+		assert.False(t, CompareSlicesFunc(nil, []user{}, sameID))
+	})
+
+	t.Run("With Result", func(t *testing.T) {
+		a := []user{{1, "Alice"}, {2, "Bob"}}
+		b := []user{{1, "Alice"}, {3, "Bob"}}
+		result := CompareSlicesFuncWithResult(a, b, sameID)
+
+		assert.False(t, result.Equal)
+		assert.Equal(t, "Slices differ at specific indices", result.Message)
+		assert.Equal(t, []int{1}, result.Details.DifferenceIndices)
+		assert.Equal(t, []ElementDiff[user]{{Index: 1, AValue: user{2, "Bob"}, BValue: user{3, "Bob"}}}, result.Details.Extra["mismatches"])
+
+		result = CompareSlicesFuncWithResult(a, a, sameID)
+		assert.True(t, result.Equal)
+	})
+}
+
+// TestCompareSlicesApprox tests the CompareSlicesApprox function
+func TestCompareSlicesApprox(t *testing.T) {
+	t.Run("Within Tolerance", func(t *testing.T) {
+		tenth := 0.1
+		a := []float64{tenth + 0.2, 1.0}
+		b := []float64{0.3, 1.0}
+
+		assert.False(t, CompareSlices(a, b))
+		assert.True(t, CompareSlicesApprox(a, b, 1e-9))
+	})
+
+	t.Run("Outside Tolerance", func(t *testing.T) {
+		assert.False(t, CompareSlicesApprox([]float64{1.0}, []float64{1.1}, 0.01))
+	})
+
+	t.Run("Special Values", func(t *testing.T) {
+		assert.True(t, CompareSlicesApprox([]float64{math.Inf(1)}, []float64{math.Inf(1)}, 0.1))
+		assert.False(t, CompareSlicesApprox([]float64{math.NaN()}, []float64{math.NaN()}, 0.1))
+	})
+
+	t.Run("Nil and Length Mismatch", func(t *testing.T) {
+		assert.True(t, CompareSlicesApprox(nil, nil, 0.1))
+		assert.False(t, CompareSlicesApprox(nil, []float64{}, 0.1))
+		assert.False(t, CompareSlicesApprox([]float64{1}, []float64{1, 2}, 0.1))
+	})
+}
+
+// TestCompareStructsApprox tests the CompareStructsApprox function
+func TestCompareStructsApprox(t *testing.T) {
+	type Point struct {
+		X, Y float64
+	}
+	type Shape struct {
+		Name   string
+		Center *Point
+		Points []Point
+		Scale  [2]float32
+		Weight float64
+	}
+
+	t.Run("Nested Floats Within Tolerance", func(t *testing.T) {
+		tenth := 0.1
+		a := Shape{Name: "tri", Center: &Point{tenth + 0.2, 1}, Points: []Point{{1, 2.0000001}}, Scale: [2]float32{1, 2}, Weight: 3}
+		b := Shape{Name: "tri", Center: &Point{0.3, 1}, Points: []Point{{1, 2}}, Scale: [2]float32{1, 2.0000001}, Weight: 3.0000001}
+
+		assert.False(t, CompareStructs(a, b))
+		assert.True(t, CompareStructsApprox(a, b, 1e-6))
+	})
+
+	t.Run("Non-Float Fields Compared Exactly", func(t *testing.T) {
+		a := Shape{Name: "tri", Weight: 1}
+		b := Shape{Name: "square", Weight: 1}
+		assert.False(t, CompareStructsApprox(a, b, 1))
+	})
+
+	t.Run("Outside Tolerance", func(t *testing.T) {
+		assert.False(t, CompareStructsApprox(Point{1, 1}, Point{1, 1.5}, 0.1))
+		assert.False(t, CompareStructsApprox(&Point{1, 1}, &Point{1, 1.5}, 0.1))
+	})
+
+	t.Run("Skipped Fields", func(t *testing.T) {
+		type Sample struct {
+			Value    float64
+			Revision int `sliceutil:"-"`
+		}
+		a := Sample{Value: 1, Revision: 1}
+		b := Sample{Value: 1.0000001, Revision: 2}
+
+		assert.True(t, CompareStructs(Sample{1, 1}, Sample{1, 2}))
+		assert.True(t, CompareStructsApprox(a, b, 1e-6))
+	})
+
+	t.Run("Cyclic Structures", func(t *testing.T) {
+		type Node struct {
+			V    float64
+			Next *Node
+		}
+		a := &Node{V: 1}
+		a.Next = a
+		b := &Node{V: 1.0000001}
+		b.Next = b
+
+		assert.True(t, CompareStructsApprox(a, b, 1e-6))
+		assert.False(t, CompareStructsApprox(a, b, 1e-9))
+	})
+
+	t.Run("Maps And Interfaces", func(t *testing.T) {
+		type Reading struct {
+			Values map[string]float64
+			Any    interface{}
+		}
+		a := Reading{Values: map[string]float64{"x": 1.0000001}, Any: Point{2, 3}}
+		b := Reading{Values: map[string]float64{"x": 1}, Any: Point{2, 3.0000001}}
+
+		assert.True(t, CompareStructsApprox(a, b, 1e-6))
+		assert.False(t, CompareStructsApprox(a, b, 1e-9))
+	})
+
+	t.Run("Nil And Mismatched Types", func(t *testing.T) {
+		assert.True(t, CompareStructsApprox(nil, nil, 0.1))
+		assert.False(t, CompareStructsApprox(Point{}, nil, 0.1))
+		assert.False(t, CompareStructsApprox(Point{}, &Point{}, 0.1))
+		assert.False(t, CompareStructsApprox(Shape{Center: &Point{}}, Shape{}, 0.1))
 	})
 }
 
@@ -337,3 +854,79 @@ func TestErrorHandling(t *testing.T) {
 		})
 	})
 }
+
+// BenchmarkCompareSlices compares the memory fast path of CompareSlices with the
+// element loop used for other types. The loop is exercised through a named integer
+// type, which is deliberately not part of the fast path.
+func BenchmarkCompareSlices(b *testing.B) {
+	type id int64
+
+	for _, size := range []int{16, 1024, 65536} {
+		bytesA, bytesB := make([]byte, size), make([]byte, size)
+		int32A, int32B := make([]int32, size), make([]int32, size)
+		int64A, int64B := make([]int64, size), make([]int64, size)
+		idA, idB := make([]id, size), make([]id, size)
+		for i := range size {
+			bytesA[i], bytesB[i] = byte(i), byte(i)
+			int32A[i], int32B[i] = int32(i), int32(i)
+			int64A[i], int64B[i] = int64(i), int64(i)
+			idA[i], idB[i] = id(i), id(i)
+		}
+
+		b.Run(fmt.Sprintf("Bytes/%d", size), func(b *testing.B) {
+			for b.Loop() {
+				CompareSlices(bytesA, bytesB)
+			}
+		})
+		b.Run(fmt.Sprintf("Int32/%d", size), func(b *testing.B) {
+			for b.Loop() {
+				CompareSlices(int32A, int32B)
+			}
+		})
+		b.Run(fmt.Sprintf("Int64/%d", size), func(b *testing.B) {
+			for b.Loop() {
+				CompareSlices(int64A, int64B)
+			}
+		})
+		b.Run(fmt.Sprintf("Int64Loop/%d", size), func(b *testing.B) {
+			for b.Loop() {
+				CompareSlices(idA, idB)
+			}
+		})
+	}
+}
+
+// BenchmarkCompareSlices_SmallMediumLarge benchmarks comparing two equal int slices,
+// which is the worst case because every element is inspected
+func BenchmarkCompareSlices_SmallMediumLarge(b *testing.B) {
+	benchcmp.SmallMediumLarge(b, func(b *testing.B, n int) {
+		x, y := Range(0, n), Range(0, n)
+		for b.Loop() {
+			CompareSlices(x, y)
+		}
+	})
+}
+
+// BenchmarkCompareSlicesUnordered_SmallMediumLarge benchmarks comparing an int slice
+// with its reverse
+func BenchmarkCompareSlicesUnordered_SmallMediumLarge(b *testing.B) {
+	benchcmp.SmallMediumLarge(b, func(b *testing.B, n int) {
+		x := Range(0, n)
+		y := ReverseCopy(x)
+		for b.Loop() {
+			CompareSlicesUnordered(x, y)
+		}
+	})
+}
+
+// BenchmarkCompareSlicesWithResult_SmallMediumLarge benchmarks the detailed comparison
+// of two int slices that differ only in their last element
+func BenchmarkCompareSlicesWithResult_SmallMediumLarge(b *testing.B) {
+	benchcmp.SmallMediumLarge(b, func(b *testing.B, n int) {
+		x, y := Range(0, n), Range(0, n)
+		y[n-1] = -1
+		for b.Loop() {
+			CompareSlicesWithResult(x, y)
+		}
+	})
+}
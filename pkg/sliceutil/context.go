@@ -0,0 +1,143 @@
+package sliceutil
+
+import (
+	"cmp"
+	"context"
+)
+
+// ctxCheckInterval is the number of elements the context-aware functions process
+// between checks of the context, which keeps the cost of checking negligible.
+const ctxCheckInterval = 1 << 16
+
+// CompareSlicesCtx checks if two slices are equal in values and order, like CompareSlices,
+// but can be aborted. The context is checked periodically, and when it is done the
+// function returns false and ctx.Err(), such as context.Canceled or
+// context.DeadlineExceeded.
+//
+// Example:
+//
+//	ctx, cancel := context.WithTimeout(ctx, time.Second)
+//	defer cancel()
+//	equal, err := CompareSlicesCtx(ctx, hugeA, hugeB)
+func CompareSlicesCtx[T comparable](ctx context.Context, a, b []T) (bool, error) {
+	if err := ctx.Err(); err != nil {
+		return false, err
+	}
+
+	// Check for nil slices
+	if a == nil || b == nil {
+		return a == nil && b == nil, nil
+	}
+
+	if len(a) != len(b) {
+		return false, nil
+	}
+
+	for start := 0; start < len(a); start += ctxCheckInterval {
+		if err := ctx.Err(); err != nil {
+			return false, err
+		}
+		end := min(start+ctxCheckInterval, len(a))
+		if !CompareSlices(a[start:end], b[start:end]) {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// FindDifferencesCtx returns the unique values from both slices that are not in the
+// other, like FindDifferences, but can be aborted. The context is checked periodically,
+// and when it is done the function returns nil and ctx.Err().
+//
+// Example:
+//
+//	diff, err := FindDifferencesCtx(r.Context(), stored, incoming)
+//	if errors.Is(err, context.Canceled) {
+//		return
+//	}
+func FindDifferencesCtx[T comparable](ctx context.Context, a, b []T) ([]T, error) {
+	return findDifferences(ctx, a, b)
+}
+
+// SortCtx sorts a slice of an ordered type in place in the specified order, like Sort,
+// but can be aborted. The slice is sorted in blocks that are then merged, and the context
+// is checked between blocks and periodically during merges. When the context is done,
+// the function returns ctx.Err() and the slice holds its original elements in an
+// unspecified order. Like Sort, OrderNone leaves the slice unchanged.
+//
+// Time complexity: O(n log n) where n is the length of the slice
+// Space complexity: O(n) for the merge buffer
+//
+// Example:
+//
+//	if err := SortCtx(ctx, readings, OrderAsc); err != nil {
+//		return err
+//	}
+func SortCtx[T cmp.Ordered](ctx context.Context, a []T, order OrderType) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if order == OrderNone {
+		return nil
+	}
+
+	// Sort blocks that are small enough to finish between two context checks
+	for start := 0; start < len(a); start += ctxCheckInterval {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		Sort(a[start:min(start+ctxCheckInterval, len(a))], order)
+	}
+	if len(a) <= ctxCheckInterval {
+		return nil
+	}
+
+	less := func(x, y T) bool { return cmp.Less(x, y) }
+	if order == OrderDesc {
+		less = func(x, y T) bool { return cmp.Less(y, x) }
+	}
+
+	// Merge sorted runs bottom-up, alternating between the slice and a buffer.
+	// src always holds every element, so it can be copied back on cancellation.
+	src, dst := a, make([]T, len(a))
+	for width := ctxCheckInterval; width < len(a); width *= 2 {
+		for lo := 0; lo < len(a); lo += 2 * width {
+			mid := min(lo+width, len(a))
+			hi := min(lo+2*width, len(a))
+			if err := mergeRunsCtx(ctx, dst[lo:hi], src[lo:mid], src[mid:hi], less); err != nil {
+				if &src[0] != &a[0] {
+					copy(a, src)
+				}
+				return err
+			}
+		}
+		src, dst = dst, src
+	}
+
+	if &src[0] != &a[0] {
+		copy(a, src)
+	}
+	return nil
+}
+
+// mergeRunsCtx is a helper function that merges two sorted runs into dst, taking
+// from left on ties, and checks the context periodically.
+func mergeRunsCtx[T any](ctx context.Context, dst, left, right []T, less func(x, y T) bool) error {
+	i, j := 0, 0
+	for k := range dst {
+		if k%ctxCheckInterval == 0 {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+		}
+		if j >= len(right) || (i < len(left) && !less(right[j], left[i])) {
+			dst[k] = left[i]
+			i++
+		} else {
+			dst[k] = right[j]
+			j++
+		}
+	}
+	return nil
+}
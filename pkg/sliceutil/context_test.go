@@ -0,0 +1,170 @@
+package sliceutil
+
+import (
+	"context"
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// countdownCtx is a context that reports cancellation after its Err method has been
+// called a given number of times, which makes cancellation during an operation deterministic.
+type countdownCtx struct {
+	context.Context
+	remaining int
+}
+
+func (c *countdownCtx) Err() error {
+	if c.remaining <= 0 {
+		return context.Canceled
+	}
+	c.remaining--
+	return nil
+}
+
+// cancelledCtx returns a context that is already cancelled.
+func cancelledCtx() context.Context {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	return ctx
+}
+
+// TestCompareSlicesCtx tests the CompareSlicesCtx function
+func TestCompareSlicesCtx(t *testing.T) {
+	large := Range(0, 3*ctxCheckInterval+7)
+
+	t.Run("Equal and Different", func(t *testing.T) {
+		equal, err := CompareSlicesCtx(context.Background(), large, slices.Clone(large))
+		require.NoError(t, err)
+		assert.True(t, equal)
+
+		other := slices.Clone(large)
+		other[len(other)-1] = -1
+		equal, err = CompareSlicesCtx(context.Background(), large, other)
+		require.NoError(t, err)
+		assert.False(t, equal)
+
+		equal, err = CompareSlicesCtx(context.Background(), []int{1}, []int{1, 2})
+		require.NoError(t, err)
+		assert.False(t, equal)
+	})
+
+	t.Run("Nil Slices", func(t *testing.T) {
+		equal, err := CompareSlicesCtx[int](context.Background(), nil, nil)
+		require.NoError(t, err)
+		assert.True(t, equal)
+
+		equal, err = CompareSlicesCtx(context.Background(), nil, []int{})
+		require.NoError(t, err)
+		assert.False(t, equal)
+	})
+
+	t.Run("Cancelled", func(t *testing.T) {
+		_, err := CompareSlicesCtx(cancelledCtx(), []int{1}, []int{1})
+		assert.ErrorIs(t, err, context.Canceled)
+
+		ctx := &countdownCtx{Context: context.Background(), remaining: 2}
+		equal, err := CompareSlicesCtx(ctx, large, slices.Clone(large))
+		assert.ErrorIs(t, err, context.Canceled)
+		assert.False(t, equal)
+	})
+}
+
+// TestFindDifferencesCtx tests the FindDifferencesCtx function
+func TestFindDifferencesCtx(t *testing.T) {
+	t.Run("Matches FindDifferences", func(t *testing.T) {
+		a := []int{1, 2, 3, 4}
+		b := []int{3, 4, 5, 6}
+		result, err := FindDifferencesCtx(context.Background(), a, b)
+		require.NoError(t, err)
+		assert.ElementsMatch(t, FindDifferences(a, b), result)
+	})
+
+	t.Run("Nil Slices", func(t *testing.T) {
+		result, err := FindDifferencesCtx(context.Background(), nil, []int{1})
+		require.NoError(t, err)
+		assert.Equal(t, []int{1}, result)
+	})
+
+	t.Run("Cancelled", func(t *testing.T) {
+		result, err := FindDifferencesCtx(cancelledCtx(), []int{1}, []int{2})
+		assert.ErrorIs(t, err, context.Canceled)
+		assert.Nil(t, result)
+
+		large := Range(0, 2*ctxCheckInterval)
+		ctx := &countdownCtx{Context: context.Background(), remaining: 2}
+		_, err = FindDifferencesCtx(ctx, large, large)
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+}
+
+// TestSortCtx tests the SortCtx function
+func TestSortCtx(t *testing.T) {
+	rng := rand.New(rand.NewPCG(1, 2))
+	random := func(n int) []int {
+		return Generate(n, func(int) int { return rng.IntN(n) })
+	}
+
+	t.Run("Small Slice", func(t *testing.T) {
+		s := []int{3, 1, 2}
+		require.NoError(t, SortCtx(context.Background(), s, OrderAsc))
+		assert.Equal(t, []int{1, 2, 3}, s)
+
+		require.NoError(t, SortCtx(context.Background(), s, OrderDesc))
+		assert.Equal(t, []int{3, 2, 1}, s)
+	})
+
+	t.Run("Large Slice", func(t *testing.T) {
+		for _, n := range []int{ctxCheckInterval + 1, 2 * ctxCheckInterval, 5*ctxCheckInterval + 17} {
+			s := random(n)
+			expected := slices.Sorted(slices.Values(s))
+
+			require.NoError(t, SortCtx(context.Background(), s, OrderAsc))
+			assert.Equal(t, expected, s)
+
+			require.NoError(t, SortCtx(context.Background(), s, OrderDesc))
+			slices.Reverse(expected)
+			assert.Equal(t, expected, s)
+		}
+	})
+
+	t.Run("OrderNone Leaves Slice Unchanged", func(t *testing.T) {
+		for _, n := range []int{3, 2*ctxCheckInterval + 5} {
+			s := random(n)
+			expected := slices.Clone(s)
+			require.NoError(t, SortCtx(context.Background(), s, OrderNone))
+			assert.Equal(t, expected, s)
+		}
+	})
+
+	t.Run("Strings", func(t *testing.T) {
+		s := []string{"pear", "apple", "fig"}
+		require.NoError(t, SortCtx(context.Background(), s, OrderAsc))
+		assert.Equal(t, []string{"apple", "fig", "pear"}, s)
+	})
+
+	t.Run("Cancelled Before Start", func(t *testing.T) {
+		s := []int{3, 1, 2}
+		assert.ErrorIs(t, SortCtx(cancelledCtx(), s, OrderAsc), context.Canceled)
+		assert.Equal(t, []int{3, 1, 2}, s)
+	})
+
+	t.Run("Cancelled During Merge Keeps Elements", func(t *testing.T) {
+		for remaining := 5; remaining < 20; remaining += 3 {
+			s := random(4*ctxCheckInterval + 3)
+			expected := slices.Sorted(slices.Values(s))
+
+			ctx := &countdownCtx{Context: context.Background(), remaining: remaining}
+			assert.ErrorIs(t, SortCtx(ctx, s, OrderAsc), context.Canceled)
+			assert.Equal(t, expected, slices.Sorted(slices.Values(s)))
+		}
+	})
+
+	t.Run("Nil and Empty", func(t *testing.T) {
+		assert.NoError(t, SortCtx[int](context.Background(), nil, OrderAsc))
+		assert.NoError(t, SortCtx(context.Background(), []int{}, OrderAsc))
+	})
+}
@@ -0,0 +1,97 @@
+package sliceutil
+
+import (
+	"cmp"
+	"maps"
+	"slices"
+)
+
+// ToMap builds a map from a slice, using kf for the key and vf for the value of each
+// element. When several elements share a key, the last one wins.
+//
+// Time complexity: O(n) where n is the length of the slice
+// Space complexity: O(n) for the result map
+//
+// Example:
+//
+//	names := ToMap(users, func(u User) int { return u.ID }, func(u User) string { return u.Name })
+//	// returns map[1:Alice 2:Bob]
+func ToMap[T any, K comparable, V any](s []T, kf func(T) K, vf func(T) V) map[K]V {
+	result := make(map[K]V, len(s))
+	for _, v := range s {
+		result[kf(v)] = vf(v)
+	}
+	return result
+}
+
+// ToSet returns the distinct elements of a slice as a map for constant-time lookups.
+//
+// Example:
+//
+//	allowed := ToSet([]string{"read", "write"})
+//	_, ok := allowed["write"] // ok is true
+func ToSet[T comparable](s []T) map[T]struct{} {
+	result := make(map[T]struct{}, len(s))
+	for _, v := range s {
+		result[v] = struct{}{}
+	}
+	return result
+}
+
+// Keys returns the keys of a map as a slice in the unspecified iteration order of the
+// map. The result is never nil.
+//
+// Time complexity: O(n) where n is the size of the map
+// Space complexity: O(n) for the result slice
+//
+// Example:
+//
+//	keys := Keys(map[string]int{"b": 2, "a": 1}) // returns the keys in any order
+func Keys[K comparable, V any](m map[K]V) []K {
+	return slices.AppendSeq(make([]K, 0, len(m)), maps.Keys(m))
+}
+
+// Values returns the values of a map as a slice in the unspecified iteration order of
+// the map. The result is never nil.
+//
+// Time complexity: O(n) where n is the size of the map
+// Space complexity: O(n) for the result slice
+//
+// Example:
+//
+//	scores := Values(map[string]int{"alice": 7, "bob": 3}) // returns the values in any order
+func Values[K comparable, V any](m map[K]V) []V {
+	return slices.AppendSeq(make([]V, 0, len(m)), maps.Values(m))
+}
+
+// SortedKeys returns the keys of a map as a slice sorted in the given order. With
+// OrderNone the keys are returned in the unspecified iteration order of the map, as
+// with Keys. The result is never nil.
+//
+// Time complexity: O(n log n) where n is the size of the map, O(n) with OrderNone
+// Space complexity: O(n) for the result slice
+//
+// Example:
+//
+//	keys := SortedKeys(map[string]int{"b": 2, "a": 1}, OrderAsc) // returns []string{"a", "b"}
+func SortedKeys[K cmp.Ordered, V any](m map[K]V, order OrderType) []K {
+	keys := Keys(m)
+	Sort(keys, order)
+	return keys
+}
+
+// SortedValues returns the values of a map as a slice sorted in the given order. With
+// OrderNone the values are returned in the unspecified iteration order of the map, as
+// with Values. The result is never nil.
+//
+// Time complexity: O(n log n) where n is the size of the map, O(n) with OrderNone
+// Space complexity: O(n) for the result slice
+//
+// Example:
+//
+//	scores := SortedValues(map[string]int{"alice": 7, "bob": 3}, OrderDesc) // returns []int{7, 3}
+func SortedValues[K comparable, V cmp.Ordered](m map[K]V, order OrderType) []V {
+	values := Values(m)
+	Sort(values, order)
+	return values
+}
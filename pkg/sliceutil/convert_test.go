@@ -0,0 +1,86 @@
+package sliceutil
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestToMap tests the ToMap function
+func TestToMap(t *testing.T) {
+	type User struct {
+		ID   int
+		Name string
+	}
+	id := func(u User) int { return u.ID }
+	name := func(u User) string { return u.Name }
+
+	t.Run("Builds Map", func(t *testing.T) {
+		users := []User{{1, "Alice"}, {2, "Bob"}}
+		assert.Equal(t, map[int]string{1: "Alice", 2: "Bob"}, ToMap(users, id, name))
+	})
+
+	t.Run("Last Duplicate Wins", func(t *testing.T) {
+		users := []User{{1, "Alice"}, {1, "Alicia"}}
+		assert.Equal(t, map[int]string{1: "Alicia"}, ToMap(users, id, name))
+	})
+
+	t.Run("Nil and Empty", func(t *testing.T) {
+		assert.Equal(t, map[int]string{}, ToMap(nil, id, name))
+	})
+}
+
+// TestToSet tests the ToSet function
+func TestToSet(t *testing.T) {
+	t.Run("Distinct Elements", func(t *testing.T) {
+		assert.Equal(t, map[string]struct{}{"a": {}, "b": {}}, ToSet([]string{"a", "b", "a"}))
+	})
+
+	t.Run("Nil and Empty", func(t *testing.T) {
+		assert.Equal(t, map[int]struct{}{}, ToSet[int](nil))
+	})
+}
+
+// TestKeys tests the Keys and Values functions
+func TestKeys(t *testing.T) {
+	m := map[string]int{"b": 2, "a": 1, "c": 2}
+
+	t.Run("Unsorted", func(t *testing.T) {
+		assert.ElementsMatch(t, []string{"a", "b", "c"}, Keys(m))
+		assert.ElementsMatch(t, []int{1, 2, 2}, Values(m))
+	})
+
+	t.Run("Non-Ordered Types", func(t *testing.T) {
+		type point struct{ X, Y int }
+		pm := map[point][]string{{1, 2}: {"a"}, {3, 4}: {"b"}}
+		assert.ElementsMatch(t, []point{{1, 2}, {3, 4}}, Keys(pm))
+		assert.ElementsMatch(t, [][]string{{"a"}, {"b"}}, Values(pm))
+	})
+
+	t.Run("Nil and Empty", func(t *testing.T) {
+		assert.Equal(t, []string{}, Keys[string, int](nil))
+		assert.Equal(t, []int{}, Values[string, int](nil))
+	})
+}
+
+// TestSortedKeys tests the SortedKeys and SortedValues functions
+func TestSortedKeys(t *testing.T) {
+	m := map[string]int{"b": 2, "a": 1, "c": 2}
+
+	t.Run("Sorted", func(t *testing.T) {
+		assert.Equal(t, []string{"a", "b", "c"}, SortedKeys(m, OrderAsc))
+		assert.Equal(t, []string{"c", "b", "a"}, SortedKeys(m, OrderDesc))
+		assert.Equal(t, []int{1, 2, 2}, SortedValues(m, OrderAsc))
+		assert.Equal(t, []int{2, 2, 1}, SortedValues(m, OrderDesc))
+	})
+
+	t.Run("Unsorted", func(t *testing.T) {
+		assert.ElementsMatch(t, []string{"a", "b", "c"}, SortedKeys(m, OrderNone))
+		assert.ElementsMatch(t, []int{1, 2, 2}, SortedValues(m, OrderNone))
+	})
+
+	t.Run("Nil and Empty", func(t *testing.T) {
+		assert.Equal(t, []string{}, SortedKeys[string, int](nil, OrderAsc))
+		assert.Equal(t, []int{}, SortedValues[string, int](nil, OrderAsc))
+	})
+}
@@ -0,0 +1,132 @@
+package sliceutil
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// ReadSliceCSV reads CSV data and returns the values of the column with the given
+// zero-based index, one per record, so the column can be passed to the statistics and
+// comparison functions. Records may have different numbers of fields, but every record
+// must contain the column. A header row is returned like any other record; drop it
+// with values[1:] if present.
+//
+// The function returns ErrOutOfRange if col is negative or a record is too short, and
+// the error of the CSV parser if the input is malformed. The result is never nil.
+//
+// Example:
+//
+//	column, err := ReadSliceCSV(file, 2)
+//	if err != nil || len(column) == 0 {
+//		return err
+//	}
+//	prices, err := ParseFloats(column[1:]) // skip the header
+//	stats, err := GetSliceStatsFloat64(prices)
+func ReadSliceCSV(r io.Reader, col int) ([]string, error) {
+	if col < 0 {
+		return nil, fmt.Errorf("%w: column %d", ErrOutOfRange, col)
+	}
+
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.ReuseRecord = true
+
+	values := make([]string, 0)
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return values, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		if col >= len(record) {
+			line, _ := reader.FieldPos(0)
+			return nil, fmt.Errorf("%w: column %d in record on line %d with %d fields", ErrOutOfRange, col, line, len(record))
+		}
+		values = append(values, record[col])
+	}
+}
+
+// WriteSliceCSV writes the elements of a slice as CSV data with one element per
+// record, the format read by ReadSliceCSV. Elements are formatted with fmt.Sprint,
+// which writes floats in the shortest form that parses back to the same value.
+// Empty elements are written as "" because CSV readers skip blank lines, so every
+// element is read back as its own record.
+//
+// Example:
+//
+//	err := WriteSliceCSV(os.Stdout, []float64{1.5, 2, 3.25})
+//	// 1.5
+//	// 2
+//	// 3.25
+func WriteSliceCSV[T any](w io.Writer, s []T) error {
+	writer := csv.NewWriter(w)
+	record := make([]string, 1)
+	for _, v := range s {
+		record[0] = fmt.Sprint(v)
+		if record[0] == "" {
+			// encoding/csv writes a lone empty field as a blank line, which readers skip
+			writer.Flush()
+			if err := writer.Error(); err != nil {
+				return err
+			}
+			if _, err := io.WriteString(w, "\"\"\n"); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+// ParseInts converts a slice of strings, such as a column read by ReadSliceCSV, to
+// integers. Surrounding whitespace is ignored. The function returns an error naming
+// the index of the first value that is not a valid integer. If the slice is nil, the
+// result is nil.
+//
+// Example:
+//
+//	ints, err := ParseInts([]string{"1", " 2", "3"}) // returns []int{1, 2, 3}, nil
+func ParseInts(s []string) ([]int, error) {
+	return parseStrings(s, strconv.Atoi)
+}
+
+// ParseFloats converts a slice of strings, such as a column read by ReadSliceCSV, to
+// float64 values. Surrounding whitespace is ignored. The function returns an error
+// naming the index of the first value that is not a valid number. If the slice is nil,
+// the result is nil.
+//
+// Example:
+//
+//	floats, err := ParseFloats([]string{"1.5", "2e3"}) // returns []float64{1.5, 2000}, nil
+func ParseFloats(s []string) ([]float64, error) {
+	return parseStrings(s, func(v string) (float64, error) {
+		return strconv.ParseFloat(v, 64)
+	})
+}
+
+// parseStrings is a helper function that converts every trimmed string with parse.
+func parseStrings[T any](s []string, parse func(string) (T, error)) ([]T, error) {
+	if s == nil {
+		return nil, nil
+	}
+
+	result := make([]T, len(s))
+	for i, v := range s {
+		parsed, err := parse(strings.TrimSpace(v))
+		if err != nil {
+			return nil, fmt.Errorf("element %d: %w", i, err)
+		}
+		result[i] = parsed
+	}
+	return result, nil
+}
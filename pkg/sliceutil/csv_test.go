@@ -0,0 +1,147 @@
+package sliceutil
+
+import (
+	"bytes"
+	"errors"
+	"math"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestReadSliceCSV tests the ReadSliceCSV function
+func TestReadSliceCSV(t *testing.T) {
+	data := "name,price\nwidget,1.5\n\"gadget, large\",2\nthing,3.25,extra\n"
+
+	t.Run("Reads Column", func(t *testing.T) {
+		names, err := ReadSliceCSV(strings.NewReader(data), 0)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"name", "widget", "gadget, large", "thing"}, names)
+
+		prices, err := ReadSliceCSV(strings.NewReader(data), 1)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"price", "1.5", "2", "3.25"}, prices)
+	})
+
+	t.Run("Feeds Stats", func(t *testing.T) {
+		column, err := ReadSliceCSV(strings.NewReader(data), 1)
+		require.NoError(t, err)
+		prices, err := ParseFloats(column[1:])
+		require.NoError(t, err)
+		sum, err := SumFloat64Kahan(prices)
+		require.NoError(t, err)
+		assert.Equal(t, 6.75, sum)
+	})
+
+	t.Run("Column Out Of Range", func(t *testing.T) {
+		_, err := ReadSliceCSV(strings.NewReader(data), 2)
+		assert.ErrorIs(t, err, ErrOutOfRange)
+		assert.Contains(t, err.Error(), "line 1")
+
+		_, err = ReadSliceCSV(strings.NewReader(data), -1)
+		assert.ErrorIs(t, err, ErrOutOfRange)
+	})
+
+	t.Run("Malformed Input", func(t *testing.T) {
+		_, err := ReadSliceCSV(strings.NewReader("a,\"b\n"), 0)
+		assert.Error(t, err)
+	})
+
+	t.Run("Nil and Empty", func(t *testing.T) {
+		values, err := ReadSliceCSV(strings.NewReader(""), 0)
+		require.NoError(t, err)
+		assert.Equal(t, []string{}, values)
+	})
+}
+
+// TestWriteSliceCSV tests the WriteSliceCSV function
+func TestWriteSliceCSV(t *testing.T) {
+	t.Run("Writes One Value Per Record", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, WriteSliceCSV(&buf, []string{"a", "b,c", "d\"e"}))
+		assert.Equal(t, "a\n\"b,c\"\n\"d\"\"e\"\n", buf.String())
+	})
+
+	t.Run("Round Trip", func(t *testing.T) {
+		floats := []float64{0.1, 1e300, -2.5, math.SmallestNonzeroFloat64}
+		var buf bytes.Buffer
+		require.NoError(t, WriteSliceCSV(&buf, floats))
+
+		column, err := ReadSliceCSV(&buf, 0)
+		require.NoError(t, err)
+		parsed, err := ParseFloats(column)
+		require.NoError(t, err)
+		assert.Equal(t, floats, parsed)
+	})
+
+	t.Run("Empty Strings Round Trip", func(t *testing.T) {
+		values := []string{"a", "", "b", "", ""}
+		var buf bytes.Buffer
+		require.NoError(t, WriteSliceCSV(&buf, values))
+		assert.Equal(t, "a\n\"\"\nb\n\"\"\n\"\"\n", buf.String())
+
+		column, err := ReadSliceCSV(&buf, 0)
+		require.NoError(t, err)
+		assert.Equal(t, values, column)
+	})
+
+	t.Run("Write Error", func(t *testing.T) {
+		err := WriteSliceCSV(failingWriter{}, []string{""})
+		assert.ErrorIs(t, err, errWriteFailed)
+
+		err = WriteSliceCSV(failingWriter{}, []int{1})
+		assert.ErrorIs(t, err, errWriteFailed)
+	})
+
+	t.Run("Nil and Empty", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, WriteSliceCSV[int](&buf, nil))
+		assert.Empty(t, buf.String())
+	})
+}
+
+// errWriteFailed is returned by failingWriter
+var errWriteFailed = errors.New("write failed")
+
+// failingWriter is an io.Writer that always fails
+type failingWriter struct{}
+
+func (failingWriter) Write([]byte) (int, error) {
+	return 0, errWriteFailed
+}
+
+// TestParseInts tests the ParseInts and ParseFloats functions
+func TestParseInts(t *testing.T) {
+	t.Run("Parses Values", func(t *testing.T) {
+		ints, err := ParseInts([]string{"1", " 2", "-3 "})
+		require.NoError(t, err)
+		assert.Equal(t, []int{1, 2, -3}, ints)
+
+		floats, err := ParseFloats([]string{"1.5", "2e3", "NaN"})
+		require.NoError(t, err)
+		assert.Equal(t, []float64{1.5, 2000}, floats[:2])
+		assert.True(t, math.IsNaN(floats[2]))
+	})
+
+	t.Run("Invalid Value", func(t *testing.T) {
+		_, err := ParseInts([]string{"1", "2.5"})
+		assert.ErrorIs(t, err, strconv.ErrSyntax)
+		assert.Contains(t, err.Error(), "element 1")
+
+		_, err = ParseFloats([]string{"x"})
+		assert.ErrorIs(t, err, strconv.ErrSyntax)
+	})
+
+	t.Run("Nil and Empty", func(t *testing.T) {
+		ints, err := ParseInts(nil)
+		require.NoError(t, err)
+		assert.Nil(t, ints)
+
+		floats, err := ParseFloats([]string{})
+		require.NoError(t, err)
+		assert.Equal(t, []float64{}, floats)
+	})
+}
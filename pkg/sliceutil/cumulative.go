@@ -0,0 +1,78 @@
+package sliceutil
+
+import "cmp"
+
+// Scan folds a slice like Reduce but returns every intermediate accumulator, so the
+// result holds one value per element: result[i] is the fold of initial with s[0..i].
+// The initial value itself is not included in the result.
+//
+// Time complexity: O(n) where n is the length of the slice
+// Space complexity: O(n) for the result slice
+//
+// Example:
+//
+//	balances := Scan([]int{100, -30, 50}, 1000, func(acc, v int) int { return acc + v })
+//	// returns []int{1100, 1070, 1120}
+func Scan[T, U any](s []T, initial U, fn func(U, T) U) []U {
+	if s == nil {
+		return nil
+	}
+
+	result := make([]U, len(s))
+	acc := initial
+	for i, v := range s {
+		acc = fn(acc, v)
+		result[i] = acc
+	}
+	return result
+}
+
+// CumSum returns the running total of a slice, where result[i] is the sum of s[0..i].
+// The sum is accumulated in the element type, so it can overflow for integer types.
+//
+// Example:
+//
+//	totals := CumSum([]int{1, 2, 3, 4})
+//	// returns []int{1, 3, 6, 10}
+func CumSum[T Number](s []T) []T {
+	return Scan(s, 0, func(acc, v T) T { return acc + v })
+}
+
+// CumMin returns the running minimum of a slice, where result[i] is the smallest of s[0..i].
+//
+// Example:
+//
+//	lows := CumMin([]int{5, 3, 4, 1})
+//	// returns []int{5, 3, 3, 1}
+func CumMin[T cmp.Ordered](s []T) []T {
+	return cumulativeExtreme(s, func(v, current T) bool { return cmp.Less(v, current) })
+}
+
+// CumMax returns the running maximum of a slice, where result[i] is the largest of s[0..i].
+// A typical use is tracking the high-water mark of a price or balance series.
+//
+// Example:
+//
+//	highs := CumMax([]int{1, 3, 2, 5})
+//	// returns []int{1, 3, 3, 5}
+func CumMax[T cmp.Ordered](s []T) []T {
+	return cumulativeExtreme(s, func(v, current T) bool { return cmp.Less(current, v) })
+}
+
+// cumulativeExtreme is a helper function that returns the running extreme of a slice,
+// replacing the current extreme whenever better reports that a value beats it.
+func cumulativeExtreme[T any](s []T, better func(v, current T) bool) []T {
+	if s == nil {
+		return nil
+	}
+
+	result := make([]T, len(s))
+	for i, v := range s {
+		if i == 0 || better(v, result[i-1]) {
+			result[i] = v
+		} else {
+			result[i] = result[i-1]
+		}
+	}
+	return result
+}
@@ -0,0 +1,78 @@
+package sliceutil
+
+import (
+	"math"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestScan tests the Scan function
+func TestScan(t *testing.T) {
+	t.Run("Running Balance", func(t *testing.T) {
+		result := Scan([]int{100, -30, 50}, 1000, func(acc, v int) int { return acc + v })
+		assert.Equal(t, []int{1100, 1070, 1120}, result)
+	})
+
+	t.Run("Different Accumulator Type", func(t *testing.T) {
+		result := Scan([]int{1, 2, 3}, "", func(acc string, v int) string { return acc + strconv.Itoa(v) })
+		assert.Equal(t, []string{"1", "12", "123"}, result)
+	})
+
+	t.Run("Last Value Matches Reduce", func(t *testing.T) {
+		s := []int{4, 8, 15, 16, 23, 42}
+		add := func(acc, v int) int { return acc + v }
+		result := Scan(s, 0, add)
+		assert.Equal(t, Reduce(s, 0, add), result[len(result)-1])
+	})
+
+	t.Run("Nil and Empty", func(t *testing.T) {
+		add := func(acc, v int) int { return acc + v }
+		assert.Nil(t, Scan(nil, 0, add))
+		assert.Equal(t, []int{}, Scan([]int{}, 0, add))
+	})
+}
+
+// TestCumSum tests the CumSum function
+func TestCumSum(t *testing.T) {
+	t.Run("Integers", func(t *testing.T) {
+		assert.Equal(t, []int{1, 3, 6, 10}, CumSum([]int{1, 2, 3, 4}))
+	})
+
+	t.Run("Floats", func(t *testing.T) {
+		assert.Equal(t, []float64{0.5, 2, 1}, CumSum([]float64{0.5, 1.5, -1}))
+	})
+
+	t.Run("Nil and Empty", func(t *testing.T) {
+		assert.Nil(t, CumSum[int](nil))
+		assert.Equal(t, []int{}, CumSum([]int{}))
+	})
+}
+
+// TestCumMinMax tests the CumMin and CumMax functions
+func TestCumMinMax(t *testing.T) {
+	t.Run("CumMin", func(t *testing.T) {
+		assert.Equal(t, []int{5, 3, 3, 1}, CumMin([]int{5, 3, 4, 1}))
+	})
+
+	t.Run("CumMax", func(t *testing.T) {
+		assert.Equal(t, []int{1, 3, 3, 5}, CumMax([]int{1, 3, 2, 5}))
+	})
+
+	t.Run("Strings", func(t *testing.T) {
+		assert.Equal(t, []string{"b", "b", "c"}, CumMax([]string{"b", "a", "c"}))
+		assert.Equal(t, []string{"b", "a", "a"}, CumMin([]string{"b", "a", "c"}))
+	})
+
+	t.Run("NaN Does Not Replace Extreme", func(t *testing.T) {
+		result := CumMax([]float64{1, math.NaN(), 2})
+		assert.Equal(t, []float64{1, 1, 2}, result)
+	})
+
+	t.Run("Nil and Empty", func(t *testing.T) {
+		assert.Nil(t, CumMin[int](nil))
+		assert.Nil(t, CumMax[int](nil))
+		assert.Equal(t, []int{}, CumMax([]int{}))
+	})
+}
@@ -0,0 +1,207 @@
+package sliceutil
+
+import "fmt"
+
+// EditKind identifies the type of an edit operation in a diff
+type EditKind string
+
+const (
+	// EditKeep marks an element present in both slices
+	EditKeep EditKind = "KEEP"
+	// EditDelete marks an element present only in the original slice
+	EditDelete EditKind = "DELETE"
+	// EditInsert marks an element present only in the target slice
+	EditInsert EditKind = "INSERT"
+)
+
+// EditOp is a single step of an edit script that transforms one slice into another.
+// AIndex is the position in the original slice the operation applies to, and BIndex
+// is the corresponding position in the target slice. For insertions, AIndex is the
+// position in the original slice before which the element is inserted; for deletions,
+// BIndex is the position in the target slice where the deleted element would have been.
+type EditOp[T any] struct {
+	Kind   EditKind
+	Value  T
+	AIndex int
+	BIndex int
+}
+
+// ComputeDiff computes an edit script that transforms slice A into slice B using a
+// longest common subsequence. The script consists of keep, delete and insert operations
+// in order; within each changed region deletions come before insertions. Applying the
+// script to slice A with ApplyPatch reproduces slice B.
+//
+// Common prefixes and suffixes are trimmed before the LCS is computed, so slices that
+// differ only in a small region are diffed quickly.
+//
+// Time complexity: O(n * m) in the worst case where n and m are the lengths of the slices
+// Space complexity: O(n + m), as the LCS is found with Hirschberg's linear-space algorithm
+//
+// Example:
+//
+//	ops := ComputeDiff([]string{"a", "b", "c"}, []string{"a", "c", "d"})
+//	// returns KEEP a, DELETE b, KEEP c, INSERT d
+func ComputeDiff[T comparable](a, b []T) []EditOp[T] {
+	ops := make([]EditOp[T], 0, max(len(a), len(b)))
+
+	// Trim the common prefix
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		ops = append(ops, EditOp[T]{Kind: EditKeep, Value: a[prefix], AIndex: prefix, BIndex: prefix})
+		prefix++
+	}
+
+	// Trim the common suffix
+	suffix := 0
+	for suffix < len(a)-prefix && suffix < len(b)-prefix && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+
+	midA := a[prefix : len(a)-suffix]
+	midB := b[prefix : len(b)-suffix]
+
+	i, j := 0, 0
+	emitUntil := func(ai, bj int) {
+		for ; i < ai; i++ {
+			ops = append(ops, EditOp[T]{Kind: EditDelete, Value: midA[i], AIndex: prefix + i, BIndex: prefix + j})
+		}
+		for ; j < bj; j++ {
+			ops = append(ops, EditOp[T]{Kind: EditInsert, Value: midB[j], AIndex: prefix + i, BIndex: prefix + j})
+		}
+	}
+
+	for _, m := range lcsMatches(midA, midB) {
+		emitUntil(m.First, m.Second)
+		ops = append(ops, EditOp[T]{Kind: EditKeep, Value: midA[i], AIndex: prefix + i, BIndex: prefix + j})
+		i++
+		j++
+	}
+	emitUntil(len(midA), len(midB))
+
+	for k := 0; k < suffix; k++ {
+		ai := len(a) - suffix + k
+		bj := len(b) - suffix + k
+		ops = append(ops, EditOp[T]{Kind: EditKeep, Value: a[ai], AIndex: ai, BIndex: bj})
+	}
+
+	return ops
+}
+
+// ApplyPatch applies an edit script produced by ComputeDiff to slice A and returns the
+// resulting slice. Keep and delete operations are verified against the elements of A,
+// and the script must account for every element of A.
+// The function returns an error wrapping ErrPatchMismatch if the script does not fit A.
+//
+// Example:
+//
+//	ops := ComputeDiff(a, b)
+//	result, err := ApplyPatch(a, ops) // result equals b
+func ApplyPatch[T comparable](a []T, ops []EditOp[T]) ([]T, error) {
+	result := make([]T, 0, len(a))
+	i := 0
+
+	for n, op := range ops {
+		switch op.Kind {
+		case EditKeep, EditDelete:
+			if i >= len(a) {
+				return nil, fmt.Errorf("%w: operation %d goes past the end of the slice", ErrPatchMismatch, n)
+			}
+			if a[i] != op.Value {
+				return nil, fmt.Errorf("%w: operation %d expects %v at index %d, found %v", ErrPatchMismatch, n, op.Value, i, a[i])
+			}
+			if op.Kind == EditKeep {
+				result = append(result, a[i])
+			}
+			i++
+		case EditInsert:
+			result = append(result, op.Value)
+		default:
+			return nil, fmt.Errorf("%w: operation %d has unknown kind %q", ErrPatchMismatch, n, op.Kind)
+		}
+	}
+
+	if i != len(a) {
+		return nil, fmt.Errorf("%w: %d trailing elements not covered by the patch", ErrPatchMismatch, len(a)-i)
+	}
+
+	return result, nil
+}
+
+// lcsMatches computes a longest common subsequence of two slices and returns the
+// matched index pairs in increasing order. Each pair holds an index into slice A
+// (First) and the index of the equal element in slice B (Second). It uses
+// Hirschberg's algorithm, which only keeps two rows of the dynamic programming table.
+//
+// Time complexity: O(n * m) where n and m are the lengths of the slices
+// Space complexity: O(n + m)
+func lcsMatches[T comparable](a, b []T) []Pair[int, int] {
+	if len(a) == 0 || len(b) == 0 {
+		return nil
+	}
+	matches := make([]Pair[int, int], 0, min(len(a), len(b)))
+	fwd := make([]int, len(b)+1)
+	rev := make([]int, len(b)+1)
+	return hirschberg(a, b, 0, 0, fwd, rev, matches)
+}
+
+// hirschberg is a helper function that appends the LCS matches of a and b to matches,
+// offsetting the indices by offA and offB. It splits a in half, finds the position in
+// b where an optimal alignment crosses the split, and recurses on both halves.
+// fwd and rev are scratch rows of at least len(b)+1 elements.
+func hirschberg[T comparable](a, b []T, offA, offB int, fwd, rev []int, matches []Pair[int, int]) []Pair[int, int] {
+	if len(a) == 0 || len(b) == 0 {
+		return matches
+	}
+	if len(a) == 1 {
+		for j, v := range b {
+			if v == a[0] {
+				return append(matches, Pair[int, int]{First: offA, Second: offB + j})
+			}
+		}
+		return matches
+	}
+
+	mid := len(a) / 2
+	m := len(b)
+	fwd, rev = fwd[:m+1], rev[:m+1]
+
+	// fwd[j] holds the LCS length of a[:mid] and b[:j]
+	clear(fwd)
+	for i := 0; i < mid; i++ {
+		diag := 0
+		for j := 1; j <= m; j++ {
+			up := fwd[j]
+			if a[i] == b[j-1] {
+				fwd[j] = diag + 1
+			} else {
+				fwd[j] = max(up, fwd[j-1])
+			}
+			diag = up
+		}
+	}
+
+	// rev[j] holds the LCS length of a[mid:] and b[j:]
+	clear(rev)
+	for i := len(a) - 1; i >= mid; i-- {
+		diag := 0
+		for j := m - 1; j >= 0; j-- {
+			down := rev[j]
+			if a[i] == b[j] {
+				rev[j] = diag + 1
+			} else {
+				rev[j] = max(down, rev[j+1])
+			}
+			diag = down
+		}
+	}
+
+	split, best := 0, -1
+	for j := 0; j <= m; j++ {
+		if total := fwd[j] + rev[j]; total > best {
+			split, best = j, total
+		}
+	}
+
+	matches = hirschberg(a[:mid], b[:split], offA, offB, fwd, rev, matches)
+	return hirschberg(a[mid:], b[split:], offA+mid, offB+split, fwd, rev, matches)
+}
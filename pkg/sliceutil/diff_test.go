@@ -0,0 +1,169 @@
+package sliceutil
+
+import (
+	"math/rand/v2"
+	"testing"
+
+	"github.com/devrob-go/sliceutil/pkg/sliceutil/benchcmp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestComputeDiff tests the ComputeDiff function
+func TestComputeDiff(t *testing.T) {
+	t.Run("Produces Edit Script", func(t *testing.T) {
+		ops := ComputeDiff([]string{"a", "b", "c"}, []string{"a", "c", "d"})
+		expected := []EditOp[string]{
+			{Kind: EditKeep, Value: "a", AIndex: 0, BIndex: 0},
+			{Kind: EditDelete, Value: "b", AIndex: 1, BIndex: 1},
+			{Kind: EditKeep, Value: "c", AIndex: 2, BIndex: 1},
+			{Kind: EditInsert, Value: "d", AIndex: 3, BIndex: 2},
+		}
+		assert.Equal(t, expected, ops)
+	})
+
+	t.Run("Identical Slices Keep Everything", func(t *testing.T) {
+		ops := ComputeDiff([]int{1, 2, 3}, []int{1, 2, 3})
+		assert.Len(t, ops, 3)
+		for _, op := range ops {
+			assert.Equal(t, EditKeep, op.Kind)
+		}
+	})
+
+	t.Run("Replacement Deletes Before Inserting", func(t *testing.T) {
+		ops := ComputeDiff([]int{1, 2, 3}, []int{1, 9, 3})
+		kinds := Map(ops, func(op EditOp[int]) EditKind { return op.Kind })
+		assert.Equal(t, []EditKind{EditKeep, EditDelete, EditInsert, EditKeep}, kinds)
+	})
+
+	t.Run("Nil Slices", func(t *testing.T) {
+		assert.Empty(t, ComputeDiff[int](nil, nil))
+
+		ops := ComputeDiff(nil, []int{1, 2})
+		assert.Equal(t, []EditOp[int]{
+			{Kind: EditInsert, Value: 1, AIndex: 0, BIndex: 0},
+			{Kind: EditInsert, Value: 2, AIndex: 0, BIndex: 1},
+		}, ops)
+	})
+}
+
+// TestApplyPatch tests the ApplyPatch function
+func TestApplyPatch(t *testing.T) {
+	t.Run("Round Trip", func(t *testing.T) {
+		cases := []struct{ a, b []int }{
+			{[]int{1, 2, 3, 4, 5}, []int{2, 3, 6, 5, 7}},
+			{[]int{}, []int{1, 2}},
+			{[]int{1, 2}, []int{}},
+			{[]int{1, 1, 2, 1}, []int{1, 2, 1, 1}},
+			{[]int{5, 4, 3, 2, 1}, []int{1, 2, 3, 4, 5}},
+		}
+
+		for _, c := range cases {
+			result, err := ApplyPatch(c.a, ComputeDiff(c.a, c.b))
+			require.NoError(t, err)
+			assert.Equal(t, c.b, result)
+		}
+	})
+
+	t.Run("Mismatched Patch", func(t *testing.T) {
+		ops := ComputeDiff([]int{1, 2}, []int{1, 3})
+
+		_, err := ApplyPatch([]int{4, 2}, ops)
+		assert.ErrorIs(t, err, ErrPatchMismatch)
+
+		_, err = ApplyPatch([]int{1}, ops)
+		assert.ErrorIs(t, err, ErrPatchMismatch)
+
+		_, err = ApplyPatch([]int{1, 2, 3}, ops)
+		assert.ErrorIs(t, err, ErrPatchMismatch)
+	})
+
+	t.Run("Unknown Operation", func(t *testing.T) {
+		_, err := ApplyPatch([]int{1}, []EditOp[int]{{Kind: EditKind("MOVE"), Value: 1}})
+		assert.ErrorIs(t, err, ErrPatchMismatch)
+	})
+}
+
+// TestLCSMatches tests the lcsMatches helper function
+func TestLCSMatches(t *testing.T) {
+	t.Run("Common Subsequence", func(t *testing.T) {
+		a := []string{"a", "b", "c", "d"}
+		b := []string{"b", "x", "d"}
+		expected := []Pair[int, int]{{First: 1, Second: 0}, {First: 3, Second: 2}}
+
+		assert.Equal(t, expected, lcsMatches(a, b))
+	})
+
+	t.Run("Identical Slices", func(t *testing.T) {
+		matches := lcsMatches([]int{1, 2, 3}, []int{1, 2, 3})
+		assert.Len(t, matches, 3)
+		for i, p := range matches {
+			assert.Equal(t, i, p.First)
+			assert.Equal(t, i, p.Second)
+		}
+	})
+
+	t.Run("Nothing In Common", func(t *testing.T) {
+		assert.Empty(t, lcsMatches([]int{1, 2}, []int{3, 4}))
+		assert.Empty(t, lcsMatches(nil, []int{3, 4}))
+	})
+
+	t.Run("Matches Table Length", func(t *testing.T) {
+		rng := rand.New(rand.NewPCG(1, 2))
+		for range 200 {
+			a := make([]int, rng.IntN(30))
+			for i := range a {
+				a[i] = rng.IntN(4)
+			}
+			b := make([]int, rng.IntN(30))
+			for i := range b {
+				b[i] = rng.IntN(4)
+			}
+
+			matches := lcsMatches(a, b)
+			require.Len(t, matches, lcsLength(a, b))
+			for k, p := range matches {
+				require.Equal(t, a[p.First], b[p.Second])
+				if k > 0 {
+					require.Greater(t, p.First, matches[k-1].First)
+					require.Greater(t, p.Second, matches[k-1].Second)
+				}
+			}
+		}
+	})
+}
+
+// lcsLength computes the LCS length of two slices with the full dynamic programming table
+func lcsLength(a, b []int) int {
+	table := make([][]int, len(a)+1)
+	for i := range table {
+		table[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				table[i][j] = table[i+1][j+1] + 1
+			} else {
+				table[i][j] = max(table[i+1][j], table[i][j+1])
+			}
+		}
+	}
+	return table[0][0]
+}
+
+// BenchmarkComputeDiff_SmallMediumLarge benchmarks diffing two slices of n elements
+// that differ in a window of eight elements in the middle. The common prefix and suffix
+// are trimmed in linear time, so the quadratic LCS only covers the window.
+func BenchmarkComputeDiff_SmallMediumLarge(b *testing.B) {
+	benchcmp.SmallMediumLarge(b, func(b *testing.B, n int) {
+		x := Range(0, n)
+		y := Range(0, n)
+		for i := n/2 - 4; i < n/2+4; i++ {
+			y[i] = -i
+		}
+
+		for b.Loop() {
+			ComputeDiff(x, y)
+		}
+	})
+}
@@ -0,0 +1,119 @@
+package sliceutil
+
+import (
+	"fmt"
+	"slices"
+)
+
+// Insert inserts values into a slice at index i, shifting later elements to the right,
+// and returns the modified slice. Like append, it reuses the backing array of the slice
+// when it has enough capacity, so the result must be used in place of the original.
+// The index must be in [0, len(a)]; otherwise ErrOutOfRange is returned.
+//
+// Time complexity: O(n + m) where n is the length of the slice and m the number of values
+// Space complexity: O(n + m) when the slice has to grow, O(1) otherwise
+//
+// Example:
+//
+//	s, err := Insert([]int{1, 4}, 1, 2, 3)
+//	// returns []int{1, 2, 3, 4}, nil
+func Insert[T any](a []T, i int, values ...T) ([]T, error) {
+	if err := checkEditRange(i, i, len(a)); err != nil {
+		return a, err
+	}
+	return slices.Insert(a, i, values...), nil
+}
+
+// InsertCopy works like Insert, but returns a new slice and leaves the original unchanged.
+func InsertCopy[T any](a []T, i int, values ...T) ([]T, error) {
+	return ReplaceRangeCopy(a, i, i, values...)
+}
+
+// RemoveAt removes the element at index i, shifting later elements to the left, and
+// returns the shortened slice. The original slice is modified. The index must be in
+// [0, len(a)); otherwise ErrOutOfRange is returned.
+//
+// Example:
+//
+//	s, err := RemoveAt([]string{"a", "b", "c"}, 1)
+//	// returns []string{"a", "c"}, nil
+func RemoveAt[T any](a []T, i int) ([]T, error) {
+	if err := checkEditIndex(i, len(a)); err != nil {
+		return a, err
+	}
+	return slices.Delete(a, i, i+1), nil
+}
+
+// RemoveAtCopy works like RemoveAt, but returns a new slice and leaves the original unchanged.
+func RemoveAtCopy[T any](a []T, i int) ([]T, error) {
+	if err := checkEditIndex(i, len(a)); err != nil {
+		return a, err
+	}
+	return ReplaceRangeCopy(a, i, i+1)
+}
+
+// RemoveRange removes the elements in the half-open range [i, j), shifting later elements
+// to the left, and returns the shortened slice. The original slice is modified.
+// The range must satisfy 0 <= i <= j <= len(a); otherwise ErrOutOfRange is returned.
+//
+// Example:
+//
+//	s, err := RemoveRange([]int{1, 2, 3, 4, 5}, 1, 3)
+//	// returns []int{1, 4, 5}, nil
+func RemoveRange[T any](a []T, i, j int) ([]T, error) {
+	if err := checkEditRange(i, j, len(a)); err != nil {
+		return a, err
+	}
+	return slices.Delete(a, i, j), nil
+}
+
+// RemoveRangeCopy works like RemoveRange, but returns a new slice and leaves the original unchanged.
+func RemoveRangeCopy[T any](a []T, i, j int) ([]T, error) {
+	return ReplaceRangeCopy(a, i, j)
+}
+
+// ReplaceRange replaces the elements in the half-open range [i, j) with the given values
+// and returns the modified slice. The number of values may differ from the size of the
+// range. Like append, it reuses the backing array of the slice when possible.
+// The range must satisfy 0 <= i <= j <= len(a); otherwise ErrOutOfRange is returned.
+//
+// Example:
+//
+//	s, err := ReplaceRange([]int{1, 2, 3, 4}, 1, 3, 9)
+//	// returns []int{1, 9, 4}, nil
+func ReplaceRange[T any](a []T, i, j int, values ...T) ([]T, error) {
+	if err := checkEditRange(i, j, len(a)); err != nil {
+		return a, err
+	}
+	return slices.Replace(a, i, j, values...), nil
+}
+
+// ReplaceRangeCopy works like ReplaceRange, but returns a new slice and leaves the
+// original unchanged.
+func ReplaceRangeCopy[T any](a []T, i, j int, values ...T) ([]T, error) {
+	if err := checkEditRange(i, j, len(a)); err != nil {
+		return a, err
+	}
+
+	result := make([]T, 0, len(a)-(j-i)+len(values))
+	result = append(result, a[:i]...)
+	result = append(result, values...)
+	result = append(result, a[j:]...)
+	return result, nil
+}
+
+// checkEditIndex is a helper function that validates an element index for a slice of length n.
+func checkEditIndex(i, n int) error {
+	if i < 0 || i >= n {
+		return fmt.Errorf("%w: index %d with length %d", ErrOutOfRange, i, n)
+	}
+	return nil
+}
+
+// checkEditRange is a helper function that validates a half-open range [i, j) for a slice of length n.
+func checkEditRange(i, j, n int) error {
+	if i < 0 || j < i || j > n {
+		return fmt.Errorf("%w: range [%d:%d] with length %d", ErrOutOfRange, i, j, n)
+	}
+	return nil
+}
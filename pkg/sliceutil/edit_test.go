@@ -0,0 +1,143 @@
+package sliceutil
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestInsert tests the Insert and InsertCopy functions
+func TestInsert(t *testing.T) {
+	t.Run("Insert In Middle", func(t *testing.T) {
+		result, err := Insert([]int{1, 4}, 1, 2, 3)
+		require.NoError(t, err)
+		assert.Equal(t, []int{1, 2, 3, 4}, result)
+	})
+
+	t.Run("Insert At Ends", func(t *testing.T) {
+		result, err := Insert([]int{2}, 0, 1)
+		require.NoError(t, err)
+		assert.Equal(t, []int{1, 2}, result)
+
+		result, err = Insert(result, 2, 3)
+		require.NoError(t, err)
+		assert.Equal(t, []int{1, 2, 3}, result)
+	})
+
+	t.Run("Copy Leaves Original Unchanged", func(t *testing.T) {
+		original := make([]int, 2, 10)
+		original[0], original[1] = 1, 3
+		result, err := InsertCopy(original, 1, 2)
+		require.NoError(t, err)
+
+		assert.Equal(t, []int{1, 2, 3}, result)
+		assert.Equal(t, []int{1, 3}, original)
+		assert.Equal(t, 0, original[:3][2]) // Spare capacity untouched
+	})
+
+	t.Run("Out Of Range", func(t *testing.T) {
+		_, err := Insert([]int{1}, 2, 5)
+		assert.ErrorIs(t, err, ErrOutOfRange)
+
+		_, err = InsertCopy([]int{1}, -1, 5)
+		assert.ErrorIs(t, err, ErrOutOfRange)
+	})
+
+	t.Run("Nil and Empty", func(t *testing.T) {
+		result, err := Insert(nil, 0, 1)
+		require.NoError(t, err)
+		assert.Equal(t, []int{1}, result)
+	})
+}
+
+// TestRemoveAt tests the RemoveAt and RemoveAtCopy functions
+func TestRemoveAt(t *testing.T) {
+	t.Run("Remove Element", func(t *testing.T) {
+		result, err := RemoveAt([]string{"a", "b", "c"}, 1)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"a", "c"}, result)
+	})
+
+	t.Run("Copy Leaves Original Unchanged", func(t *testing.T) {
+		original := []string{"a", "b", "c"}
+		result, err := RemoveAtCopy(original, 0)
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{"b", "c"}, result)
+		assert.Equal(t, []string{"a", "b", "c"}, original)
+	})
+
+	t.Run("Out Of Range", func(t *testing.T) {
+		_, err := RemoveAt([]int{1}, 1)
+		assert.ErrorIs(t, err, ErrOutOfRange)
+
+		_, err = RemoveAtCopy([]int{1}, -1)
+		assert.ErrorIs(t, err, ErrOutOfRange)
+
+		_, err = RemoveAt[int](nil, 0)
+		assert.ErrorIs(t, err, ErrOutOfRange)
+	})
+}
+
+// TestRemoveRange tests the RemoveRange and RemoveRangeCopy functions
+func TestRemoveRange(t *testing.T) {
+	t.Run("Remove Range", func(t *testing.T) {
+		result, err := RemoveRange([]int{1, 2, 3, 4, 5}, 1, 3)
+		require.NoError(t, err)
+		assert.Equal(t, []int{1, 4, 5}, result)
+	})
+
+	t.Run("Empty Range", func(t *testing.T) {
+		result, err := RemoveRange([]int{1, 2}, 1, 1)
+		require.NoError(t, err)
+		assert.Equal(t, []int{1, 2}, result)
+	})
+
+	t.Run("Copy Leaves Original Unchanged", func(t *testing.T) {
+		original := []int{1, 2, 3, 4}
+		result, err := RemoveRangeCopy(original, 2, 4)
+		require.NoError(t, err)
+
+		assert.Equal(t, []int{1, 2}, result)
+		assert.Equal(t, []int{1, 2, 3, 4}, original)
+	})
+
+	t.Run("Out Of Range", func(t *testing.T) {
+		_, err := RemoveRange([]int{1, 2}, 1, 3)
+		assert.ErrorIs(t, err, ErrOutOfRange)
+
+		_, err = RemoveRangeCopy([]int{1, 2}, 2, 1)
+		assert.ErrorIs(t, err, ErrOutOfRange)
+		assert.Contains(t, err.Error(), "range [2:1] with length 2")
+	})
+}
+
+// TestReplaceRange tests the ReplaceRange and ReplaceRangeCopy functions
+func TestReplaceRange(t *testing.T) {
+	t.Run("Shrinking Replacement", func(t *testing.T) {
+		result, err := ReplaceRange([]int{1, 2, 3, 4}, 1, 3, 9)
+		require.NoError(t, err)
+		assert.Equal(t, []int{1, 9, 4}, result)
+	})
+
+	t.Run("Growing Replacement", func(t *testing.T) {
+		result, err := ReplaceRange([]int{1, 2}, 1, 2, 7, 8, 9)
+		require.NoError(t, err)
+		assert.Equal(t, []int{1, 7, 8, 9}, result)
+	})
+
+	t.Run("Copy Leaves Original Unchanged", func(t *testing.T) {
+		original := []int{1, 2, 3}
+		result, err := ReplaceRangeCopy(original, 0, 2, 5)
+		require.NoError(t, err)
+
+		assert.Equal(t, []int{5, 3}, result)
+		assert.Equal(t, []int{1, 2, 3}, original)
+	})
+
+	t.Run("Out Of Range", func(t *testing.T) {
+		_, err := ReplaceRange([]int{1}, -1, 1, 2)
+		assert.ErrorIs(t, err, ErrOutOfRange)
+	})
+}
@@ -0,0 +1,420 @@
+package sliceutil
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"reflect"
+	"unsafe"
+)
+
+// The binary format written by EncodeSlice is:
+//
+//	version   1 byte, currently encodingVersion
+//	kind      1 byte, the reflect.Kind of the element type
+//	flags     1 byte, the element encoding and whether the slice is nil
+//	length    uvarint, the number of elements
+//	elements  the encoded elements
+//
+// Integers are stored as 1, 2, 4 or 8 little-endian bytes by width, with int, uint
+// and uintptr always taking 8 bytes, or as varints. Floats are stored as their
+// little-endian IEEE 754 bits, booleans as one byte, and strings as a uvarint length
+// followed by the bytes of the string.
+const encodingVersion = 1
+
+const (
+	// encodingFixed stores every element with a fixed width
+	encodingFixed byte = iota
+	// encodingVarint stores integers as zig-zag or unsigned varints
+	encodingVarint
+	// encodingDelta stores the first integer and then the differences between
+	// consecutive integers as varints
+	encodingDelta
+
+	// encodingNil marks a nil slice
+	encodingNil byte = 0x80
+)
+
+// EncodeOption configures EncodeSlice.
+type EncodeOption func(*encodeConfig)
+
+// encodeConfig holds the settings applied by EncodeOption values
+type encodeConfig struct {
+	encoding byte
+}
+
+// WithVarint stores integers as variable-length varints, which takes one byte for
+// values between -64 and 63 and at most ten bytes for any value. EncodeSlice returns
+// ErrUnsupportedType when the option is used with non-integer elements.
+func WithVarint() EncodeOption {
+	return func(c *encodeConfig) {
+		c.encoding = encodingVarint
+	}
+}
+
+// WithDelta stores the first integer and then the difference between each integer and
+// its predecessor as varints. Sorted slices and slices of nearby values, such as
+// timestamps or IDs, take one or two bytes per element. Any slice can be delta
+// encoded, but unsorted slices may take more space than with WithVarint.
+// EncodeSlice returns ErrUnsupportedType when the option is used with non-integer elements.
+func WithDelta() EncodeOption {
+	return func(c *encodeConfig) {
+		c.encoding = encodingDelta
+	}
+}
+
+// EncodeSlice encodes a slice of numbers, booleans or strings in a compact,
+// length-prefixed binary format, for example to persist snapshots that are compared
+// or diffed later. The result can be decoded with DecodeSlice into a slice of any type
+// with the same underlying kind, and is independent of the platform. Nil and empty
+// slices are distinguished.
+//
+// Time complexity: O(n) where n is the length of the slice
+// Space complexity: O(n) for the result
+//
+// Example:
+//
+//	data, err := EncodeSlice(sortedIDs, WithDelta())
+//	ids, err := DecodeSlice[int64](data)
+func EncodeSlice[T Primitive](s []T, opts ...EncodeOption) ([]byte, error) {
+	config := encodeConfig{encoding: encodingFixed}
+	for _, opt := range opts {
+		opt(&config)
+	}
+
+	kind := reflect.TypeFor[T]().Kind()
+	if config.encoding != encodingFixed && !isIntegerKind(kind) {
+		return nil, fmt.Errorf("%w: %s elements cannot be varint encoded", ErrUnsupportedType, kind)
+	}
+
+	flags := config.encoding
+	if s == nil {
+		flags |= encodingNil
+	}
+
+	buf := make([]byte, 0, 3+binary.MaxVarintLen64+len(s)*int(unsafe.Sizeof(*new(T))))
+	buf = append(buf, encodingVersion, byte(kind), flags)
+	buf = binary.AppendUvarint(buf, uint64(len(s)))
+
+	var previous uint64
+	for i := range s {
+		p := unsafe.Pointer(&s[i])
+		switch kind {
+		case reflect.String:
+			v := *(*string)(p)
+			buf = binary.AppendUvarint(buf, uint64(len(v)))
+			buf = append(buf, v...)
+		case reflect.Bool:
+			if *(*bool)(p) {
+				buf = append(buf, 1)
+			} else {
+				buf = append(buf, 0)
+			}
+		case reflect.Float32:
+			buf = binary.LittleEndian.AppendUint32(buf, math.Float32bits(*(*float32)(p)))
+		case reflect.Float64:
+			buf = binary.LittleEndian.AppendUint64(buf, math.Float64bits(*(*float64)(p)))
+		default:
+			v := loadInteger(kind, p)
+			switch config.encoding {
+			case encodingVarint:
+				buf = appendIntegerVarint(buf, kind, v)
+			case encodingDelta:
+				// Wrapping subtraction round-trips through wrapping addition
+				buf = binary.AppendVarint(buf, int64(v-previous))
+				previous = v
+			default:
+				buf = appendIntegerFixed(buf, kind, v)
+			}
+		}
+	}
+	return buf, nil
+}
+
+// DecodeSlice decodes data written by EncodeSlice. The element type must have the same
+// underlying kind as the encoded slice, otherwise the function returns ErrTypeMismatch;
+// an int slice can be decoded into a slice of a named int type, but not into []int64.
+// The function returns ErrInvalidEncoding if the data is truncated or corrupt.
+//
+// Time complexity: O(n) where n is the length of the data
+// Space complexity: O(n) for the result
+//
+// Example:
+//
+//	data, err := EncodeSlice([]float64{1.5, 2.5})
+//	values, err := DecodeSlice[float64](data) // returns []float64{1.5, 2.5}, nil
+func DecodeSlice[T Primitive](data []byte) ([]T, error) {
+	if len(data) < 3 {
+		return nil, fmt.Errorf("%w: missing header", ErrInvalidEncoding)
+	}
+	version, encodedKind, flags := data[0], reflect.Kind(data[1]), data[2]
+	if version != encodingVersion {
+		return nil, fmt.Errorf("%w: unknown version %d", ErrInvalidEncoding, version)
+	}
+
+	kind := reflect.TypeFor[T]().Kind()
+	if encodedKind != kind {
+		return nil, fmt.Errorf("%w: data holds %s elements, not %s", ErrTypeMismatch, encodedKind, kind)
+	}
+	encoding := flags &^ encodingNil
+	if encoding > encodingDelta || (encoding != encodingFixed && !isIntegerKind(kind)) {
+		return nil, fmt.Errorf("%w: unknown flags %#x", ErrInvalidEncoding, flags)
+	}
+
+	d := decoder{data: data[3:]}
+	length := d.uvarint()
+	// Every element takes at least one byte, which bounds the allocation
+	if d.err != nil || length > uint64(len(d.data)) {
+		return nil, fmt.Errorf("%w: invalid length", ErrInvalidEncoding)
+	}
+	if flags&encodingNil != 0 {
+		if length != 0 || len(d.data) != 0 {
+			return nil, fmt.Errorf("%w: nil slice with data", ErrInvalidEncoding)
+		}
+		return nil, nil
+	}
+
+	result := make([]T, length)
+	var previous uint64
+	for i := range result {
+		p := unsafe.Pointer(&result[i])
+		switch kind {
+		case reflect.String:
+			*(*string)(p) = string(d.bytes(d.uvarint()))
+		case reflect.Bool:
+			b := d.bytes(1)
+			if len(b) == 1 && b[0] > 1 {
+				d.fail()
+			}
+			*(*bool)(p) = len(b) == 1 && b[0] == 1
+		case reflect.Float32:
+			*(*float32)(p) = math.Float32frombits(uint32(d.fixed(4)))
+		case reflect.Float64:
+			*(*float64)(p) = math.Float64frombits(d.fixed(8))
+		default:
+			var v uint64
+			switch encoding {
+			case encodingVarint:
+				if isSignedKind(kind) {
+					v = uint64(d.varint())
+				} else {
+					v = d.uvarint()
+				}
+			case encodingDelta:
+				v = previous + uint64(d.varint())
+				previous = v
+			default:
+				v = d.fixed(integerWidth(kind))
+				if isSignedKind(kind) {
+					// Sign-extend values narrower than 64 bits
+					shift := 64 - 8*integerWidth(kind)
+					v = uint64(int64(v<<shift) >> shift)
+				}
+			}
+			if !storeInteger(kind, p, v) {
+				d.fail()
+			}
+		}
+		if d.err != nil {
+			return nil, fmt.Errorf("%w: element %d", ErrInvalidEncoding, i)
+		}
+	}
+
+	if len(d.data) != 0 {
+		return nil, fmt.Errorf("%w: %d trailing bytes", ErrInvalidEncoding, len(d.data))
+	}
+	return result, nil
+}
+
+// decoder reads values from encoded data. The first failure is recorded in err and
+// all later reads return zero values.
+type decoder struct {
+	data []byte
+	err  error
+}
+
+// fail records that the data is invalid.
+func (d *decoder) fail() {
+	if d.err == nil {
+		d.err = ErrInvalidEncoding
+	}
+	d.data = nil
+}
+
+// bytes reads the next n bytes.
+func (d *decoder) bytes(n uint64) []byte {
+	if d.err != nil || n > uint64(len(d.data)) {
+		d.fail()
+		return nil
+	}
+	b := d.data[:n]
+	d.data = d.data[n:]
+	return b
+}
+
+// fixed reads a little-endian unsigned integer of the given width in bytes.
+func (d *decoder) fixed(width int) uint64 {
+	var v uint64
+	for i, b := range d.bytes(uint64(width)) {
+		v |= uint64(b) << (8 * i)
+	}
+	return v
+}
+
+// uvarint reads an unsigned varint.
+func (d *decoder) uvarint() uint64 {
+	if d.err != nil {
+		return 0
+	}
+	v, n := binary.Uvarint(d.data)
+	if n <= 0 {
+		d.fail()
+		return 0
+	}
+	d.data = d.data[n:]
+	return v
+}
+
+// varint reads a zig-zag encoded signed varint.
+func (d *decoder) varint() int64 {
+	if d.err != nil {
+		return 0
+	}
+	v, n := binary.Varint(d.data)
+	if n <= 0 {
+		d.fail()
+		return 0
+	}
+	d.data = d.data[n:]
+	return v
+}
+
+// isIntegerKind is a helper function that reports whether a kind is an integer kind.
+func isIntegerKind(kind reflect.Kind) bool {
+	return isSignedKind(kind) || (kind >= reflect.Uint && kind <= reflect.Uintptr)
+}
+
+// isSignedKind is a helper function that reports whether a kind is a signed integer kind.
+func isSignedKind(kind reflect.Kind) bool {
+	return kind >= reflect.Int && kind <= reflect.Int64
+}
+
+// integerWidth is a helper function that returns the number of bytes used to store an
+// integer kind with the fixed encoding.
+func integerWidth(kind reflect.Kind) int {
+	switch kind {
+	case reflect.Int8, reflect.Uint8:
+		return 1
+	case reflect.Int16, reflect.Uint16:
+		return 2
+	case reflect.Int32, reflect.Uint32:
+		return 4
+	default:
+		return 8
+	}
+}
+
+// loadInteger is a helper function that reads the integer of the given kind at p,
+// sign-extending signed values to 64 bits.
+func loadInteger(kind reflect.Kind, p unsafe.Pointer) uint64 {
+	switch kind {
+	case reflect.Int:
+		return uint64(*(*int)(p))
+	case reflect.Int8:
+		return uint64(*(*int8)(p))
+	case reflect.Int16:
+		return uint64(*(*int16)(p))
+	case reflect.Int32:
+		return uint64(*(*int32)(p))
+	case reflect.Int64:
+		return uint64(*(*int64)(p))
+	case reflect.Uint:
+		return uint64(*(*uint)(p))
+	case reflect.Uint8:
+		return uint64(*(*uint8)(p))
+	case reflect.Uint16:
+		return uint64(*(*uint16)(p))
+	case reflect.Uint32:
+		return uint64(*(*uint32)(p))
+	case reflect.Uintptr:
+		return uint64(*(*uintptr)(p))
+	default:
+		return *(*uint64)(p)
+	}
+}
+
+// storeInteger is a helper function that writes v as an integer of the given kind at p.
+// It reports false if v does not fit the kind, leaving p unchanged.
+func storeInteger(kind reflect.Kind, p unsafe.Pointer, v uint64) bool {
+	s := int64(v)
+	switch kind {
+	case reflect.Int:
+		if int64(int(s)) != s {
+			return false
+		}
+		*(*int)(p) = int(s)
+	case reflect.Int8:
+		if s < math.MinInt8 || s > math.MaxInt8 {
+			return false
+		}
+		*(*int8)(p) = int8(s)
+	case reflect.Int16:
+		if s < math.MinInt16 || s > math.MaxInt16 {
+			return false
+		}
+		*(*int16)(p) = int16(s)
+	case reflect.Int32:
+		if s < math.MinInt32 || s > math.MaxInt32 {
+			return false
+		}
+		*(*int32)(p) = int32(s)
+	case reflect.Int64:
+		*(*int64)(p) = s
+	case reflect.Uint:
+		if uint64(uint(v)) != v {
+			return false
+		}
+		*(*uint)(p) = uint(v)
+	case reflect.Uint8:
+		if v > math.MaxUint8 {
+			return false
+		}
+		*(*uint8)(p) = uint8(v)
+	case reflect.Uint16:
+		if v > math.MaxUint16 {
+			return false
+		}
+		*(*uint16)(p) = uint16(v)
+	case reflect.Uint32:
+		if v > math.MaxUint32 {
+			return false
+		}
+		*(*uint32)(p) = uint32(v)
+	case reflect.Uintptr:
+		if uint64(uintptr(v)) != v {
+			return false
+		}
+		*(*uintptr)(p) = uintptr(v)
+	default:
+		*(*uint64)(p) = v
+	}
+	return true
+}
+
+// appendIntegerFixed is a helper function that appends an integer with the fixed width
+// of its kind in little-endian byte order.
+func appendIntegerFixed(buf []byte, kind reflect.Kind, v uint64) []byte {
+	for i := range integerWidth(kind) {
+		buf = append(buf, byte(v>>(8*i)))
+	}
+	return buf
+}
+
+// appendIntegerVarint is a helper function that appends an integer as a zig-zag varint
+// if its kind is signed and as an unsigned varint otherwise.
+func appendIntegerVarint(buf []byte, kind reflect.Kind, v uint64) []byte {
+	if isSignedKind(kind) {
+		return binary.AppendVarint(buf, int64(v))
+	}
+	return binary.AppendUvarint(buf, v)
+}
@@ -0,0 +1,144 @@
+package sliceutil
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// roundTrip is a helper that encodes and decodes a slice with the given options
+func roundTrip[T Primitive](t *testing.T, s []T, opts ...EncodeOption) []T {
+	t.Helper()
+	data, err := EncodeSlice(s, opts...)
+	require.NoError(t, err)
+	decoded, err := DecodeSlice[T](data)
+	require.NoError(t, err)
+	return decoded
+}
+
+// TestEncodeSlice tests the EncodeSlice and DecodeSlice functions
+func TestEncodeSlice(t *testing.T) {
+	t.Run("Round Trip Fixed", func(t *testing.T) {
+		ints := []int{0, 1, -1, math.MaxInt, math.MinInt}
+		assert.Equal(t, ints, roundTrip(t, ints))
+
+		int8s := []int8{0, -128, 127}
+		assert.Equal(t, int8s, roundTrip(t, int8s))
+
+		uint16s := []uint16{0, 1, math.MaxUint16}
+		assert.Equal(t, uint16s, roundTrip(t, uint16s))
+
+		floats := []float64{0, -0.5, math.Inf(1), math.MaxFloat64}
+		assert.Equal(t, floats, roundTrip(t, floats))
+
+		float32s := []float32{1.25, -3}
+		assert.Equal(t, float32s, roundTrip(t, float32s))
+
+		bools := []bool{true, false, true}
+		assert.Equal(t, bools, roundTrip(t, bools))
+
+		strs := []string{"", "hello", "héllo, wörld"}
+		assert.Equal(t, strs, roundTrip(t, strs))
+	})
+
+	t.Run("Round Trip Varint And Delta", func(t *testing.T) {
+		rng := rand.New(rand.NewPCG(5, 6))
+		ints := Generate(1000, func(int) int64 { return rng.Int64() - rng.Int64() })
+		ints = append(ints, math.MaxInt64, math.MinInt64, 0)
+		assert.Equal(t, ints, roundTrip(t, ints, WithVarint()))
+		assert.Equal(t, ints, roundTrip(t, ints, WithDelta()))
+
+		uints := []uint64{math.MaxUint64, 0, 5, 3}
+		assert.Equal(t, uints, roundTrip(t, uints, WithVarint()))
+		assert.Equal(t, uints, roundTrip(t, uints, WithDelta()))
+
+		int16s := []int16{math.MinInt16, math.MaxInt16, -1}
+		assert.Equal(t, int16s, roundTrip(t, int16s, WithDelta()))
+	})
+
+	t.Run("Compact Sizes", func(t *testing.T) {
+		sorted := Range(1_000_000, 1_001_000)
+		fixed, err := EncodeSlice(sorted)
+		require.NoError(t, err)
+		varint, err := EncodeSlice(sorted, WithVarint())
+		require.NoError(t, err)
+		delta, err := EncodeSlice(sorted, WithDelta())
+		require.NoError(t, err)
+
+		assert.Greater(t, len(fixed), 8000)
+		assert.Less(t, len(varint), len(fixed))
+		// One byte per element after the first
+		assert.Less(t, len(delta), 1020)
+	})
+
+	t.Run("Named Types", func(t *testing.T) {
+		type Status int8
+		statuses := []Status{1, -2}
+		assert.Equal(t, statuses, roundTrip(t, statuses))
+
+		data, err := EncodeSlice([]int8{1, -2})
+		require.NoError(t, err)
+		decoded, err := DecodeSlice[Status](data)
+		require.NoError(t, err)
+		assert.Equal(t, statuses, decoded)
+	})
+
+	t.Run("Type Mismatch", func(t *testing.T) {
+		data, err := EncodeSlice([]int{1})
+		require.NoError(t, err)
+		_, err = DecodeSlice[int64](data)
+		assert.ErrorIs(t, err, ErrTypeMismatch)
+	})
+
+	t.Run("Varint Requires Integers", func(t *testing.T) {
+		_, err := EncodeSlice([]float64{1}, WithVarint())
+		assert.ErrorIs(t, err, ErrUnsupportedType)
+		_, err = EncodeSlice([]string{"a"}, WithDelta())
+		assert.ErrorIs(t, err, ErrUnsupportedType)
+	})
+
+	t.Run("Corrupt Data", func(t *testing.T) {
+		data, err := EncodeSlice([]string{"hello", "world"})
+		require.NoError(t, err)
+
+		// Every truncation is detected
+		for i := range len(data) {
+			_, err := DecodeSlice[string](data[:i])
+			assert.ErrorIs(t, err, ErrInvalidEncoding, "length %d", i)
+		}
+
+		_, err = DecodeSlice[string](append(data, 0))
+		assert.ErrorIs(t, err, ErrInvalidEncoding)
+
+		bad := append([]byte{}, data...)
+		bad[0] = 99
+		_, err = DecodeSlice[string](bad)
+		assert.ErrorIs(t, err, ErrInvalidEncoding)
+
+		// A huge length does not cause a huge allocation
+		_, err = DecodeSlice[string]([]byte{1, data[1], 0, 0xff, 0xff, 0xff, 0xff, 0x0f})
+		assert.ErrorIs(t, err, ErrInvalidEncoding)
+	})
+
+	t.Run("Out Of Range Values", func(t *testing.T) {
+		data, err := EncodeSlice([]int8{100, 100}, WithDelta())
+		require.NoError(t, err)
+		// Change the delta from 0 to 100, so the second value overflows int8
+		data[len(data)-1] = 200
+		data = append(data, 1)
+		_, err = DecodeSlice[int8](data)
+		assert.ErrorIs(t, err, ErrInvalidEncoding)
+	})
+
+	t.Run("Nil and Empty", func(t *testing.T) {
+		assert.Nil(t, roundTrip[int](t, nil))
+		assert.Equal(t, []int{}, roundTrip(t, []int{}))
+		assert.Nil(t, roundTrip[uint8](t, nil, WithDelta()))
+
+		_, err := DecodeSlice[int](nil)
+		assert.ErrorIs(t, err, ErrInvalidEncoding)
+	})
+}
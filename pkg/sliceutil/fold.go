@@ -0,0 +1,58 @@
+package sliceutil
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// CompareFold compares two strings case-insensitively using FoldKey, for
+// use as a collation function. Strings that differ only in case are ordered by byte
+// order, so the ordering is total and deterministic.
+//
+// Example:
+//
+//	CompareFold("apple", "Banana") // returns -1
+func CompareFold(x, y string) int {
+	if c := strings.Compare(FoldKey(x), FoldKey(y)); c != 0 {
+		return c
+	}
+	return strings.Compare(x, y)
+}
+
+// FoldKey maps a string to a case-folded key. Two strings have the same key exactly
+// when strings.EqualFold reports them equal, so the key can be used to group, index or
+// deduplicate strings case-insensitively. Letters are mapped to their lower-case form
+// where it is part of the same Unicode simple folding.
+//
+// Time complexity: O(n) where n is the length of the string
+// Space complexity: O(n) for the key
+//
+// Example:
+//
+//	key := FoldKey("Straße") // returns "straße"
+func FoldKey(s string) string {
+	for i := 0; i < len(s); i++ {
+		if s[i] >= utf8.RuneSelf {
+			return strings.Map(foldRune, s)
+		}
+	}
+	return strings.ToLower(s)
+}
+
+// foldRune is a helper function that maps a rune to a canonical member of its Unicode
+// simple folding orbit, the same equivalence strings.EqualFold uses.
+func foldRune(r rune) rune {
+	lowest := r
+	for f := unicode.SimpleFold(r); f != r; f = unicode.SimpleFold(f) {
+		lowest = min(lowest, f)
+	}
+	if lower := unicode.ToLower(lowest); lower != lowest {
+		for f := unicode.SimpleFold(lowest); f != lowest; f = unicode.SimpleFold(f) {
+			if f == lower {
+				return lower
+			}
+		}
+	}
+	return lowest
+}
@@ -0,0 +1,40 @@
+package sliceutil
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestCompareFold tests the CompareFold function
+func TestCompareFold(t *testing.T) {
+	assert.Negative(t, CompareFold("apple", "Banana"))
+	assert.Positive(t, CompareFold("banana", "Apple"))
+	assert.Negative(t, CompareFold("Apple", "apple"))
+	assert.Zero(t, CompareFold("Straße", "Straße"))
+	assert.Negative(t, CompareFold("ÉCOLE", "école2"))
+}
+
+// TestFoldKey tests the FoldKey function
+func TestFoldKey(t *testing.T) {
+	t.Run("Folds Case", func(t *testing.T) {
+		assert.Equal(t, "hello", FoldKey("HeLLo"))
+		assert.Equal(t, "école", FoldKey("ÉCOLE"))
+		assert.Equal(t, FoldKey("K"), FoldKey("\u212a")) // Kelvin sign
+		assert.Equal(t, FoldKey("σ"), FoldKey("ς"))
+	})
+
+	t.Run("Agrees With EqualFold", func(t *testing.T) {
+		words := []string{"Go", "GO", "go", "ǅ", "ǆ", "Ǆ", "İ", "i", "I", "ı", "ſ", "s", "ß", "ẞ", "Ω", "\u2126", "ω", "µ", "μ", "Μ"}
+		for _, x := range words {
+			for _, y := range words {
+				assert.Equal(t, strings.EqualFold(x, y), FoldKey(x) == FoldKey(y), "%q and %q", x, y)
+			}
+		}
+	})
+
+	t.Run("Nil and Empty", func(t *testing.T) {
+		assert.Equal(t, "", FoldKey(""))
+	})
+}
@@ -0,0 +1,105 @@
+package sliceutil
+
+import "math"
+
+// Frequencies counts how many times each distinct element appears in a slice.
+//
+// Time complexity: O(n) where n is the length of the slice
+// Space complexity: O(k) where k is the number of distinct elements
+//
+// Example:
+//
+//	freq := Frequencies([]string{"a", "b", "a"}) // returns map[a:2 b:1]
+func Frequencies[T comparable](s []T) map[T]int {
+	return countElements(s)
+}
+
+// Histogram distributes the values of a numeric slice into the given number of
+// equal-width buckets spanning the range from the minimum to the maximum value.
+// If all values are equal, a single bucket containing every value is returned.
+// The function returns an error if the slice is empty or nil, or ErrOutOfRange if
+// buckets is not positive.
+//
+// Time complexity: O(n + b) where n is the length of the slice and b the number of buckets
+// Space complexity: O(b) for the buckets
+//
+// Example:
+//
+//	buckets, err := Histogram([]int{1, 2, 2, 3, 9}, 2)
+//	// returns [{1 5 4} {5 9 1}], nil
+func Histogram[T Number](a []T, buckets int) ([]HistogramBucket, error) {
+	if buckets <= 0 {
+		return nil, ErrOutOfRange
+	}
+	low, high, err := numericBounds(a)
+	if err != nil {
+		return nil, err
+	}
+
+	if low == high {
+		return []HistogramBucket{{Lower: low, Upper: high, Count: len(a)}}, nil
+	}
+
+	return fillHistogram(a, low, (high-low)/float64(buckets), buckets), nil
+}
+
+// HistogramWidth distributes the values of a numeric slice into buckets of a fixed width.
+// Bucket boundaries are aligned to multiples of the width, starting with the bucket that
+// contains the minimum value and ending with the bucket that contains the maximum value.
+// The function returns an error if the slice is empty or nil, ErrOutOfRange if width is
+// not positive, or ErrSizeOverflow if more than math.MaxInt32 buckets would be needed,
+// which keeps a tiny width from allocating an enormous result.
+//
+// Example:
+//
+//	buckets, err := HistogramWidth([]float64{0.5, 1.2, 1.7, 3.1}, 1)
+//	// returns [{0 1 1} {1 2 2} {2 3 0} {3 4 1}], nil
+func HistogramWidth[T Number](a []T, width float64) ([]HistogramBucket, error) {
+	if width <= 0 || math.IsNaN(width) || math.IsInf(width, 0) {
+		return nil, ErrOutOfRange
+	}
+	low, high, err := numericBounds(a)
+	if err != nil {
+		return nil, err
+	}
+
+	start := math.Floor(low/width) * width
+	n := math.Floor((high-start)/width) + 1
+	if !(n <= math.MaxInt32) {
+		return nil, ErrSizeOverflow
+	}
+
+	return fillHistogram(a, start, width, int(n)), nil
+}
+
+// numericBounds is a helper function that returns the minimum and maximum of a numeric slice as float64.
+func numericBounds[T Number](a []T) (low, high float64, err error) {
+	minValue, err := Min(a)
+	if err != nil {
+		return 0, 0, err
+	}
+	maxValue, err := Max(a)
+	if err != nil {
+		return 0, 0, err
+	}
+	return float64(minValue), float64(maxValue), nil
+}
+
+// fillHistogram is a helper function that builds n buckets of the given width starting at
+// start and counts the values falling into each of them. Values beyond the last bucket,
+// which can only occur through rounding, are counted in the last bucket.
+func fillHistogram[T Number](a []T, start, width float64, n int) []HistogramBucket {
+	result := make([]HistogramBucket, n)
+	for i := range result {
+		result[i].Lower = start + float64(i)*width
+		result[i].Upper = start + float64(i+1)*width
+	}
+
+	for _, v := range a {
+		idx := int((float64(v) - start) / width)
+		idx = max(0, min(idx, n-1))
+		result[idx].Count++
+	}
+
+	return result
+}
@@ -0,0 +1,97 @@
+package sliceutil
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestFrequencies tests the Frequencies function
+func TestFrequencies(t *testing.T) {
+	t.Run("Counts Elements", func(t *testing.T) {
+		result := Frequencies([]string{"a", "b", "a", "c", "a"})
+		assert.Equal(t, map[string]int{"a": 3, "b": 1, "c": 1}, result)
+	})
+
+	t.Run("Nil and Empty", func(t *testing.T) {
+		assert.Empty(t, Frequencies[int](nil))
+		assert.Empty(t, Frequencies([]int{}))
+	})
+}
+
+// TestHistogram tests the Histogram function
+func TestHistogram(t *testing.T) {
+	t.Run("Equal Width Buckets", func(t *testing.T) {
+		result, err := Histogram([]int{1, 2, 2, 3, 9}, 2)
+		require.NoError(t, err)
+
+		expected := []HistogramBucket{
+			{Lower: 1, Upper: 5, Count: 4},
+			{Lower: 5, Upper: 9, Count: 1},
+		}
+		assert.Equal(t, expected, result)
+	})
+
+	t.Run("Maximum Falls Into Last Bucket", func(t *testing.T) {
+		result, err := Histogram([]float64{0, 1, 2, 3, 4}, 4)
+		require.NoError(t, err)
+
+		counts := Map(result, func(b HistogramBucket) int { return b.Count })
+		assert.Equal(t, []int{1, 1, 1, 2}, counts)
+	})
+
+	t.Run("All Values Equal", func(t *testing.T) {
+		result, err := Histogram([]int{7, 7, 7}, 5)
+		require.NoError(t, err)
+		assert.Equal(t, []HistogramBucket{{Lower: 7, Upper: 7, Count: 3}}, result)
+	})
+
+	t.Run("Invalid Arguments", func(t *testing.T) {
+		_, err := Histogram([]int{1, 2}, 0)
+		assert.Equal(t, ErrOutOfRange, err)
+		_, err = Histogram[int](nil, 2)
+		assert.Equal(t, ErrNilSlice, err)
+		_, err = Histogram([]int{}, 2)
+		assert.Equal(t, ErrEmptySlice, err)
+	})
+}
+
+// TestHistogramWidth tests the HistogramWidth function
+func TestHistogramWidth(t *testing.T) {
+	t.Run("Aligned Buckets", func(t *testing.T) {
+		result, err := HistogramWidth([]float64{0.5, 1.2, 1.7, 3.1}, 1)
+		require.NoError(t, err)
+
+		expected := []HistogramBucket{
+			{Lower: 0, Upper: 1, Count: 1},
+			{Lower: 1, Upper: 2, Count: 2},
+			{Lower: 2, Upper: 3, Count: 0},
+			{Lower: 3, Upper: 4, Count: 1},
+		}
+		assert.Equal(t, expected, result)
+	})
+
+	t.Run("Negative Values", func(t *testing.T) {
+		result, err := HistogramWidth([]int{-15, -5, 5}, 10)
+		require.NoError(t, err)
+
+		assert.Equal(t, -20.0, result[0].Lower)
+		counts := Map(result, func(b HistogramBucket) int { return b.Count })
+		assert.Equal(t, []int{1, 1, 1}, counts)
+	})
+
+	t.Run("Invalid Arguments", func(t *testing.T) {
+		_, err := HistogramWidth([]int{1, 2}, 0)
+		assert.Equal(t, ErrOutOfRange, err)
+		_, err = HistogramWidth([]int{1, 2}, -1)
+		assert.Equal(t, ErrOutOfRange, err)
+		_, err = HistogramWidth([]float64{0, 1e300}, 1e-300)
+		assert.Equal(t, ErrSizeOverflow, err)
+		_, err = HistogramWidth([]float64{0, math.MaxInt32}, 1)
+		assert.Equal(t, ErrSizeOverflow, err)
+		_, err = HistogramWidth([]float64{0, math.Inf(1)}, 1)
+		assert.Equal(t, ErrSizeOverflow, err)
+	})
+}
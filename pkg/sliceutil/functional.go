@@ -0,0 +1,129 @@
+package sliceutil
+
+// Map applies a transformation function to every element of a slice and
+// returns a new slice with the results in the same order.
+//
+// Time complexity: O(n) where n is the length of the slice
+// Space complexity: O(n) for the result slice
+//
+// Example:
+//
+//	lengths := Map([]string{"a", "bb", "ccc"}, func(s string) int { return len(s) })
+//	// returns []int{1, 2, 3}
+func Map[T, U any](s []T, fn func(T) U) []U {
+	if s == nil {
+		return nil
+	}
+
+	result := make([]U, len(s))
+	for i, v := range s {
+		result[i] = fn(v)
+	}
+	return result
+}
+
+// Filter returns a new slice containing only the elements for which the predicate
+// returns true, preserving their order.
+//
+// Example:
+//
+//	even := Filter([]int{1, 2, 3, 4}, func(v int) bool { return v%2 == 0 })
+//	// returns []int{2, 4}
+func Filter[T any](s []T, pred func(T) bool) []T {
+	if s == nil {
+		return nil
+	}
+
+	result := make([]T, 0, len(s))
+	for _, v := range s {
+		if pred(v) {
+			result = append(result, v)
+		}
+	}
+	return result
+}
+
+// Reduce folds a slice into a single value by applying fn to an accumulator and
+// each element in order, starting from the initial value.
+//
+// Example:
+//
+//	total := Reduce([]int{1, 2, 3}, 0, func(acc, v int) int { return acc + v })
+//	// returns 6
+func Reduce[T, U any](s []T, initial U, fn func(U, T) U) U {
+	acc := initial
+	for _, v := range s {
+		acc = fn(acc, v)
+	}
+	return acc
+}
+
+// FlatMap applies a function that returns a slice to every element and
+// concatenates the results into a single slice.
+//
+// Example:
+//
+//	words := FlatMap([]string{"a b", "c"}, strings.Fields)
+//	// returns []string{"a", "b", "c"}
+func FlatMap[T, U any](s []T, fn func(T) []U) []U {
+	if s == nil {
+		return nil
+	}
+
+	result := make([]U, 0, len(s))
+	for _, v := range s {
+		result = append(result, fn(v)...)
+	}
+	return result
+}
+
+// CountFunc counts the elements that satisfy the predicate.
+//
+// Example:
+//
+//	active := CountFunc(users, func(u User) bool { return u.Active })
+func CountFunc[T any](s []T, pred func(T) bool) int {
+	count := 0
+	for _, v := range s {
+		if pred(v) {
+			count++
+		}
+	}
+	return count
+}
+
+// Any reports whether at least one element satisfies the predicate.
+// It returns false for an empty slice and stops at the first match.
+//
+// Example:
+//
+//	hasNegative := Any([]int{3, -1, 2}, func(v int) bool { return v < 0 }) // true
+func Any[T any](s []T, pred func(T) bool) bool {
+	for _, v := range s {
+		if pred(v) {
+			return true
+		}
+	}
+	return false
+}
+
+// All reports whether every element satisfies the predicate.
+// It returns true for an empty slice and stops at the first element that does not match.
+//
+// Example:
+//
+//	allPositive := All([]int{3, 1, 2}, func(v int) bool { return v > 0 }) // true
+func All[T any](s []T, pred func(T) bool) bool {
+	for _, v := range s {
+		if !pred(v) {
+			return false
+		}
+	}
+	return true
+}
+
+// None reports whether no element satisfies the predicate.
+// It returns true for an empty slice.
+func None[T any](s []T, pred func(T) bool) bool {
+	return !Any(s, pred)
+}
@@ -0,0 +1,133 @@
+package sliceutil
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestMap tests the Map function
+func TestMap(t *testing.T) {
+	t.Run("Transforms Elements", func(t *testing.T) {
+		result := Map([]int{1, 2, 3}, strconv.Itoa)
+		assert.Equal(t, []string{"1", "2", "3"}, result)
+	})
+
+	t.Run("Nil and Empty", func(t *testing.T) {
+		assert.Nil(t, Map[int, string](nil, strconv.Itoa))
+		assert.Empty(t, Map([]int{}, strconv.Itoa))
+	})
+}
+
+// TestFilter tests the Filter function
+func TestFilter(t *testing.T) {
+	isEven := func(v int) bool { return v%2 == 0 }
+
+	t.Run("Keeps Matching Elements", func(t *testing.T) {
+		slice := []int{1, 2, 3, 4}
+		assert.Equal(t, []int{2, 4}, Filter(slice, isEven))
+		assert.Equal(t, []int{1, 2, 3, 4}, slice) // Original unchanged
+	})
+
+	t.Run("Nil and Empty", func(t *testing.T) {
+		assert.Nil(t, Filter(nil, isEven))
+		assert.Empty(t, Filter([]int{1, 3}, isEven))
+	})
+}
+
+// TestReduce tests the Reduce function
+func TestReduce(t *testing.T) {
+	t.Run("Sums Elements", func(t *testing.T) {
+		total := Reduce([]int{1, 2, 3}, 0, func(acc, v int) int { return acc + v })
+		assert.Equal(t, 6, total)
+	})
+
+	t.Run("Changes Type", func(t *testing.T) {
+		joined := Reduce([]int{1, 2, 3}, "", func(acc string, v int) string { return acc + strconv.Itoa(v) })
+		assert.Equal(t, "123", joined)
+	})
+
+	t.Run("Nil Returns Initial", func(t *testing.T) {
+		assert.Equal(t, 42, Reduce(nil, 42, func(acc, v int) int { return acc + v }))
+	})
+}
+
+// TestFlatMap tests the FlatMap function
+func TestFlatMap(t *testing.T) {
+	t.Run("Flattens Results", func(t *testing.T) {
+		result := FlatMap([]string{"a b", "", "c"}, strings.Fields)
+		assert.Equal(t, []string{"a", "b", "c"}, result)
+	})
+
+	t.Run("Composes With RemoveDuplicates", func(t *testing.T) {
+		result := RemoveDuplicates(FlatMap([]string{"a b", "b c"}, strings.Fields))
+		assert.Equal(t, []string{"a", "b", "c"}, result)
+	})
+
+	t.Run("Nil Slice", func(t *testing.T) {
+		assert.Nil(t, FlatMap(nil, strings.Fields))
+	})
+}
+
+// TestCountFunc tests the CountFunc function
+func TestCountFunc(t *testing.T) {
+	type user struct {
+		Name   string
+		Active bool
+	}
+	users := []user{{"Alice", true}, {"Bob", false}, {"Carol", true}}
+
+	t.Run("Counts Matches", func(t *testing.T) {
+		assert.Equal(t, 2, CountFunc(users, func(u user) bool { return u.Active }))
+		assert.Equal(t, 0, CountFunc(users, func(u user) bool { return u.Name == "Dave" }))
+	})
+
+	t.Run("Nil Slice", func(t *testing.T) {
+		assert.Equal(t, 0, CountFunc(nil, func(u user) bool { return true }))
+	})
+}
+
+// TestAnyAllNone tests the Any, All and None functions
+func TestAnyAllNone(t *testing.T) {
+	isNegative := func(v int) bool { return v < 0 }
+
+	t.Run("Mixed Values", func(t *testing.T) {
+		s := []int{3, -1, 2}
+		assert.True(t, Any(s, isNegative))
+		assert.False(t, All(s, isNegative))
+		assert.False(t, None(s, isNegative))
+	})
+
+	t.Run("All Match", func(t *testing.T) {
+		s := []int{-3, -1}
+		assert.True(t, Any(s, isNegative))
+		assert.True(t, All(s, isNegative))
+		assert.False(t, None(s, isNegative))
+	})
+
+	t.Run("None Match", func(t *testing.T) {
+		s := []int{3, 1}
+		assert.False(t, Any(s, isNegative))
+		assert.False(t, All(s, isNegative))
+		assert.True(t, None(s, isNegative))
+	})
+
+	t.Run("Short Circuits", func(t *testing.T) {
+		calls := 0
+		counting := func(v int) bool { calls++; return v < 0 }
+		Any([]int{-1, 2, 3}, counting)
+		assert.Equal(t, 1, calls)
+
+		calls = 0
+		All([]int{1, -2, 3}, func(v int) bool { calls++; return v > 0 })
+		assert.Equal(t, 2, calls)
+	})
+
+	t.Run("Nil and Empty", func(t *testing.T) {
+		assert.False(t, Any[int](nil, isNegative))
+		assert.True(t, All[int](nil, isNegative))
+		assert.True(t, None([]int{}, isNegative))
+	})
+}
@@ -0,0 +1,149 @@
+// Package gen generates random slices for property-based and fuzz tests of package
+// sliceutil and its callers. Every generator takes a *rand.Rand as its last argument:
+// pass a seeded source to reproduce a failing case, or nil to use the global random
+// source.
+package gen
+
+import (
+	"math/rand/v2"
+
+	"github.com/devrob-go/sliceutil/pkg/sliceutil"
+)
+
+// RandomInts returns n integers drawn uniformly from the inclusive range [lo, hi].
+// If lo is greater than hi the bounds are swapped. If n is not positive, the result
+// is empty.
+//
+// Time complexity: O(n)
+// Space complexity: O(n) for the result
+//
+// Example:
+//
+//	rng := rand.New(rand.NewPCG(1, 2))
+//	dice := RandomInts(10, 1, 6, rng)
+func RandomInts(n, lo, hi int, rng *rand.Rand) []int {
+	if lo > hi {
+		lo, hi = hi, lo
+	}
+	// The span is computed in uint64 so that the full int range does not overflow
+	span := uint64(hi) - uint64(lo) + 1
+	return sliceutil.Generate(n, func(int) int {
+		if span == 0 {
+			return int(randUint64(rng))
+		}
+		return lo + int(randUint64N(rng, span))
+	})
+}
+
+// SortedInts returns n integers drawn uniformly from the inclusive range [lo, hi],
+// sorted in ascending order. Duplicates are possible, which makes the result suitable
+// input for the merge and binary search functions.
+//
+// Time complexity: O(n log n)
+// Space complexity: O(n) for the result
+//
+// Example:
+//
+//	sorted := SortedInts(100, 0, 1000, nil)
+func SortedInts(n, lo, hi int, rng *rand.Rand) []int {
+	result := RandomInts(n, lo, hi, rng)
+	sliceutil.Sort(result, sliceutil.OrderAsc)
+	return result
+}
+
+// RandomStrings returns n strings of exactly length runes, each chosen uniformly from
+// alphabet. A small alphabet produces many duplicates, which is useful for exercising
+// the deduplication and multiset functions. If alphabet is empty or length is not
+// positive, every string is empty. If n is not positive, the result is empty.
+//
+// Time complexity: O(n * length)
+// Space complexity: O(n * length) for the result
+//
+// Example:
+//
+//	words := RandomStrings(50, "abc", 3, nil) // e.g. ["cab" "aac" ...]
+func RandomStrings(n int, alphabet string, length int, rng *rand.Rand) []string {
+	runes := []rune(alphabet)
+	return sliceutil.Generate(n, func(int) string {
+		if len(runes) == 0 || length <= 0 {
+			return ""
+		}
+		word := make([]rune, length)
+		for i := range word {
+			word[i] = runes[randIntN(rng, len(runes))]
+		}
+		return string(word)
+	})
+}
+
+// Corrupt returns a copy of a slice with k random edits applied, where each edit
+// deletes an element, duplicates an element in place, or swaps two adjacent elements.
+// The result is therefore within 2*k insertions and deletions of the original, which
+// makes it a natural second input for ComputeDiff and the compare functions.
+// Edits are skipped once the slice is empty. The original slice is not modified, and
+// a nil slice returns nil.
+//
+// Time complexity: O(n * k) where n is the length of the slice
+// Space complexity: O(n + k) for the result
+//
+// Example:
+//
+//	a := RandomInts(20, 0, 9, rng)
+//	b := Corrupt(a, 3, rng)
+//	ops := sliceutil.ComputeDiff(a, b)
+func Corrupt[T any](s []T, k int, rng *rand.Rand) []T {
+	if s == nil {
+		return nil
+	}
+
+	result := append(make([]T, 0, len(s)+max(k, 0)), s...)
+	for range k {
+		if len(result) == 0 {
+			break
+		}
+		i := randIntN(rng, len(result))
+		switch randIntN(rng, 3) {
+		case 0:
+			result = append(result[:i], result[i+1:]...)
+		case 1:
+			result = append(result[:i+1], result[i:]...)
+		default:
+			// Swap with the next element, or the previous one at the end of the slice
+			j := i + 1
+			if j == len(result) {
+				j = i - 1
+			}
+			if j >= 0 {
+				result[i], result[j] = result[j], result[i]
+			}
+		}
+	}
+	return result
+}
+
+// randIntN is a helper function that returns a random int in [0, n) from rng,
+// falling back to the global random source when rng is nil.
+func randIntN(rng *rand.Rand, n int) int {
+	if rng == nil {
+		return rand.IntN(n)
+	}
+	return rng.IntN(n)
+}
+
+// randUint64N is a helper function that returns a random uint64 in [0, n) from rng,
+// falling back to the global random source when rng is nil.
+func randUint64N(rng *rand.Rand, n uint64) uint64 {
+	if rng == nil {
+		return rand.Uint64N(n)
+	}
+	return rng.Uint64N(n)
+}
+
+// randUint64 is a helper function that returns a random uint64 from rng, falling back
+// to the global random source when rng is nil.
+func randUint64(rng *rand.Rand) uint64 {
+	if rng == nil {
+		return rand.Uint64()
+	}
+	return rng.Uint64()
+}
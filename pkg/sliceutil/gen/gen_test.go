@@ -0,0 +1,124 @@
+package gen
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/devrob-go/sliceutil/pkg/sliceutil"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestRandomInts tests the RandomInts function
+func TestRandomInts(t *testing.T) {
+	t.Run("Within Bounds", func(t *testing.T) {
+		rng := rand.New(rand.NewPCG(1, 2))
+		s := RandomInts(1000, -3, 3, rng)
+		assert.Len(t, s, 1000)
+		seen := make(map[int]bool)
+		for _, v := range s {
+			assert.GreaterOrEqual(t, v, -3)
+			assert.LessOrEqual(t, v, 3)
+			seen[v] = true
+		}
+		assert.Len(t, seen, 7)
+	})
+
+	t.Run("Reproducible", func(t *testing.T) {
+		a := RandomInts(20, 0, 100, rand.New(rand.NewPCG(7, 7)))
+		b := RandomInts(20, 0, 100, rand.New(rand.NewPCG(7, 7)))
+		assert.Equal(t, a, b)
+	})
+
+	t.Run("Swapped And Full Bounds", func(t *testing.T) {
+		for _, v := range RandomInts(100, 5, 2, nil) {
+			assert.True(t, v >= 2 && v <= 5)
+		}
+		assert.Equal(t, []int{4, 4}, RandomInts(2, 4, 4, nil))
+		assert.Len(t, RandomInts(10, math.MinInt, math.MaxInt, nil), 10)
+	})
+
+	t.Run("Nil and Empty", func(t *testing.T) {
+		assert.Equal(t, []int{}, RandomInts(0, 0, 10, nil))
+		assert.Equal(t, []int{}, RandomInts(-1, 0, 10, nil))
+	})
+}
+
+// TestSortedInts tests the SortedInts function
+func TestSortedInts(t *testing.T) {
+	t.Run("Ascending", func(t *testing.T) {
+		s := SortedInts(500, 0, 50, rand.New(rand.NewPCG(3, 4)))
+		assert.Len(t, s, 500)
+		assert.True(t, sliceutil.IsSortedOrder(s, sliceutil.OrderAsc))
+	})
+
+	t.Run("Nil and Empty", func(t *testing.T) {
+		assert.Equal(t, []int{}, SortedInts(0, 0, 10, nil))
+	})
+}
+
+// TestRandomStrings tests the RandomStrings function
+func TestRandomStrings(t *testing.T) {
+	t.Run("Alphabet And Length", func(t *testing.T) {
+		s := RandomStrings(200, "aßc", 4, rand.New(rand.NewPCG(5, 6)))
+		assert.Len(t, s, 200)
+		for _, w := range s {
+			assert.Equal(t, 4, utf8.RuneCountInString(w))
+			for _, r := range w {
+				assert.Contains(t, "aßc", string(r))
+			}
+		}
+	})
+
+	t.Run("Empty Alphabet Or Length", func(t *testing.T) {
+		assert.Equal(t, []string{"", ""}, RandomStrings(2, "", 5, nil))
+		assert.Equal(t, []string{"", ""}, RandomStrings(2, "ab", 0, nil))
+	})
+
+	t.Run("Nil and Empty", func(t *testing.T) {
+		assert.Equal(t, []string{}, RandomStrings(0, "ab", 3, nil))
+	})
+}
+
+// TestCorrupt tests the Corrupt function
+func TestCorrupt(t *testing.T) {
+	t.Run("Bounded Edit Distance", func(t *testing.T) {
+		rng := rand.New(rand.NewPCG(8, 9))
+		for range 200 {
+			a := RandomInts(rng.IntN(30), 0, 9, rng)
+			k := rng.IntN(5)
+			b := Corrupt(a, k, rng)
+
+			assert.LessOrEqual(t, len(b), len(a)+k)
+			assert.GreaterOrEqual(t, len(b), len(a)-k)
+
+			changes := 0
+			for _, op := range sliceutil.ComputeDiff(a, b) {
+				if op.Kind != sliceutil.EditKeep {
+					changes++
+				}
+			}
+			assert.LessOrEqual(t, changes, 2*k)
+			patched, err := sliceutil.ApplyPatch(a, sliceutil.ComputeDiff(a, b))
+			assert.NoError(t, err)
+			assert.Equal(t, b, patched)
+		}
+	})
+
+	t.Run("Does Not Modify Input", func(t *testing.T) {
+		a := []int{1, 2, 3, 4, 5}
+		Corrupt(a, 10, rand.New(rand.NewPCG(1, 1)))
+		assert.Equal(t, []int{1, 2, 3, 4, 5}, a)
+	})
+
+	t.Run("No Edits", func(t *testing.T) {
+		assert.Equal(t, []string{"a", "b"}, Corrupt([]string{"a", "b"}, 0, nil))
+		assert.Equal(t, []string{"a"}, Corrupt([]string{"a"}, -2, nil))
+	})
+
+	t.Run("Nil and Empty", func(t *testing.T) {
+		assert.Nil(t, Corrupt[int](nil, 3, nil))
+		assert.Equal(t, []int{}, Corrupt([]int{}, 3, nil))
+	})
+}
@@ -0,0 +1,100 @@
+package sliceutil
+
+// Range returns the integers in the half-open interval [start, end) in ascending order.
+// If end is not greater than start, the result is empty.
+//
+// Example:
+//
+//	ids := Range(1, 5)
+//	// returns []int{1, 2, 3, 4}
+func Range[T Integer](start, end T) []T {
+	return RangeStep(start, end, 1)
+}
+
+// RangeStep returns the values start, start+step, start+2*step, ... that lie strictly
+// before end. A negative step counts down and stops strictly above end. If step is zero,
+// it points away from end, or any argument is NaN or infinite, the result is empty.
+// Each value is computed from start rather than accumulated, so float ranges do not drift,
+// and the sequence stops instead of wrapping around when it would overflow T.
+//
+// Time complexity: O(k) where k is the number of values produced
+// Space complexity: O(k) for the result
+//
+// Example:
+//
+//	evens := RangeStep(0, 10, 2)      // returns []int{0, 2, 4, 6, 8}
+//	countdown := RangeStep(3, 0, -1)  // returns []int{3, 2, 1}
+//	ticks := RangeStep(0, 1, 0.25)    // returns []float64{0, 0.25, 0.5, 0.75}
+func RangeStep[T Number](start, end, step T) []T {
+	var zero T
+	if !isFinite(start) || !isFinite(end) || !isFinite(step) {
+		return []T{}
+	}
+	ascending := step > zero
+	if !ascending && !(step < zero) {
+		return []T{}
+	}
+
+	result := []T{}
+	var prev T
+	for i := 0; ; i++ {
+		v := start + T(i)*step
+		if i > 0 && ((ascending && v <= prev) || (!ascending && v >= prev)) {
+			// the sequence wrapped around or stopped making progress
+			break
+		}
+		if (ascending && v >= end) || (!ascending && v <= end) {
+			break
+		}
+		result = append(result, v)
+		prev = v
+	}
+
+	return result
+}
+
+// isFinite is a helper function that reports whether a number is neither NaN nor
+// infinite. Integers are always finite; for floats, x-x is NaN exactly when x is.
+func isFinite[T Number](x T) bool {
+	var zero T
+	return x-x == zero
+}
+
+// Repeat returns a slice containing n copies of v. If n is not positive, the result is empty.
+// Reference types such as slices or pointers are copied shallowly, so every element
+// refers to the same underlying value.
+//
+// Example:
+//
+//	row := Repeat("-", 3)
+//	// returns []string{"-", "-", "-"}
+func Repeat[T any](v T, n int) []T {
+	if n <= 0 {
+		return []T{}
+	}
+
+	result := make([]T, n)
+	for i := range result {
+		result[i] = v
+	}
+	return result
+}
+
+// Generate returns a slice of n elements where the element at index i is f(i).
+// f is called once per index in ascending order. If n is not positive, the result is empty.
+//
+// Example:
+//
+//	squares := Generate(4, func(i int) int { return i * i })
+//	// returns []int{0, 1, 4, 9}
+func Generate[T any](n int, f func(i int) T) []T {
+	if n <= 0 {
+		return []T{}
+	}
+
+	result := make([]T, n)
+	for i := range result {
+		result[i] = f(i)
+	}
+	return result
+}
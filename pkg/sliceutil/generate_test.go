@@ -0,0 +1,116 @@
+package sliceutil
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestRange tests the Range function
+func TestRange(t *testing.T) {
+	t.Run("Ascending", func(t *testing.T) {
+		assert.Equal(t, []int{1, 2, 3, 4}, Range(1, 5))
+	})
+
+	t.Run("Negative Bounds", func(t *testing.T) {
+		assert.Equal(t, []int64{-2, -1, 0}, Range[int64](-2, 1))
+	})
+
+	t.Run("Empty Ranges", func(t *testing.T) {
+		assert.Equal(t, []int{}, Range(3, 3))
+		assert.Equal(t, []int{}, Range(5, 1))
+	})
+
+	t.Run("Stops At Type Limit", func(t *testing.T) {
+		assert.Equal(t, []uint8{253, 254}, Range[uint8](253, 255))
+	})
+}
+
+// TestRangeStep tests the RangeStep function
+func TestRangeStep(t *testing.T) {
+	t.Run("Positive Step", func(t *testing.T) {
+		assert.Equal(t, []int{0, 2, 4, 6, 8}, RangeStep(0, 10, 2))
+		assert.Equal(t, []int{0, 3, 6, 9}, RangeStep(0, 10, 3))
+	})
+
+	t.Run("Negative Step", func(t *testing.T) {
+		assert.Equal(t, []int{3, 2, 1}, RangeStep(3, 0, -1))
+		assert.Equal(t, []int{10, 7, 4, 1}, RangeStep(10, 0, -3))
+	})
+
+	t.Run("Float Step", func(t *testing.T) {
+		assert.Equal(t, []float64{0, 0.25, 0.5, 0.75}, RangeStep(0, 1, 0.25))
+
+		result := RangeStep(0, 1, 0.1)
+		assert.Len(t, result, 10)
+		assert.InDelta(t, 0.9, result[9], 1e-12)
+	})
+
+	t.Run("Step Away From End", func(t *testing.T) {
+		assert.Equal(t, []int{}, RangeStep(0, 10, -1))
+		assert.Equal(t, []int{}, RangeStep(10, 0, 1))
+	})
+
+	t.Run("Zero and NaN Step", func(t *testing.T) {
+		assert.Equal(t, []int{}, RangeStep(0, 10, 0))
+		assert.Equal(t, []float64{}, RangeStep(0, 1, math.NaN()))
+	})
+
+	t.Run("Non-Finite Bounds", func(t *testing.T) {
+		assert.Equal(t, []float64{}, RangeStep(0, math.Inf(1), 1))
+		assert.Equal(t, []float64{}, RangeStep(0, math.NaN(), 1))
+		assert.Equal(t, []float64{}, RangeStep(math.Inf(-1), 0, 1))
+		assert.Equal(t, []float64{}, RangeStep(math.NaN(), 10, 1))
+		assert.Equal(t, []float64{}, RangeStep(0, 1, math.Inf(1)))
+	})
+
+	t.Run("Overflow Does Not Wrap", func(t *testing.T) {
+		assert.Equal(t, []int8{100, 120}, RangeStep[int8](100, 127, 20))
+		assert.Equal(t, []int8{-100, -120}, RangeStep[int8](-100, -128, -20))
+	})
+}
+
+// TestRepeat tests the Repeat function
+func TestRepeat(t *testing.T) {
+	t.Run("Repeats Value", func(t *testing.T) {
+		assert.Equal(t, []string{"-", "-", "-"}, Repeat("-", 3))
+	})
+
+	t.Run("Shallow Copies", func(t *testing.T) {
+		result := Repeat([]int{1}, 2)
+		result[0][0] = 9
+		assert.Equal(t, 9, result[1][0])
+	})
+
+	t.Run("Non-Positive Count", func(t *testing.T) {
+		assert.Equal(t, []int{}, Repeat(1, 0))
+		assert.Equal(t, []int{}, Repeat(1, -1))
+	})
+}
+
+// TestGenerate tests the Generate function
+func TestGenerate(t *testing.T) {
+	t.Run("Squares", func(t *testing.T) {
+		assert.Equal(t, []int{0, 1, 4, 9}, Generate(4, func(i int) int { return i * i }))
+	})
+
+	t.Run("Called In Order", func(t *testing.T) {
+		var calls []int
+		Generate(3, func(i int) struct{} {
+			calls = append(calls, i)
+			return struct{}{}
+		})
+		assert.Equal(t, []int{0, 1, 2}, calls)
+	})
+
+	t.Run("Non-Positive Count", func(t *testing.T) {
+		called := false
+		result := Generate(0, func(i int) int {
+			called = true
+			return i
+		})
+		assert.Equal(t, []int{}, result)
+		assert.False(t, called)
+	})
+}
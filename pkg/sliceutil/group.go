@@ -0,0 +1,161 @@
+package sliceutil
+
+// GroupBy buckets the elements of a slice by the key returned for each element.
+// Within every bucket, elements keep their original relative order.
+//
+// Time complexity: O(n) where n is the length of the slice
+// Space complexity: O(n) for the result map
+//
+// Example:
+//
+//	people := []Person{{Name: "Alice", City: "NY"}, {Name: "Bob", City: "LA"}, {Name: "Carol", City: "NY"}}
+//	byCity := GroupBy(people, func(p Person) string { return p.City })
+//	// returns map[LA:[Bob] NY:[Alice Carol]]
+func GroupBy[T any, K comparable](s []T, key func(T) K) map[K][]T {
+	groups := make(map[K][]T)
+	for _, v := range s {
+		k := key(v)
+		groups[k] = append(groups[k], v)
+	}
+	return groups
+}
+
+// IndexBy builds a map from the key of each element to the element itself.
+// When several elements share a key, the last one wins.
+//
+// Example:
+//
+//	byID := IndexBy(users, func(u User) int { return u.ID })
+//	user := byID[42]
+func IndexBy[T any, K comparable](s []T, key func(T) K) map[K]T {
+	index := make(map[K]T, len(s))
+	for _, v := range s {
+		index[key(v)] = v
+	}
+	return index
+}
+
+// GroupByAdjacent splits a slice into groups of consecutive elements that share
+// the same key. A new group is started every time the key changes between two
+// neighbouring elements, so equal keys that are not adjacent end up in separate
+// groups. This makes it well suited for processing pre-sorted event streams.
+//
+// Time complexity: O(n) where n is the length of the slice
+// Space complexity: O(n) for the result groups
+//
+// Example:
+//
+//	s := []int{1, 1, 2, 2, 1}
+//	groups := GroupByAdjacent(s, func(v int) int { return v })
+//	// returns [][]int{{1, 1}, {2, 2}, {1}}
+func GroupByAdjacent[T any, K comparable](s []T, keyFn func(T) K) [][]T {
+	if s == nil {
+		return nil
+	}
+	if len(s) == 0 {
+		return [][]T{}
+	}
+
+	groups := make([][]T, 0)
+	start := 0
+	currentKey := keyFn(s[0])
+
+	for i := 1; i < len(s); i++ {
+		key := keyFn(s[i])
+		if key != currentKey {
+			// Key changed, close the current group and start a new one
+			groups = append(groups, append([]T{}, s[start:i]...))
+			start = i
+			currentKey = key
+		}
+	}
+
+	// Append the trailing group
+	groups = append(groups, append([]T{}, s[start:]...))
+
+	return groups
+}
+
+// Runs returns the maximal runs of equal consecutive values in a slice.
+// Each run records the repeated value, the index where it starts and its length,
+// which makes the result suitable for run-length encoding and streak analysis.
+//
+// Time complexity: O(n) where n is the length of the slice
+// Space complexity: O(r) where r is the number of runs
+//
+// Example:
+//
+//	runs := Runs([]string{"a", "a", "b", "a"})
+//	// returns [{a 0 2} {b 2 1} {a 3 1}]
+func Runs[T comparable](s []T) []Run[T] {
+	if s == nil {
+		return nil
+	}
+
+	runs := make([]Run[T], 0)
+	for i, v := range s {
+		last := len(runs) - 1
+		if last >= 0 && runs[last].Value == v {
+			runs[last].Length++
+			continue
+		}
+		runs = append(runs, Run[T]{Value: v, Start: i, Length: 1})
+	}
+
+	return runs
+}
+
+// Chunk splits a slice into consecutive batches of the given size.
+// The last batch holds the remaining elements and may be shorter.
+// If size is not positive, the result is empty.
+//
+// Time complexity: O(n) where n is the length of the slice
+// Space complexity: O(n) for the result batches
+//
+// Example:
+//
+//	batches := Chunk([]int{1, 2, 3, 4, 5}, 2)
+//	// returns [][]int{{1, 2}, {3, 4}, {5}}
+func Chunk[T any](s []T, size int) [][]T {
+	if s == nil {
+		return nil
+	}
+	if size <= 0 {
+		return [][]T{}
+	}
+
+	// Step by the remaining length so that a huge size cannot overflow
+	chunks := make([][]T, 0, len(s)/size+1)
+	for start := 0; start < len(s); {
+		end := start + min(size, len(s)-start)
+		chunks = append(chunks, append([]T{}, s[start:end]...))
+		start = end
+	}
+
+	return chunks
+}
+
+// Partition splits a slice into the elements that satisfy the predicate and those
+// that do not. Both results preserve the original order.
+//
+// Example:
+//
+//	even, odd := Partition([]int{1, 2, 3, 4}, func(v int) bool { return v%2 == 0 })
+//	// even: []int{2, 4}, odd: []int{1, 3}
+func Partition[T any](s []T, pred func(T) bool) (matched, rest []T) {
+	if s == nil {
+		return nil, nil
+	}
+
+	matched = make([]T, 0)
+	rest = make([]T, 0)
+	for _, v := range s {
+		if pred(v) {
+			matched = append(matched, v)
+		} else {
+			rest = append(rest, v)
+		}
+	}
+
+	return matched, rest
+}
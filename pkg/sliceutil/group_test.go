@@ -0,0 +1,172 @@
+package sliceutil
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestGroupBy tests the GroupBy function
+func TestGroupBy(t *testing.T) {
+	type person struct {
+		Name string
+		City string
+	}
+	city := func(p person) string { return p.City }
+
+	t.Run("Buckets By Key", func(t *testing.T) {
+		people := []person{{"Alice", "NY"}, {"Bob", "LA"}, {"Carol", "NY"}}
+		result := GroupBy(people, city)
+
+		assert.Equal(t, map[string][]person{
+			"NY": {{"Alice", "NY"}, {"Carol", "NY"}},
+			"LA": {{"Bob", "LA"}},
+		}, result)
+	})
+
+	t.Run("Nil and Empty", func(t *testing.T) {
+		assert.Empty(t, GroupBy(nil, city))
+		assert.NotNil(t, GroupBy(nil, city))
+	})
+}
+
+// TestIndexBy tests the IndexBy function
+func TestIndexBy(t *testing.T) {
+	type user struct {
+		ID   int
+		Name string
+	}
+	id := func(u user) int { return u.ID }
+
+	t.Run("Last Wins", func(t *testing.T) {
+		users := []user{{1, "Alice"}, {2, "Bob"}, {1, "Alicia"}}
+		result := IndexBy(users, id)
+
+		assert.Equal(t, map[int]user{1: {1, "Alicia"}, 2: {2, "Bob"}}, result)
+	})
+
+	t.Run("Nil and Empty", func(t *testing.T) {
+		assert.Empty(t, IndexBy(nil, id))
+		assert.NotNil(t, IndexBy(nil, id))
+	})
+}
+
+// TestGroupByAdjacent tests the GroupByAdjacent function
+func TestGroupByAdjacent(t *testing.T) {
+	t.Run("Groups Consecutive Keys", func(t *testing.T) {
+		s := []int{1, 1, 2, 2, 2, 1}
+		result := GroupByAdjacent(s, func(v int) int { return v })
+
+		assert.Equal(t, [][]int{{1, 1}, {2, 2, 2}, {1}}, result)
+	})
+
+	t.Run("Groups By Derived Key", func(t *testing.T) {
+		type event struct {
+			Kind string
+			ID   int
+		}
+		s := []event{{"start", 1}, {"start", 2}, {"stop", 3}, {"start", 4}}
+		result := GroupByAdjacent(s, func(e event) string { return e.Kind })
+
+		assert.Len(t, result, 3)
+		assert.Equal(t, []event{{"start", 1}, {"start", 2}}, result[0])
+		assert.Equal(t, []event{{"stop", 3}}, result[1])
+		assert.Equal(t, []event{{"start", 4}}, result[2])
+	})
+
+	t.Run("Groups Do Not Alias Input", func(t *testing.T) {
+		s := []int{1, 1, 2}
+		result := GroupByAdjacent(s, func(v int) int { return v })
+		result[0][0] = 99
+
+		assert.Equal(t, []int{1, 1, 2}, s)
+	})
+
+	t.Run("Nil and Empty", func(t *testing.T) {
+		assert.Nil(t, GroupByAdjacent[int](nil, func(v int) int { return v }))
+		assert.Empty(t, GroupByAdjacent([]int{}, func(v int) int { return v }))
+		assert.Equal(t, [][]int{{7}}, GroupByAdjacent([]int{7}, func(v int) int { return v }))
+	})
+}
+
+// TestRuns tests the Runs function
+func TestRuns(t *testing.T) {
+	t.Run("Detects Maximal Runs", func(t *testing.T) {
+		result := Runs([]string{"a", "a", "b", "a", "a", "a"})
+		expected := []Run[string]{
+			{Value: "a", Start: 0, Length: 2},
+			{Value: "b", Start: 2, Length: 1},
+			{Value: "a", Start: 3, Length: 3},
+		}
+		assert.Equal(t, expected, result)
+	})
+
+	t.Run("No Repeats", func(t *testing.T) {
+		result := Runs([]int{1, 2, 3})
+		assert.Len(t, result, 3)
+		for i, r := range result {
+			assert.Equal(t, i, r.Start)
+			assert.Equal(t, 1, r.Length)
+		}
+	})
+
+	t.Run("Nil and Empty", func(t *testing.T) {
+		assert.Nil(t, Runs[int](nil))
+		assert.Empty(t, Runs([]int{}))
+	})
+}
+
+// TestChunk tests the Chunk function
+func TestChunk(t *testing.T) {
+	t.Run("Uneven Chunks", func(t *testing.T) {
+		result := Chunk([]int{1, 2, 3, 4, 5}, 2)
+		assert.Equal(t, [][]int{{1, 2}, {3, 4}, {5}}, result)
+	})
+
+	t.Run("Even Chunks", func(t *testing.T) {
+		result := Chunk([]int{1, 2, 3, 4}, 2)
+		assert.Equal(t, [][]int{{1, 2}, {3, 4}}, result)
+	})
+
+	t.Run("Size Larger Than Slice", func(t *testing.T) {
+		assert.Equal(t, [][]int{{1, 2}}, Chunk([]int{1, 2}, 10))
+	})
+
+	t.Run("Huge Size", func(t *testing.T) {
+		assert.Equal(t, [][]int{{1, 2, 3}}, Chunk([]int{1, 2, 3}, math.MaxInt))
+	})
+
+	t.Run("Invalid Size", func(t *testing.T) {
+		assert.Empty(t, Chunk([]int{1, 2}, 0))
+		assert.Empty(t, Chunk([]int{1, 2}, -3))
+	})
+
+	t.Run("Nil and Empty", func(t *testing.T) {
+		assert.Nil(t, Chunk[int](nil, 2))
+		assert.Empty(t, Chunk([]int{}, 2))
+	})
+}
+
+// TestPartition tests the Partition function
+func TestPartition(t *testing.T) {
+	isEven := func(v int) bool { return v%2 == 0 }
+
+	t.Run("Splits By Predicate", func(t *testing.T) {
+		even, odd := Partition([]int{1, 2, 3, 4, 5}, isEven)
+		assert.Equal(t, []int{2, 4}, even)
+		assert.Equal(t, []int{1, 3, 5}, odd)
+	})
+
+	t.Run("All Match", func(t *testing.T) {
+		even, odd := Partition([]int{2, 4}, isEven)
+		assert.Equal(t, []int{2, 4}, even)
+		assert.Empty(t, odd)
+	})
+
+	t.Run("Nil Slice", func(t *testing.T) {
+		even, odd := Partition(nil, isEven)
+		assert.Nil(t, even)
+		assert.Nil(t, odd)
+	})
+}
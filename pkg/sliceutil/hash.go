@@ -0,0 +1,263 @@
+package sliceutil
+
+import (
+	"encoding/binary"
+	"hash"
+	"math"
+	"reflect"
+	"unsafe"
+)
+
+// HashSlice computes a stable, order-sensitive 64-bit hash of a slice.
+// The hash is based on FNV-1a and does not depend on process-specific seeds,
+// so it stays the same across runs and can be used as a cache key or to cheaply
+// rule out equality of large slices before comparing them element by element.
+//
+// Basic types (booleans, integers, floats and strings) are hashed from their binary
+// representation, and structs, arrays and interfaces are walked down to their basic
+// values, so negative zero hashes like zero wherever it appears. Pointers and channels
+// are only hashed as nil or non-nil, since their addresses differ between runs.
+// Equal slices always produce equal hashes, but equal hashes do not guarantee equal slices.
+// Nil and empty slices hash to the same value.
+//
+// Time complexity: O(n) where n is the length of the slice
+// Space complexity: O(1)
+//
+// Example:
+//
+//	h1 := HashSlice([]int{1, 2, 3})
+//	h2 := HashSlice([]int{3, 2, 1}) // h1 != h2
+func HashSlice[T comparable](s []T) uint64 {
+	kind := reflect.TypeFor[T]().Kind()
+	h := newFNVHash()
+	h.writeWord(uint64(len(s)))
+	for i := range s {
+		writeHashElement(&h, kind, &s[i])
+	}
+	return uint64(h)
+}
+
+// HashSliceUnordered computes a stable, order-insensitive 64-bit hash of a slice.
+// Slices containing the same elements with the same multiplicities produce the same
+// hash regardless of element order, which matches the notion of multiset equality.
+//
+// Example:
+//
+//	h1 := HashSliceUnordered([]int{1, 2, 3})
+//	h2 := HashSliceUnordered([]int{3, 2, 1}) // h1 == h2
+func HashSliceUnordered[T comparable](s []T) uint64 {
+	// Combine per-element hashes with a commutative operation
+	kind := reflect.TypeFor[T]().Kind()
+	var sum uint64
+	for i := range s {
+		h := newFNVHash()
+		writeHashElement(&h, kind, &s[i])
+		sum += mixHash(uint64(h))
+	}
+
+	h := newFNVHash()
+	h.writeWord(uint64(len(s)))
+	return mixHash(uint64(h) ^ sum)
+}
+
+// EqualByHash checks if two slices are equal in values and order, using fingerprints
+// previously computed with HashSlice to short-circuit. Different fingerprints prove the
+// slices differ, so the result is false without looking at the elements; equal
+// fingerprints are confirmed with CompareSlices, so a hash collision can never produce
+// a wrong answer. This makes it cheap to compare the same large slices repeatedly by
+// caching their fingerprints next to them.
+//
+// Time complexity: O(1) when the fingerprints differ, O(n) otherwise
+// Space complexity: O(1)
+//
+// Example:
+//
+//	hashA := HashSlice(a) // computed once, e.g. when a is loaded
+//	for _, b := range candidates {
+//		if EqualByHash(a, hashA, b.Items, b.Hash) { ... }
+//	}
+func EqualByHash[T comparable](a []T, hashA uint64, b []T, hashB uint64) bool {
+	if hashA != hashB {
+		return false
+	}
+	return CompareSlices(a, b)
+}
+
+// EqualByHashUnordered checks if two slices contain the same elements with the same
+// multiplicities, using fingerprints previously computed with HashSliceUnordered to
+// short-circuit. Equal fingerprints are confirmed with CompareSlicesUnordered.
+//
+// Example:
+//
+//	equal := EqualByHashUnordered(a, HashSliceUnordered(a), b, HashSliceUnordered(b))
+func EqualByHashUnordered[T comparable](a []T, hashA uint64, b []T, hashB uint64) bool {
+	if hashA != hashB {
+		return false
+	}
+	return CompareSlicesUnordered(a, b)
+}
+
+// writeHashLength is a helper function that writes a length prefix into the hash.
+func writeHashLength(h hash.Hash64, n int) {
+	var buf [8]byte
+	binary.LittleEndian.PutUint64(buf[:], uint64(n))
+	_, _ = h.Write(buf[:])
+}
+
+// fnvHash is a helper type that computes a 64-bit FNV-1a hash like hash/fnv, without
+// the allocation of writing small buffers through the hash.Hash64 interface.
+type fnvHash uint64
+
+const (
+	fnvOffset64 = 14695981039346656037
+	fnvPrime64  = 1099511628211
+)
+
+// newFNVHash returns an empty FNV-1a hash.
+func newFNVHash() fnvHash {
+	return fnvOffset64
+}
+
+// writeByte adds one byte to the hash.
+func (h *fnvHash) writeByte(b byte) {
+	*h = (*h ^ fnvHash(b)) * fnvPrime64
+}
+
+// writeWord adds a 64-bit word to the hash in little-endian byte order.
+func (h *fnvHash) writeWord(x uint64) {
+	for i := 0; i < 8; i++ {
+		h.writeByte(byte(x >> (8 * i)))
+	}
+}
+
+// writeString adds the bytes of a string to the hash.
+func (h *fnvHash) writeString(s string) {
+	for i := 0; i < len(s); i++ {
+		h.writeByte(s[i])
+	}
+}
+
+// writeHashElement is a helper function that writes a stable encoding of the element at
+// p, whose type has the given kind, into the hash. Basic kinds are read directly; only
+// structs, arrays, interfaces, pointers and channels go through reflection.
+// Every encoding is prefixed with a tag byte so that values of different kinds never collide trivially.
+func writeHashElement[T comparable](h *fnvHash, kind reflect.Kind, v *T) {
+	p := unsafe.Pointer(v)
+	switch kind {
+	case reflect.Bool:
+		writeHashBool(h, *(*bool)(p))
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		writeHashWord(h, 'i', loadInteger(kind, p))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		writeHashWord(h, 'u', loadInteger(kind, p))
+	case reflect.Float32:
+		writeHashFloat(h, float64(*(*float32)(p)))
+	case reflect.Float64:
+		writeHashFloat(h, *(*float64)(p))
+	case reflect.Complex64:
+		c := *(*complex64)(p)
+		writeHashFloat(h, float64(real(c)))
+		writeHashFloat(h, float64(imag(c)))
+	case reflect.Complex128:
+		c := *(*complex128)(p)
+		writeHashFloat(h, real(c))
+		writeHashFloat(h, imag(c))
+	case reflect.String:
+		writeHashString(h, *(*string)(p))
+	default:
+		writeHashReflect(h, reflect.ValueOf(v).Elem())
+	}
+}
+
+// writeHashReflect is a helper function that writes the encoding of writeHashElement for
+// a reflected value. Structs and arrays are walked field by field and element by element,
+// so that every value that compares equal with == is encoded identically.
+func writeHashReflect(h *fnvHash, v reflect.Value) {
+	switch v.Kind() {
+	case reflect.Invalid:
+		h.writeByte('n')
+	case reflect.Bool:
+		writeHashBool(h, v.Bool())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		writeHashWord(h, 'i', uint64(v.Int()))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		writeHashWord(h, 'u', v.Uint())
+	case reflect.Float32, reflect.Float64:
+		writeHashFloat(h, v.Float())
+	case reflect.Complex64, reflect.Complex128:
+		c := v.Complex()
+		writeHashFloat(h, real(c))
+		writeHashFloat(h, imag(c))
+	case reflect.String:
+		writeHashString(h, v.String())
+	case reflect.Array:
+		h.writeByte('a')
+		for i := 0; i < v.Len(); i++ {
+			writeHashReflect(h, v.Index(i))
+		}
+	case reflect.Struct:
+		h.writeByte('t')
+		for i := 0; i < v.NumField(); i++ {
+			writeHashReflect(h, v.Field(i))
+		}
+	case reflect.Interface:
+		if v.IsNil() {
+			writeHashReflect(h, reflect.Value{})
+			return
+		}
+		writeHashReflect(h, v.Elem())
+	default:
+		// Pointers and channels are equal only if they have the same address, which
+		// changes between runs, so only their nil-ness is hashed
+		h.writeByte('p')
+		if v.IsNil() {
+			h.writeByte(0)
+		} else {
+			h.writeByte(1)
+		}
+	}
+}
+
+// writeHashBool is a helper function that writes a tagged boolean into the hash.
+func writeHashBool(h *fnvHash, b bool) {
+	h.writeByte('b')
+	if b {
+		h.writeByte(1)
+	} else {
+		h.writeByte(0)
+	}
+}
+
+// writeHashWord is a helper function that writes a tagged 64-bit word into the hash.
+func writeHashWord(h *fnvHash, tag byte, x uint64) {
+	h.writeByte(tag)
+	h.writeWord(x)
+}
+
+// writeHashFloat is a helper function that writes a float into the hash, encoding
+// negative zero like zero because the two compare equal.
+func writeHashFloat(h *fnvHash, x float64) {
+	if x == 0 {
+		x = 0
+	}
+	writeHashWord(h, 'f', math.Float64bits(x))
+}
+
+// writeHashString is a helper function that writes a tagged, length-prefixed string
+// into the hash without copying it.
+func writeHashString(h *fnvHash, s string) {
+	h.writeByte('s')
+	h.writeWord(uint64(len(s)))
+	h.writeString(s)
+}
+
+// mixHash is a helper function that scrambles the bits of a hash (SplitMix64 finalizer)
+// so that combining element hashes by addition does not cancel out structure.
+func mixHash(x uint64) uint64 {
+	x ^= x >> 30
+	x *= 0xbf58476d1ce4e5b9
+	x ^= x >> 27
+	x *= 0x94d049bb133111eb
+	x ^= x >> 31
+	return x
+}
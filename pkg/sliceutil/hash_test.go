@@ -0,0 +1,152 @@
+package sliceutil
+
+import (
+	"math"
+	"testing"
+
+	"github.com/devrob-go/sliceutil/pkg/sliceutil/benchcmp"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestHashSlice tests the HashSlice function
+func TestHashSlice(t *testing.T) {
+	t.Run("Equal Slices Have Equal Hashes", func(t *testing.T) {
+		assert.Equal(t, HashSlice([]int{1, 2, 3}), HashSlice([]int{1, 2, 3}))
+		assert.Equal(t, HashSlice([]string{"a", "b"}), HashSlice([]string{"a", "b"}))
+	})
+
+	t.Run("Order Sensitive", func(t *testing.T) {
+		assert.NotEqual(t, HashSlice([]int{1, 2, 3}), HashSlice([]int{3, 2, 1}))
+	})
+
+	t.Run("String Boundaries Matter", func(t *testing.T) {
+		assert.NotEqual(t, HashSlice([]string{"ab", "c"}), HashSlice([]string{"a", "bc"}))
+	})
+
+	t.Run("Stable Across Calls", func(t *testing.T) {
+		// The value must never change between releases since callers may persist it
+		assert.Equal(t, uint64(0x310ea7b922fd150f), HashSlice([]int{1, 2, 3}))
+		assert.Equal(t, HashSlice[int](nil), HashSlice([]int{}))
+	})
+
+	t.Run("Negative Zero Hashes Like Zero", func(t *testing.T) {
+		assert.Equal(t, HashSlice([]float64{0}), HashSlice([]float64{math.Copysign(0, -1)}))
+		assert.Equal(t, HashSlice([]float32{0}), HashSlice([]float32{float32(math.Copysign(0, -1))}))
+	})
+
+	t.Run("Struct Elements", func(t *testing.T) {
+		type point struct{ X, Y int }
+		assert.Equal(t, HashSlice([]point{{1, 2}}), HashSlice([]point{{1, 2}}))
+		assert.NotEqual(t, HashSlice([]point{{1, 2}}), HashSlice([]point{{2, 1}}))
+	})
+
+	t.Run("Negative Zero In Structs And Arrays", func(t *testing.T) {
+		negZero := math.Copysign(0, -1)
+		assert.Equal(t, HashSlice([]struct{ X float64 }{{0}}), HashSlice([]struct{ X float64 }{{negZero}}))
+		assert.Equal(t, HashSlice([][2]float64{{0, 1}}), HashSlice([][2]float64{{negZero, 1}}))
+		assert.Equal(t, HashSlice([]any{0.0}), HashSlice([]any{negZero}))
+		assert.Equal(t, HashSlice([]complex128{0}), HashSlice([]complex128{complex(negZero, negZero)}))
+
+		a := []struct{ X float64 }{{negZero}}
+		b := []struct{ X float64 }{{0}}
+		assert.True(t, EqualByHash(a, HashSlice(a), b, HashSlice(b)))
+	})
+
+	t.Run("Pointers Do Not Depend On Addresses", func(t *testing.T) {
+		x, y := 1, 1
+		assert.Equal(t, HashSlice([]*int{&x}), HashSlice([]*int{&y}))
+		assert.NotEqual(t, HashSlice([]*int{&x}), HashSlice([]*int{nil}))
+
+		a := []*int{&x}
+		b := []*int{&y}
+		assert.False(t, EqualByHash(a, HashSlice(a), b, HashSlice(b)))
+	})
+}
+
+// TestHashSliceAllocations tests that hashing slices of basic types does not allocate
+func TestHashSliceAllocations(t *testing.T) {
+	ints := Range(0, 1000)
+	words := []string{"alpha", "beta", "gamma"}
+	floats := []float64{1.5, -2, 0}
+
+	assert.Zero(t, testing.AllocsPerRun(10, func() { HashSlice(ints) }))
+	assert.Zero(t, testing.AllocsPerRun(10, func() { HashSlice(words) }))
+	assert.Zero(t, testing.AllocsPerRun(10, func() { HashSliceUnordered(floats) }))
+}
+
+// TestHashSliceUnordered tests the HashSliceUnordered function
+func TestHashSliceUnordered(t *testing.T) {
+	t.Run("Order Insensitive", func(t *testing.T) {
+		assert.Equal(t, HashSliceUnordered([]int{1, 2, 3}), HashSliceUnordered([]int{3, 1, 2}))
+	})
+
+	t.Run("Multiplicity Sensitive", func(t *testing.T) {
+		assert.NotEqual(t, HashSliceUnordered([]int{1, 1, 2}), HashSliceUnordered([]int{1, 2, 2}))
+		assert.NotEqual(t, HashSliceUnordered([]int{1, 1}), HashSliceUnordered([]int{}))
+	})
+
+	t.Run("Different Content", func(t *testing.T) {
+		assert.NotEqual(t, HashSliceUnordered([]string{"a"}), HashSliceUnordered([]string{"b"}))
+	})
+}
+
+// TestEqualByHash tests the EqualByHash function
+func TestEqualByHash(t *testing.T) {
+	a := []int{1, 2, 3}
+	hashA := HashSlice(a)
+
+	t.Run("Equal Slices", func(t *testing.T) {
+		b := []int{1, 2, 3}
+		assert.True(t, EqualByHash(a, hashA, b, HashSlice(b)))
+	})
+
+	t.Run("Different Hashes Short Circuit", func(t *testing.T) {
+		// The elements are equal, but the caller claims different fingerprints
+		assert.False(t, EqualByHash(a, hashA, a, hashA+1))
+	})
+
+	t.Run("Collisions Are Verified", func(t *testing.T) {
+		// Pretend both slices collided on the same fingerprint
+		assert.False(t, EqualByHash(a, hashA, []int{3, 2, 1}, hashA))
+	})
+
+	t.Run("Nil and Empty", func(t *testing.T) {
+		// Nil and empty share a hash but CompareSlices tells them apart
+		assert.False(t, EqualByHash(nil, HashSlice[int](nil), []int{}, HashSlice([]int{})))
+		assert.True(t, EqualByHash[int](nil, HashSlice[int](nil), nil, HashSlice[int](nil)))
+	})
+
+	t.Run("Floats", func(t *testing.T) {
+		x := []float64{math.Copysign(0, -1)}
+		y := []float64{0}
+		assert.True(t, EqualByHash(x, HashSlice(x), y, HashSlice(y)))
+	})
+}
+
+// TestEqualByHashUnordered tests the EqualByHashUnordered function
+func TestEqualByHashUnordered(t *testing.T) {
+	a := []string{"a", "b", "b"}
+	hashA := HashSliceUnordered(a)
+
+	t.Run("Same Elements Different Order", func(t *testing.T) {
+		b := []string{"b", "a", "b"}
+		assert.True(t, EqualByHashUnordered(a, hashA, b, HashSliceUnordered(b)))
+	})
+
+	t.Run("Different Multiplicities", func(t *testing.T) {
+		b := []string{"a", "a", "b"}
+		assert.False(t, EqualByHashUnordered(a, hashA, b, HashSliceUnordered(b)))
+		assert.False(t, EqualByHashUnordered(a, hashA, b, hashA))
+	})
+}
+
+// BenchmarkHashSlice_SmallMediumLarge benchmarks hashing an int slice, which reads the
+// elements directly without reflection or allocations
+func BenchmarkHashSlice_SmallMediumLarge(b *testing.B) {
+	benchcmp.SmallMediumLarge(b, func(b *testing.B, n int) {
+		s := Range(0, n)
+		for b.Loop() {
+			HashSlice(s)
+		}
+	})
+}
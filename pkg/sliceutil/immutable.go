@@ -0,0 +1,273 @@
+package sliceutil
+
+import (
+	"fmt"
+	"iter"
+	"slices"
+)
+
+const (
+	// immutableBits is the number of index bits consumed per level of an ImmutableSlice trie
+	immutableBits = 5
+	// immutableWidth is the maximum number of children or values per trie node
+	immutableWidth = 1 << immutableBits
+	// immutableMask extracts the index of a child within a node
+	immutableMask = immutableWidth - 1
+)
+
+// ImmutableSlice is a persistent slice: Append, Set and Slice never modify the
+// receiver and instead return a new ImmutableSlice that shares all unchanged storage
+// with it. This makes it cheap to keep every version of a slice around, for example to
+// diff successive snapshots, because each version only costs the nodes on the path
+// to the changed elements.
+//
+// Elements are stored in a trie of 32-wide nodes, so Get, Set and Append take
+// O(log32 n) time, which is effectively constant. Slice is O(1) and returns a view
+// that keeps the whole underlying trie alive; convert it with ToSlice and
+// NewImmutableSlice to release the memory it no longer needs.
+//
+// The zero value is an empty ImmutableSlice ready to use. ImmutableSlice values are
+// safe for concurrent use, but the elements themselves are not copied deeply, so
+// pointer or slice elements must not be mutated after they are stored.
+type ImmutableSlice[T any] struct {
+	root   *immutableNode[T]
+	shift  uint
+	count  int
+	offset int
+	length int
+}
+
+// immutableNode is a node of an ImmutableSlice trie. Leaves hold values and
+// branches hold children; nodes are never modified once they are shared.
+type immutableNode[T any] struct {
+	children []*immutableNode[T]
+	values   []T
+}
+
+// NewImmutableSlice creates an ImmutableSlice holding a copy of the given slice.
+//
+// Time complexity: O(n) where n is the length of the slice
+// Space complexity: O(n)
+//
+// Example:
+//
+//	v1 := NewImmutableSlice([]int{1, 2, 3})
+//	v2 := v1.Append(4)
+//	v3, _ := v2.Set(0, 10)
+//	// v1 is [1 2 3], v2 is [1 2 3 4] and v3 is [10 2 3 4]
+func NewImmutableSlice[T any](s []T) ImmutableSlice[T] {
+	if len(s) == 0 {
+		return ImmutableSlice[T]{}
+	}
+
+	// Build the trie bottom-up from full leaves, then group nodes level by level
+	nodes := make([]*immutableNode[T], 0, (len(s)+immutableMask)/immutableWidth)
+	for chunk := range slices.Chunk(s, immutableWidth) {
+		nodes = append(nodes, &immutableNode[T]{values: slices.Clone(chunk)})
+	}
+
+	var shift uint
+	for len(nodes) > 1 {
+		parents := make([]*immutableNode[T], 0, (len(nodes)+immutableMask)/immutableWidth)
+		for chunk := range slices.Chunk(nodes, immutableWidth) {
+			parents = append(parents, &immutableNode[T]{children: slices.Clone(chunk)})
+		}
+		nodes = parents
+		shift += immutableBits
+	}
+
+	return ImmutableSlice[T]{root: nodes[0], shift: shift, count: len(s), length: len(s)}
+}
+
+// Len returns the number of elements in the slice.
+func (s ImmutableSlice[T]) Len() int {
+	return s.length
+}
+
+// Get returns the element at index i.
+// The function returns ErrOutOfRange if i is outside the slice.
+func (s ImmutableSlice[T]) Get(i int) (T, error) {
+	if i < 0 || i >= s.length {
+		var zero T
+		return zero, fmt.Errorf("%w: index %d with length %d", ErrOutOfRange, i, s.length)
+	}
+	i += s.offset
+	return s.leaf(i).values[i&immutableMask], nil
+}
+
+// Set returns a new ImmutableSlice with the element at index i replaced by v.
+// The receiver is left unchanged. The function returns ErrOutOfRange if i is outside
+// the slice.
+func (s ImmutableSlice[T]) Set(i int, v T) (ImmutableSlice[T], error) {
+	if i < 0 || i >= s.length {
+		return s, fmt.Errorf("%w: index %d with length %d", ErrOutOfRange, i, s.length)
+	}
+	s.root = setImmutableNode(s.root, s.shift, s.offset+i, v)
+	return s, nil
+}
+
+// Append returns a new ImmutableSlice with the given values added to the end.
+// The receiver is left unchanged.
+func (s ImmutableSlice[T]) Append(values ...T) ImmutableSlice[T] {
+	for _, v := range values {
+		i := s.offset + s.length
+		if i < s.count {
+			// The slot is only hidden by a previous call to Slice, so overwrite it
+			s.root = setImmutableNode(s.root, s.shift, i, v)
+		} else {
+			s.push(v)
+		}
+		s.length++
+	}
+	return s
+}
+
+// Slice returns the elements in [i, j) as a new ImmutableSlice sharing all storage
+// with the receiver. The function returns ErrOutOfRange unless 0 <= i <= j <= Len().
+func (s ImmutableSlice[T]) Slice(i, j int) (ImmutableSlice[T], error) {
+	if i < 0 || i > j || j > s.length {
+		return s, fmt.Errorf("%w: slice [%d:%d] with length %d", ErrOutOfRange, i, j, s.length)
+	}
+	s.offset += i
+	s.length = j - i
+	return s, nil
+}
+
+// ToSlice returns the elements as an ordinary slice. The result is never nil and is
+// independent of the ImmutableSlice.
+func (s ImmutableSlice[T]) ToSlice() []T {
+	result := make([]T, 0, s.length)
+	for chunk := range s.chunks() {
+		result = append(result, chunk...)
+	}
+	return result
+}
+
+// All returns a sequence over the elements of the slice, for use with the
+// sequence functions in this package.
+func (s ImmutableSlice[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for chunk := range s.chunks() {
+			for _, v := range chunk {
+				if !yield(v) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// CompareImmutableSlices checks if two ImmutableSlices are equal in values and order,
+// like CompareSlices. Storage shared between the two, such as between successive
+// versions of the same slice, is recognized and skipped without comparing elements,
+// so comparing two versions costs time proportional to the number of changed nodes.
+//
+// Time complexity: O(n) in the worst case, O(k log n) for versions sharing all but k leaves
+// Space complexity: O(1)
+//
+// Example:
+//
+//	v1 := NewImmutableSlice(largeSlice)
+//	v2, _ := v1.Set(42, 7)
+//	equal := CompareImmutableSlices(v1, v2)
+func CompareImmutableSlices[T comparable](a, b ImmutableSlice[T]) bool {
+	if a.length != b.length {
+		return false
+	}
+	if a.root == b.root && a.offset == b.offset {
+		return true
+	}
+
+	for i := 0; i < a.length; {
+		leafA, leafB := a.leaf(a.offset+i), b.leaf(b.offset+i)
+		posA, posB := (a.offset+i)&immutableMask, (b.offset+i)&immutableMask
+		n := min(len(leafA.values)-posA, len(leafB.values)-posB, a.length-i)
+
+		if leafA != leafB || posA != posB {
+			if !CompareSlices(leafA.values[posA:posA+n], leafB.values[posB:posB+n]) {
+				return false
+			}
+		}
+		i += n
+	}
+	return true
+}
+
+// leaf is a helper function that returns the leaf holding the element at trie index i.
+func (s ImmutableSlice[T]) leaf(i int) *immutableNode[T] {
+	n := s.root
+	for shift := s.shift; shift > 0; shift -= immutableBits {
+		n = n.children[(i>>shift)&immutableMask]
+	}
+	return n
+}
+
+// chunks is a helper function that yields the visible elements one leaf at a time,
+// without copying them.
+func (s ImmutableSlice[T]) chunks() iter.Seq[[]T] {
+	return func(yield func([]T) bool) {
+		for i := 0; i < s.length; {
+			leaf := s.leaf(s.offset + i)
+			pos := (s.offset + i) & immutableMask
+			n := min(len(leaf.values)-pos, s.length-i)
+			if !yield(leaf.values[pos : pos+n]) {
+				return
+			}
+			i += n
+		}
+	}
+}
+
+// push is a helper function that appends v after the last element of the trie,
+// growing the trie by one level when it is full.
+func (s *ImmutableSlice[T]) push(v T) {
+	if s.root == nil {
+		s.root = &immutableNode[T]{values: []T{v}}
+		s.count = 1
+		return
+	}
+
+	if s.count == 1<<(s.shift+immutableBits) {
+		s.root = &immutableNode[T]{children: []*immutableNode[T]{s.root}}
+		s.shift += immutableBits
+	}
+	s.root = pushImmutableNode(s.root, s.shift, s.count, v)
+	s.count++
+}
+
+// pushImmutableNode is a helper function that returns a copy of n with v stored at
+// trie index i, which must be one past the last element below n. Only the nodes on
+// the path to i are copied.
+func pushImmutableNode[T any](n *immutableNode[T], shift uint, i int, v T) *immutableNode[T] {
+	if shift == 0 {
+		values := make([]T, len(n.values)+1)
+		copy(values, n.values)
+		values[len(n.values)] = v
+		return &immutableNode[T]{values: values}
+	}
+
+	idx := (i >> shift) & immutableMask
+	children := make([]*immutableNode[T], max(len(n.children), idx+1))
+	copy(children, n.children)
+	child := children[idx]
+	if child == nil {
+		child = &immutableNode[T]{}
+	}
+	children[idx] = pushImmutableNode(child, shift-immutableBits, i, v)
+	return &immutableNode[T]{children: children}
+}
+
+// setImmutableNode is a helper function that returns a copy of n with the element at
+// trie index i replaced by v. Only the nodes on the path to i are copied.
+func setImmutableNode[T any](n *immutableNode[T], shift uint, i int, v T) *immutableNode[T] {
+	if shift == 0 {
+		values := slices.Clone(n.values)
+		values[i&immutableMask] = v
+		return &immutableNode[T]{values: values}
+	}
+
+	idx := (i >> shift) & immutableMask
+	children := slices.Clone(n.children)
+	children[idx] = setImmutableNode(children[idx], shift-immutableBits, i, v)
+	return &immutableNode[T]{children: children}
+}
@@ -0,0 +1,187 @@
+package sliceutil
+
+import (
+	"math/rand/v2"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestImmutableSlice tests the ImmutableSlice type
+func TestImmutableSlice(t *testing.T) {
+	t.Run("Conversion Round Trip", func(t *testing.T) {
+		for _, n := range []int{1, 31, 32, 33, 1024, 1025, 40000} {
+			s := Generate(n, func(i int) int { return i })
+			v := NewImmutableSlice(s)
+			assert.Equal(t, n, v.Len())
+			assert.Equal(t, s, v.ToSlice())
+		}
+	})
+
+	t.Run("Conversion Copies Input", func(t *testing.T) {
+		s := []int{1, 2, 3}
+		v := NewImmutableSlice(s)
+		s[0] = 100
+		assert.Equal(t, []int{1, 2, 3}, v.ToSlice())
+	})
+
+	t.Run("Append Does Not Modify Receiver", func(t *testing.T) {
+		v1 := NewImmutableSlice([]int{1, 2, 3})
+		v2 := v1.Append(4, 5)
+		assert.Equal(t, []int{1, 2, 3}, v1.ToSlice())
+		assert.Equal(t, []int{1, 2, 3, 4, 5}, v2.ToSlice())
+
+		// Branching from the same version keeps both branches intact
+		v3 := v1.Append(6)
+		assert.Equal(t, []int{1, 2, 3, 4, 5}, v2.ToSlice())
+		assert.Equal(t, []int{1, 2, 3, 6}, v3.ToSlice())
+	})
+
+	t.Run("Append Grows Across Levels", func(t *testing.T) {
+		var v ImmutableSlice[int]
+		for i := range 2000 {
+			v = v.Append(i)
+		}
+		assert.Equal(t, Range(0, 2000), v.ToSlice())
+	})
+
+	t.Run("Set Does Not Modify Receiver", func(t *testing.T) {
+		v1 := NewImmutableSlice(Range(0, 100))
+		v2, err := v1.Set(70, -1)
+		require.NoError(t, err)
+
+		got, _ := v1.Get(70)
+		assert.Equal(t, 70, got)
+		got, _ = v2.Get(70)
+		assert.Equal(t, -1, got)
+
+		// Untouched leaves are shared between the versions
+		assert.Same(t, v1.leaf(0), v2.leaf(0))
+		assert.NotSame(t, v1.leaf(70), v2.leaf(70))
+	})
+
+	t.Run("Slice Shares Storage", func(t *testing.T) {
+		v := NewImmutableSlice(Range(0, 100))
+		sub, err := v.Slice(30, 70)
+		require.NoError(t, err)
+		assert.Equal(t, Range(30, 70), sub.ToSlice())
+		assert.Same(t, v.root, sub.root)
+
+		got, _ := sub.Get(0)
+		assert.Equal(t, 30, got)
+
+		empty, err := v.Slice(50, 50)
+		require.NoError(t, err)
+		assert.Equal(t, []int{}, empty.ToSlice())
+	})
+
+	t.Run("Append To Slice Does Not Leak Into Original", func(t *testing.T) {
+		v := NewImmutableSlice([]int{1, 2, 3, 4})
+		sub, _ := v.Slice(0, 2)
+		sub = sub.Append(9)
+
+		assert.Equal(t, []int{1, 2, 9}, sub.ToSlice())
+		assert.Equal(t, []int{1, 2, 3, 4}, v.ToSlice())
+	})
+
+	t.Run("Out Of Range", func(t *testing.T) {
+		v := NewImmutableSlice([]int{1, 2, 3})
+
+		_, err := v.Get(3)
+		assert.ErrorIs(t, err, ErrOutOfRange)
+		_, err = v.Get(-1)
+		assert.ErrorIs(t, err, ErrOutOfRange)
+		_, err = v.Set(3, 0)
+		assert.ErrorIs(t, err, ErrOutOfRange)
+		_, err = v.Slice(2, 1)
+		assert.ErrorIs(t, err, ErrOutOfRange)
+		_, err = v.Slice(0, 4)
+		assert.ErrorIs(t, err, ErrOutOfRange)
+	})
+
+	t.Run("All", func(t *testing.T) {
+		v := NewImmutableSlice(Range(0, 100))
+		sub, _ := v.Slice(10, 50)
+		assert.Equal(t, Range(10, 50), Collect(sub.All()))
+
+		// Stops early
+		var first []int
+		for x := range v.All() {
+			if x == 3 {
+				break
+			}
+			first = append(first, x)
+		}
+		assert.Equal(t, []int{0, 1, 2}, first)
+	})
+
+	t.Run("Nil and Empty", func(t *testing.T) {
+		var zero ImmutableSlice[string]
+		assert.Equal(t, 0, zero.Len())
+		assert.Equal(t, []string{}, zero.ToSlice())
+		assert.Equal(t, []string{}, NewImmutableSlice[string](nil).ToSlice())
+		assert.Equal(t, []string{"a"}, zero.Append("a").ToSlice())
+	})
+
+	t.Run("Matches Ordinary Slice", func(t *testing.T) {
+		rng := rand.New(rand.NewPCG(1, 2))
+		var model []int
+		var v ImmutableSlice[int]
+
+		for step := range 5000 {
+			switch op := rng.IntN(10); {
+			case op < 6:
+				model = append(model, step)
+				v = v.Append(step)
+			case op < 9 && len(model) > 0:
+				i := rng.IntN(len(model))
+				model[i] = -step
+				v, _ = v.Set(i, -step)
+			case len(model) > 0:
+				i := rng.IntN(len(model))
+				j := i + rng.IntN(len(model)-i+1)
+				model = append([]int{}, model[i:j]...)
+				v, _ = v.Slice(i, j)
+			}
+		}
+		assert.Equal(t, model, v.ToSlice())
+	})
+}
+
+// TestCompareImmutableSlices tests the CompareImmutableSlices function
+func TestCompareImmutableSlices(t *testing.T) {
+	t.Run("Equal Content", func(t *testing.T) {
+		a := NewImmutableSlice(Range(0, 1000))
+		b := NewImmutableSlice(Range(0, 1000))
+		assert.True(t, CompareImmutableSlices(a, b))
+		assert.True(t, CompareImmutableSlices(a, a))
+	})
+
+	t.Run("Versions Of The Same Slice", func(t *testing.T) {
+		v1 := NewImmutableSlice(Range(0, 1000))
+		v2, _ := v1.Set(500, -1)
+		assert.False(t, CompareImmutableSlices(v1, v2))
+
+		v3, _ := v2.Set(500, 500)
+		assert.True(t, CompareImmutableSlices(v1, v3))
+		assert.False(t, CompareImmutableSlices(v1, v1.Append(1000)))
+	})
+
+	t.Run("Unaligned Views", func(t *testing.T) {
+		v := NewImmutableSlice(Repeat(7, 200))
+		a, _ := v.Slice(3, 150)
+		b, _ := v.Slice(40, 187)
+		assert.True(t, CompareImmutableSlices(a, b))
+
+		c, _ := NewImmutableSlice(Range(0, 200)).Slice(5, 152)
+		assert.False(t, CompareImmutableSlices(a, c))
+	})
+
+	t.Run("Nil and Empty", func(t *testing.T) {
+		var zero ImmutableSlice[int]
+		empty, _ := NewImmutableSlice([]int{1}).Slice(1, 1)
+		assert.True(t, CompareImmutableSlices(zero, empty))
+		assert.False(t, CompareImmutableSlices(zero, zero.Append(0)))
+	})
+}
@@ -0,0 +1,157 @@
+package sliceutil
+
+// InnerJoin joins two slices on matching keys and projects every matching pair
+// into a result value. Results follow the order of the left slice, and for each
+// left element the matching right elements are visited in their original order.
+//
+// Time complexity: O(n + m + r) where n and m are the slice lengths and r is the number of results
+// Space complexity: O(m + r) for the right-side index and results
+//
+// Example:
+//
+//	users := []User{{ID: 1, Name: "Alice"}}
+//	orders := []Order{{UserID: 1, Total: 10}, {UserID: 2, Total: 5}}
+//	rows := InnerJoin(users, orders,
+//		func(u User) int { return u.ID },
+//		func(o Order) int { return o.UserID },
+//		func(u User, o Order) string { return fmt.Sprintf("%s:%d", u.Name, o.Total) },
+//	) // returns []string{"Alice:10"}
+func InnerJoin[L, R any, K comparable, O any](left []L, right []R, leftKey func(L) K, rightKey func(R) K, project func(L, R) O) []O {
+	index := indexJoinSide(right, rightKey)
+	result := make([]O, 0)
+
+	for _, l := range left {
+		for _, i := range index[leftKey(l)] {
+			result = append(result, project(l, right[i]))
+		}
+	}
+
+	return result
+}
+
+// LeftJoin joins two slices on matching keys, keeping every element of the left slice.
+// Left elements without a match are projected once with a nil right value.
+// Results follow the order of the left slice.
+//
+// Example:
+//
+//	rows := LeftJoin(users, orders, userID, orderUserID, func(u User, o *Order) Row {
+//		if o == nil {
+//			return Row{Name: u.Name}
+//		}
+//		return Row{Name: u.Name, Total: o.Total}
+//	})
+func LeftJoin[L, R any, K comparable, O any](left []L, right []R, leftKey func(L) K, rightKey func(R) K, project func(L, *R) O) []O {
+	index := indexJoinSide(right, rightKey)
+	result := make([]O, 0, len(left))
+
+	for _, l := range left {
+		matches := index[leftKey(l)]
+		if len(matches) == 0 {
+			result = append(result, project(l, nil))
+			continue
+		}
+		for _, i := range matches {
+			result = append(result, project(l, &right[i]))
+		}
+	}
+
+	return result
+}
+
+// OuterJoin performs a full outer join of two slices on matching keys.
+// Matched pairs and unmatched left elements are produced in the order of the left
+// slice, followed by unmatched right elements in the order of the right slice.
+// The projection receives nil for the side that has no match.
+//
+// Example:
+//
+//	rows := OuterJoin(users, orders, userID, orderUserID, func(u *User, o *Order) Row {
+//		// either u or o may be nil, but never both
+//	})
+func OuterJoin[L, R any, K comparable, O any](left []L, right []R, leftKey func(L) K, rightKey func(R) K, project func(*L, *R) O) []O {
+	index := indexJoinSide(right, rightKey)
+	matched := make([]bool, len(right))
+	result := make([]O, 0, len(left)+len(right))
+
+	for li := range left {
+		l := &left[li]
+		matches := index[leftKey(*l)]
+		if len(matches) == 0 {
+			result = append(result, project(l, nil))
+			continue
+		}
+		for _, i := range matches {
+			matched[i] = true
+			result = append(result, project(l, &right[i]))
+		}
+	}
+
+	// Append right elements that never found a partner
+	for i := range right {
+		if !matched[i] {
+			result = append(result, project(nil, &right[i]))
+		}
+	}
+
+	return result
+}
+
+// JoinByKey joins two slices of possibly different types on matching keys and returns
+// the matched pairs together with the unmatched leftovers of each side. It combines an
+// inner join with the anti-joins of both sides, which is what reconciling two in-memory
+// data sets usually needs.
+//
+// Every combination of elements with equal keys is matched, in the order of slice A and,
+// for each element of A, in the order of slice B. onlyA and onlyB hold the elements whose
+// key does not occur on the other side, each preserving the order of its source slice.
+// Use AlignByKey instead to pair repeated keys one-to-one.
+//
+// Time complexity: O(n + m + r) where n and m are the slice lengths and r is the number of matches
+// Space complexity: O(m + r) for the index and results
+//
+// Example:
+//
+//	matched, onlyA, onlyB := JoinByKey(invoices, payments,
+//		func(i Invoice) string { return i.Number },
+//		func(p Payment) string { return p.InvoiceNumber },
+//	)
+//	// onlyA: unpaid invoices, onlyB: payments without an invoice
+func JoinByKey[A, B any, K comparable](a []A, b []B, keyA func(A) K, keyB func(B) K) (matched []Pair[A, B], onlyA []A, onlyB []B) {
+	matched = make([]Pair[A, B], 0)
+	onlyA = make([]A, 0)
+	onlyB = make([]B, 0)
+
+	index := indexJoinSide(b, keyB)
+	used := make([]bool, len(b))
+	for _, va := range a {
+		matches := index[keyA(va)]
+		if len(matches) == 0 {
+			onlyA = append(onlyA, va)
+			continue
+		}
+		for _, i := range matches {
+			used[i] = true
+			matched = append(matched, Pair[A, B]{First: va, Second: b[i]})
+		}
+	}
+
+	for i, vb := range b {
+		if !used[i] {
+			onlyB = append(onlyB, vb)
+		}
+	}
+
+	return matched, onlyA, onlyB
+}
+
+// indexJoinSide is a helper function that maps every key to the positions
+// of the elements carrying it, preserving their original order.
+func indexJoinSide[T any, K comparable](s []T, key func(T) K) map[K][]int {
+	index := make(map[K][]int, len(s))
+	for i, v := range s {
+		k := key(v)
+		index[k] = append(index[k], i)
+	}
+	return index
+}
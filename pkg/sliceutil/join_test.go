@@ -0,0 +1,124 @@
+package sliceutil
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type joinUser struct {
+	ID   int
+	Name string
+}
+
+type joinOrder struct {
+	UserID int
+	Total  int
+}
+
+var (
+	joinUsers  = []joinUser{{1, "Alice"}, {2, "Bob"}, {3, "Carol"}}
+	joinOrders = []joinOrder{{1, 10}, {4, 99}, {1, 20}, {2, 5}}
+	userID     = func(u joinUser) int { return u.ID }
+	orderUser  = func(o joinOrder) int { return o.UserID }
+)
+
+// TestInnerJoin tests the InnerJoin function
+func TestInnerJoin(t *testing.T) {
+	t.Run("Joins Matching Keys", func(t *testing.T) {
+		result := InnerJoin(joinUsers, joinOrders, userID, orderUser, func(u joinUser, o joinOrder) string {
+			return fmt.Sprintf("%s:%d", u.Name, o.Total)
+		})
+
+		assert.Equal(t, []string{"Alice:10", "Alice:20", "Bob:5"}, result)
+	})
+
+	t.Run("Nil Slices", func(t *testing.T) {
+		result := InnerJoin(nil, joinOrders, userID, orderUser, func(u joinUser, o joinOrder) int { return o.Total })
+		assert.Empty(t, result)
+	})
+}
+
+// TestLeftJoin tests the LeftJoin function
+func TestLeftJoin(t *testing.T) {
+	t.Run("Keeps Unmatched Left Elements", func(t *testing.T) {
+		result := LeftJoin(joinUsers, joinOrders, userID, orderUser, func(u joinUser, o *joinOrder) string {
+			if o == nil {
+				return u.Name + ":-"
+			}
+			return fmt.Sprintf("%s:%d", u.Name, o.Total)
+		})
+
+		assert.Equal(t, []string{"Alice:10", "Alice:20", "Bob:5", "Carol:-"}, result)
+	})
+
+	t.Run("Empty Right Slice", func(t *testing.T) {
+		result := LeftJoin(joinUsers, nil, userID, orderUser, func(u joinUser, o *joinOrder) bool { return o == nil })
+		assert.Equal(t, []bool{true, true, true}, result)
+	})
+}
+
+// TestOuterJoin tests the OuterJoin function
+func TestOuterJoin(t *testing.T) {
+	t.Run("Keeps Unmatched Elements From Both Sides", func(t *testing.T) {
+		result := OuterJoin(joinUsers, joinOrders, userID, orderUser, func(u *joinUser, o *joinOrder) string {
+			switch {
+			case u == nil:
+				return fmt.Sprintf("-:%d", o.Total)
+			case o == nil:
+				return u.Name + ":-"
+			default:
+				return fmt.Sprintf("%s:%d", u.Name, o.Total)
+			}
+		})
+
+		assert.Equal(t, []string{"Alice:10", "Alice:20", "Bob:5", "Carol:-", "-:99"}, result)
+	})
+
+	t.Run("Nil Slices", func(t *testing.T) {
+		result := OuterJoin[joinUser, joinOrder](nil, nil, userID, orderUser, func(u *joinUser, o *joinOrder) int { return 0 })
+		assert.Empty(t, result)
+	})
+}
+
+// TestJoinByKey tests the JoinByKey function
+func TestJoinByKey(t *testing.T) {
+	t.Run("Matches And Leftovers", func(t *testing.T) {
+		matched, onlyA, onlyB := JoinByKey(joinUsers, joinOrders, userID, orderUser)
+
+		expected := []Pair[joinUser, joinOrder]{
+			{First: joinUser{1, "Alice"}, Second: joinOrder{1, 10}},
+			{First: joinUser{1, "Alice"}, Second: joinOrder{1, 20}},
+			{First: joinUser{2, "Bob"}, Second: joinOrder{2, 5}},
+		}
+		assert.Equal(t, expected, matched)
+		assert.Equal(t, []joinUser{{3, "Carol"}}, onlyA)
+		assert.Equal(t, []joinOrder{{4, 99}}, onlyB)
+	})
+
+	t.Run("Repeated Keys On Both Sides", func(t *testing.T) {
+		a := []string{"x1", "x2"}
+		b := []string{"x3", "x4"}
+		first := func(s string) byte { return s[0] }
+
+		matched, onlyA, onlyB := JoinByKey(a, b, first, first)
+		assert.Len(t, matched, 4)
+		assert.Equal(t, Pair[string, string]{First: "x1", Second: "x3"}, matched[0])
+		assert.Equal(t, Pair[string, string]{First: "x2", Second: "x4"}, matched[3])
+		assert.Empty(t, onlyA)
+		assert.Empty(t, onlyB)
+	})
+
+	t.Run("Nil Slices", func(t *testing.T) {
+		matched, onlyA, onlyB := JoinByKey(nil, joinOrders, userID, orderUser)
+		assert.Empty(t, matched)
+		assert.Empty(t, onlyA)
+		assert.Equal(t, joinOrders, onlyB)
+
+		matched, onlyA, onlyB = JoinByKey[joinUser, joinOrder](joinUsers, nil, userID, orderUser)
+		assert.Empty(t, matched)
+		assert.Equal(t, joinUsers, onlyA)
+		assert.NotNil(t, onlyB)
+	})
+}
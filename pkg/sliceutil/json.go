@@ -0,0 +1,122 @@
+package sliceutil
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+)
+
+// DecodeJSONArray decodes a JSON array from r into a slice, one element at a time, so
+// that large arrays are never held in memory both as raw JSON and as decoded values.
+// Every element is passed to validate, if it is not nil, as soon as it is decoded; the
+// first validation error aborts decoding. A JSON null decodes to a nil slice.
+//
+// The function returns ErrNotJSONArray if the input is not a single JSON array, and
+// errors naming the index of the offending element if an element cannot be decoded
+// or fails validation.
+//
+// Time complexity: O(n) where n is the size of the input
+// Space complexity: O(m) where m is the number of elements
+//
+// Example:
+//
+//	orders, err := DecodeJSONArray(file, func(o Order) error {
+//		if o.Total < 0 {
+//			return errors.New("negative total")
+//		}
+//		return nil
+//	})
+func DecodeJSONArray[T any](r io.Reader, validate func(T) error) ([]T, error) {
+	result := make([]T, 0)
+	var validationErr error
+	isNull, err := streamJSONArray(r, func(i int, v T) bool {
+		if validate != nil {
+			if validationErr = validate(v); validationErr != nil {
+				validationErr = fmt.Errorf("element %d: %w", i, validationErr)
+				return false
+			}
+		}
+		result = append(result, v)
+		return true
+	})
+	if validationErr != nil {
+		return nil, validationErr
+	}
+	if err != nil || isNull {
+		return nil, err
+	}
+	return result, nil
+}
+
+// JSONArraySeq returns a sequence over the elements of a JSON array read from r,
+// decoding each element only when it is requested. This allows arrays that do not fit
+// in memory to be processed with the sequence functions in this package or collected
+// into batches. A JSON null yields no elements. Decoding errors, including the input
+// not being a JSON array, are yielded with the zero value of T and end the sequence;
+// stopping early leaves the rest of the input unread.
+//
+// Example:
+//
+//	for event, err := range JSONArraySeq[Event](file) {
+//		if err != nil {
+//			return err
+//		}
+//		handle(event)
+//	}
+func JSONArraySeq[T any](r io.Reader) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		stopped := false
+		_, err := streamJSONArray(r, func(_ int, v T) bool {
+			stopped = !yield(v, nil)
+			return !stopped
+		})
+		if err != nil && !stopped {
+			var zero T
+			yield(zero, err)
+		}
+	}
+}
+
+// streamJSONArray is a helper function that decodes the elements of a JSON array read
+// from r one at a time and passes them to each, stopping without error when each
+// returns false. It reports whether the input was a JSON null instead of an array.
+func streamJSONArray[T any](r io.Reader, each func(i int, v T) bool) (isNull bool, err error) {
+	dec := json.NewDecoder(r)
+
+	tok, err := dec.Token()
+	if errors.Is(err, io.EOF) {
+		return false, fmt.Errorf("%w: empty input", ErrNotJSONArray)
+	}
+	if err != nil {
+		return false, err
+	}
+
+	if tok != nil {
+		if delim, ok := tok.(json.Delim); !ok || delim != '[' {
+			return false, fmt.Errorf("%w: starts with %v", ErrNotJSONArray, tok)
+		}
+
+		for i := 0; dec.More(); i++ {
+			var v T
+			if err := dec.Decode(&v); err != nil {
+				return false, fmt.Errorf("element %d: %w", i, err)
+			}
+			if !each(i, v) {
+				return false, nil
+			}
+		}
+
+		// Consume the closing bracket
+		if _, err := dec.Token(); err != nil {
+			return false, err
+		}
+	}
+
+	// Only whitespace may follow the array
+	if _, err := dec.Token(); !errors.Is(err, io.EOF) {
+		return false, fmt.Errorf("%w: unexpected data after the array", ErrNotJSONArray)
+	}
+	return tok == nil, nil
+}
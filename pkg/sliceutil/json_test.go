@@ -0,0 +1,135 @@
+package sliceutil
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestDecodeJSONArray tests the DecodeJSONArray function
+func TestDecodeJSONArray(t *testing.T) {
+	type Order struct {
+		ID    int     `json:"id"`
+		Total float64 `json:"total"`
+	}
+	errNegative := errors.New("negative total")
+	validate := func(o Order) error {
+		if o.Total < 0 {
+			return errNegative
+		}
+		return nil
+	}
+
+	t.Run("Decodes Elements", func(t *testing.T) {
+		orders, err := DecodeJSONArray(strings.NewReader(` [{"id": 1, "total": 9.5}, {"id": 2, "total": 3}] `), validate)
+		require.NoError(t, err)
+		assert.Equal(t, []Order{{1, 9.5}, {2, 3}}, orders)
+
+		ints, err := DecodeJSONArray[int](strings.NewReader("[1, 2, 3]"), nil)
+		require.NoError(t, err)
+		assert.Equal(t, []int{1, 2, 3}, ints)
+	})
+
+	t.Run("Validation Error", func(t *testing.T) {
+		_, err := DecodeJSONArray(strings.NewReader(`[{"id": 1, "total": 1}, {"id": 2, "total": -1}]`), validate)
+		assert.ErrorIs(t, err, errNegative)
+		assert.Contains(t, err.Error(), "element 1")
+	})
+
+	t.Run("Decode Error", func(t *testing.T) {
+		_, err := DecodeJSONArray[int](strings.NewReader(`[1, "two"]`), nil)
+		var typeErr *json.UnmarshalTypeError
+		assert.ErrorAs(t, err, &typeErr)
+		assert.Contains(t, err.Error(), "element 1")
+
+		_, err = DecodeJSONArray[int](strings.NewReader(`[1, 2`), nil)
+		assert.Error(t, err)
+	})
+
+	t.Run("Not An Array", func(t *testing.T) {
+		for _, input := range []string{`{"id": 1}`, `42`, ``, `[1] [2]`} {
+			_, err := DecodeJSONArray[int](strings.NewReader(input), nil)
+			assert.ErrorIs(t, err, ErrNotJSONArray, input)
+		}
+	})
+
+	t.Run("Large Input Is Streamed", func(t *testing.T) {
+		// Generate the input lazily so it never exists as a whole
+		const n = 100000
+		r, w := io.Pipe()
+		go func() {
+			fmt.Fprint(w, "[")
+			for i := range n {
+				if i > 0 {
+					fmt.Fprint(w, ",")
+				}
+				fmt.Fprint(w, i)
+			}
+			fmt.Fprint(w, "]")
+			w.Close()
+		}()
+
+		ints, err := DecodeJSONArray[int](r, nil)
+		require.NoError(t, err)
+		assert.Equal(t, Range(0, n), ints)
+	})
+
+	t.Run("Nil and Empty", func(t *testing.T) {
+		ints, err := DecodeJSONArray[int](strings.NewReader("null"), nil)
+		require.NoError(t, err)
+		assert.Nil(t, ints)
+
+		ints, err = DecodeJSONArray[int](strings.NewReader("[]"), nil)
+		require.NoError(t, err)
+		assert.Equal(t, []int{}, ints)
+	})
+}
+
+// TestJSONArraySeq tests the JSONArraySeq function
+func TestJSONArraySeq(t *testing.T) {
+	t.Run("Yields Elements", func(t *testing.T) {
+		var got []string
+		for v, err := range JSONArraySeq[string](strings.NewReader(`["a", "b"]`)) {
+			require.NoError(t, err)
+			got = append(got, v)
+		}
+		assert.Equal(t, []string{"a", "b"}, got)
+	})
+
+	t.Run("Stops Early", func(t *testing.T) {
+		// The malformed tail is never read
+		var got []int
+		for v, err := range JSONArraySeq[int](strings.NewReader(`[1, 2, 3, oops`)) {
+			require.NoError(t, err)
+			if v == 3 {
+				break
+			}
+			got = append(got, v)
+		}
+		assert.Equal(t, []int{1, 2}, got)
+	})
+
+	t.Run("Error Ends Sequence", func(t *testing.T) {
+		var errs []error
+		for _, err := range JSONArraySeq[int](strings.NewReader(`[1, true, 3]`)) {
+			if err != nil {
+				errs = append(errs, err)
+			}
+		}
+		assert.Len(t, errs, 1)
+	})
+
+	t.Run("Nil and Empty", func(t *testing.T) {
+		count := 0
+		for range JSONArraySeq[int](strings.NewReader("null")) {
+			count++
+		}
+		assert.Equal(t, 0, count)
+	})
+}
@@ -1,11 +1,17 @@
 package sliceutil
 
 import (
+	"cmp"
+	"container/heap"
+	"fmt"
+	"reflect"
+	"slices"
 	"sort"
+	"strings"
 )
 
 // MergeSlices merges two slices of the same type and sorts them based on the specified order.
-// The function supports int and string slices with ascending or descending sorting.
+// The function supports int, string and float64 slices with ascending or descending sorting.
 //
 // The function performs the following operations:
 // 1. Validates input parameters
@@ -21,12 +27,10 @@ import (
 //	a := []int{5, 1, 3}
 //	b := []int{4, 2, 6}
 //	result := MergeSlices(a, b, OrderAsc) // returns []int{1, 2, 3, 4, 5, 6}
+//
+// Deprecated: MergeSlices loses type safety and requires a type assertion on the result.
+// Use MergeSlicesTyped instead.
 func MergeSlices(a, b interface{}, order OrderType) (interface{}, error) {
-	// Validate order parameter
-	if order != OrderAsc && order != OrderDesc {
-		return nil, ErrUnsupportedType
-	}
-
 	// Determine the type of the slices and merge accordingly
 	switch a := a.(type) {
 	case []int:
@@ -34,30 +38,64 @@ func MergeSlices(a, b interface{}, order OrderType) (interface{}, error) {
 		if !ok {
 			return nil, ErrTypeMismatch
 		}
-		return mergeIntSlices(a, bSlice, order), nil
+		return MergeSlicesTyped(a, bSlice, order)
 	case []string:
 		bSlice, ok := b.([]string)
 		if !ok {
 			return nil, ErrTypeMismatch
 		}
-		return mergeStringSlices(a, bSlice, order), nil
+		return MergeSlicesTyped(a, bSlice, order)
 	case []float64:
 		bSlice, ok := b.([]float64)
 		if !ok {
 			return nil, ErrTypeMismatch
 		}
-		return mergeFloat64Slices(a, bSlice, order), nil
+		return MergeSlicesTyped(a, bSlice, order)
 	default:
 		return nil, ErrUnsupportedType
 	}
 }
 
+// MergeSlicesTyped merges two slices of any ordered type and sorts the result in the
+// specified order. It is the type-safe replacement for MergeSlices: the result needs no
+// type assertion and an invalid order is reported as ErrUnsupportedType.
+// With OrderNone the result is simply a followed by b.
+// The input slices are not modified.
+//
+// Time complexity: O((n + m) * log(n + m)) where n and m are the lengths of the slices
+// Space complexity: O(n + m) for the result slice
+//
+// Example:
+//
+//	result, err := MergeSlicesTyped([]int{5, 1, 3}, []int{4, 2, 6}, OrderDesc)
+//	// returns []int{6, 5, 4, 3, 2, 1}, nil
+func MergeSlicesTyped[T cmp.Ordered](a, b []T, order OrderType) ([]T, error) {
+	// Validate order parameter
+	if order != OrderAsc && order != OrderDesc && order != OrderNone {
+		return nil, ErrUnsupportedType
+	}
+	if a == nil && b == nil {
+		return nil, nil
+	}
+
+	merged := make([]T, 0, len(a)+len(b))
+	merged = append(merged, a...)
+	merged = append(merged, b...)
+	Sort(merged, order)
+
+	return merged, nil
+}
+
 // MergeSlicesGeneric is a generic version of MergeSlices that provides type safety
-// for comparable types that can be sorted.
+// for comparable types that can be sorted. With OrderNone, less is never called and
+// the result is the concatenation of a and b.
 //
 // This function requires the type parameter T to implement the sort.Interface,
 // which means it must have a Less method for comparison.
 func MergeSlicesGeneric[T any](a, b []T, order OrderType, less func(T, T) bool) []T {
+	if order == OrderNone {
+		return Concat(a, b)
+	}
 	if a == nil && b == nil {
 		return nil
 	}
@@ -102,7 +140,11 @@ func MergeSlicesGeneric[T any](a, b []T, order OrderType, less func(T, T) bool)
 
 // MergeSlicesInt merges two int slices with the specified sorting order.
 // This function is a type-safe alternative to MergeSlices for int slices.
+// OrderNone returns the concatenation of a and b without sorting.
 func MergeSlicesInt(a, b []int, order OrderType) []int {
+	if order == OrderNone {
+		return Concat(a, b)
+	}
 	if a == nil && b == nil {
 		return nil
 	}
@@ -142,7 +184,12 @@ func MergeSlicesInt(a, b []int, order OrderType) []int {
 
 // MergeSlicesString merges two string slices with the specified sorting order.
 // This function is a type-safe alternative to MergeSlices for string slices.
+// Strings are sorted by byte order; use MergeSlicesStringCollate for another collation.
+// Like MergeSlicesInt, it concatenates without sorting for OrderNone.
 func MergeSlicesString(a, b []string, order OrderType) []string {
+	if order == OrderNone {
+		return Concat(a, b)
+	}
 	if a == nil && b == nil {
 		return nil
 	}
@@ -180,9 +227,46 @@ func MergeSlicesString(a, b []string, order OrderType) []string {
 	return merged
 }
 
+// MergeSlicesStringCollate merges two string slices and sorts the result using a
+// collation function, which returns a negative number, zero, or a positive number when x
+// sorts before, equal to, or after y. This allows case-insensitive or locale-aware
+// ordering; a collator from golang.org/x/text/collate can be passed as its CompareString
+// method. A nil collate function sorts by byte order like MergeSlicesString.
+// The sort is stable, so strings that collate equal keep their concatenation order, and
+// OrderNone skips sorting altogether.
+//
+// Example:
+//
+//	result := MergeSlicesStringCollate([]string{"b", "C"}, []string{"a"}, OrderAsc, CompareFold)
+//	// returns []string{"a", "b", "C"}
+//
+//	german := collate.New(language.German)
+//	result = MergeSlicesStringCollate(a, b, OrderAsc, german.CompareString)
+func MergeSlicesStringCollate(a, b []string, order OrderType, collate func(x, y string) int) []string {
+	merged := Concat(a, b)
+	if order == OrderNone {
+		return merged
+	}
+	if collate == nil {
+		collate = strings.Compare
+	}
+
+	SortBy(merged, func(x, y string) bool {
+		if order == OrderDesc {
+			return collate(y, x) < 0
+		}
+		return collate(x, y) < 0
+	})
+	return merged
+}
+
 // MergeSlicesFloat64 merges two float64 slices with the specified sorting order.
 // This function is a type-safe alternative to MergeSlices for float64 slices.
+// Like MergeSlicesInt, it concatenates without sorting for OrderNone.
 func MergeSlicesFloat64(a, b []float64, order OrderType) []float64 {
+	if order == OrderNone {
+		return Concat(a, b)
+	}
 	if a == nil && b == nil {
 		return nil
 	}
@@ -222,10 +306,14 @@ func MergeSlicesFloat64(a, b []float64, order OrderType) []float64 {
 
 // MergeMultipleSlices merges multiple slices of the same type and sorts them.
 // This function is useful when you need to merge more than two slices.
+// With OrderNone the slices are only concatenated, in the order they are passed.
 func MergeMultipleSlices[T any](slices [][]T, order OrderType, less func(T, T) bool) []T {
 	if len(slices) == 0 {
 		return nil
 	}
+	if order == OrderNone {
+		return Concat(slices...)
+	}
 	if len(slices) == 1 {
 		result := make([]T, len(slices[0]))
 		copy(result, slices[0])
@@ -266,8 +354,268 @@ func MergeMultipleSlices[T any](slices [][]T, order OrderType, less func(T, T) b
 	return merged
 }
 
+// MergeWithPriority merges multiple slices and orders the result by a computed score
+// instead of a less function, which suits ranking feeds combined from several sources.
+// The weight function is called exactly once per element, so it may be expensive.
+// Elements with equal weights keep their input order, and OrderNone keeps the
+// concatenation order. NaN weights are treated as lower than any other weight.
+// If no slices are given, or all of them are nil, the result is nil.
+//
+// Time complexity: O(n * log n) where n is the total number of elements
+// Space complexity: O(n) for the result and the computed weights
+//
+// Example:
+//
+//	feed := MergeWithPriority([][]Post{news, friends}, func(p Post) float64 {
+//		return p.Likes / time.Since(p.At).Hours()
+//	}, OrderDesc)
+func MergeWithPriority[T any](slices [][]T, weight func(T) float64, order OrderType) []T {
+	merged := Concat(slices...)
+	if merged == nil || order == OrderNone {
+		return merged
+	}
+
+	type scored struct {
+		value  T
+		weight float64
+	}
+	items := make([]scored, len(merged))
+	for i, v := range merged {
+		items[i] = scored{value: v, weight: weight(v)}
+	}
+
+	sort.SliceStable(items, func(i, j int) bool {
+		if order == OrderDesc {
+			return cmp.Less(items[j].weight, items[i].weight)
+		}
+		return cmp.Less(items[i].weight, items[j].weight)
+	})
+
+	for i, item := range items {
+		merged[i] = item.value
+	}
+	return merged
+}
+
+// Concat joins slices end to end into a new slice, preserving the order of the inputs
+// and of the elements within them. Unlike MergeMultipleSlices, the result is not sorted.
+// The total capacity is computed up front so the result is allocated once.
+// If no slices are given, or all of them are nil, the result is nil.
+//
+// Time complexity: O(n) where n is the total number of elements
+// Space complexity: O(n) for the result slice
+//
+// Example:
+//
+//	result := Concat([]int{3, 1}, []int{2}, []int{5, 4})
+//	// returns []int{3, 1, 2, 5, 4}
+func Concat[T any](slices ...[]T) []T {
+	total := 0
+	allNil := true
+	for _, slice := range slices {
+		total += len(slice)
+		if slice != nil {
+			allNil = false
+		}
+	}
+	if allNil {
+		return nil
+	}
+
+	result := make([]T, 0, total)
+	for _, slice := range slices {
+		result = append(result, slice...)
+	}
+
+	return result
+}
+
+// Interleave merges slices by taking one element from each in turn, skipping slices
+// that have run out, until all elements are used. Unlike MergeMultipleSlices, nothing
+// is sorted: the order of the elements within each input is preserved, which makes it
+// suitable for fair scheduling across queues that are each already in priority order.
+// If no slices are given, or all of them are nil, the result is nil.
+//
+// Time complexity: O(n + k * r) where n is the total number of elements, k the number of
+// slices and r the length of the longest slice
+// Space complexity: O(n) for the result slice
+//
+// Example:
+//
+//	result := Interleave([]int{1, 2, 3}, []int{10}, []int{20, 21})
+//	// returns []int{1, 10, 20, 2, 21, 3}
+func Interleave[T any](slices ...[]T) []T {
+	result, _ := RoundRobin(slices, Repeat(1, len(slices)))
+	return result
+}
+
+// RoundRobin merges slices in rounds, taking up to quotas[i] elements from slices[i]
+// in each round. This gives weighted fair scheduling: while both have elements left,
+// a source with quota 3 contributes three elements for every one of a source with
+// quota 1. Slices that have run out are skipped, and the order of the elements within
+// each input is preserved. If no slices are given, or all of them are nil, the result
+// is nil.
+// The function returns an error wrapping ErrLengthMismatch if there is not exactly one
+// quota per slice, and an error wrapping ErrOutOfRange if a quota is less than 1.
+//
+// Example:
+//
+//	result, err := RoundRobin([][]string{{"a1", "a2", "a3"}, {"b1", "b2"}}, []int{2, 1})
+//	// returns []string{"a1", "a2", "b1", "a3", "b2"}, nil
+func RoundRobin[T any](slices [][]T, quotas []int) ([]T, error) {
+	if len(quotas) != len(slices) {
+		return nil, fmt.Errorf("%w: %d slices and %d quotas", ErrLengthMismatch, len(slices), len(quotas))
+	}
+	for i, q := range quotas {
+		if q < 1 {
+			return nil, fmt.Errorf("%w: quota %d for slice %d must be positive", ErrOutOfRange, q, i)
+		}
+	}
+
+	total := 0
+	allNil := true
+	for _, slice := range slices {
+		total += len(slice)
+		if slice != nil {
+			allNil = false
+		}
+	}
+	if allNil {
+		return nil, nil
+	}
+
+	result := make([]T, 0, total)
+	positions := make([]int, len(slices))
+	for len(result) < total {
+		for i, slice := range slices {
+			end := positions[i] + min(quotas[i], len(slice)-positions[i])
+			result = append(result, slice[positions[i]:end]...)
+			positions[i] = end
+		}
+	}
+
+	return result, nil
+}
+
+// Flatten joins a slice of slices into a single slice, like Concat.
+//
+// Example:
+//
+//	result := Flatten([][]string{{"a", "b"}, {}, {"c"}})
+//	// returns []string{"a", "b", "c"}
+func Flatten[T any](s [][]T) []T {
+	return Concat(s...)
+}
+
+// MergeSortedSlices merges slices that are already sorted in the given order into a single
+// sorted slice without re-sorting. A heap holds the head of every input, so only the
+// smallest remaining head (or largest, for OrderDesc) is compared at each step.
+// Equal elements keep the order of the input slices they came from.
+// The inputs are assumed to be sorted; use MergeSortedSlicesChecked to verify them.
+// OrderNone means the inputs follow no order, so they are concatenated as they are.
+//
+// Time complexity: O(n * log k) where n is the total number of elements and k the number of slices
+// Space complexity: O(n + k) for the result slice and the heap
+//
+// Example:
+//
+//	slices := [][]int{{1, 4, 7}, {2, 5}, {3, 6}}
+//	result := MergeSortedSlices(slices, OrderAsc, func(a, b int) bool { return a < b })
+//	// returns []int{1, 2, 3, 4, 5, 6, 7}
+func MergeSortedSlices[T any](slices [][]T, order OrderType, less func(T, T) bool) []T {
+	if len(slices) == 0 {
+		return nil
+	}
+	if order == OrderNone {
+		return Concat(slices...)
+	}
+
+	before := less
+	if order == OrderDesc {
+		before = func(a, b T) bool { return less(b, a) }
+	}
+
+	totalCap := 0
+	for _, slice := range slices {
+		totalCap += len(slice)
+	}
+
+	h := &boundedHeap[mergeCursor]{
+		items: make([]mergeCursor, 0, len(slices)),
+		less: func(x, y mergeCursor) bool {
+			a, b := slices[x.slice][x.pos], slices[y.slice][y.pos]
+			if before(a, b) {
+				return true
+			}
+			if before(b, a) {
+				return false
+			}
+			return x.slice < y.slice
+		},
+	}
+	for i, slice := range slices {
+		if len(slice) > 0 {
+			h.items = append(h.items, mergeCursor{slice: i})
+		}
+	}
+	heap.Init(h)
+
+	merged := make([]T, 0, totalCap)
+	for h.Len() > 0 {
+		head := &h.items[0]
+		merged = append(merged, slices[head.slice][head.pos])
+		head.pos++
+		if head.pos < len(slices[head.slice]) {
+			heap.Fix(h, 0)
+		} else {
+			heap.Pop(h)
+		}
+	}
+
+	return merged
+}
+
+// MergeSortedSlicesChecked works like MergeSortedSlices, but first verifies that every input
+// slice is sorted in the given order. It returns ErrNotSorted identifying the first offending
+// slice, or ErrUnsupportedType if the order is not OrderAsc, OrderDesc or OrderNone.
+// Every input satisfies OrderNone, so nothing is verified and the inputs are concatenated.
+//
+// Example:
+//
+//	_, err := MergeSortedSlicesChecked([][]int{{1, 2}, {5, 3}}, OrderAsc, less)
+//	// errors.Is(err, ErrNotSorted) == true
+func MergeSortedSlicesChecked[T any](slices [][]T, order OrderType, less func(T, T) bool) ([]T, error) {
+	if order != OrderAsc && order != OrderDesc && order != OrderNone {
+		return nil, ErrUnsupportedType
+	}
+	if order == OrderNone {
+		return MergeSortedSlices(slices, order, less), nil
+	}
+
+	for i, slice := range slices {
+		for j := 1; j < len(slice); j++ {
+			outOfOrder := less(slice[j], slice[j-1])
+			if order == OrderDesc {
+				outOfOrder = less(slice[j-1], slice[j])
+			}
+			if outOfOrder {
+				return nil, fmt.Errorf("%w: slice %d at index %d", ErrNotSorted, i, j)
+			}
+		}
+	}
+
+	return MergeSortedSlices(slices, order, less), nil
+}
+
+// mergeCursor tracks the next unread position of one input slice during a k-way merge.
+type mergeCursor struct {
+	slice int
+	pos   int
+}
+
 // MergeSlicesWithDeduplication merges two slices and removes duplicates.
 // This function is useful when you want to merge slices while ensuring uniqueness.
+// With OrderNone the first occurrence of every element is kept in concatenation order.
 func MergeSlicesWithDeduplication[T comparable](a, b []T, order OrderType, less func(T, T) bool) []T {
 	if a == nil && b == nil {
 		return nil
@@ -287,6 +635,9 @@ func MergeSlicesWithDeduplication[T comparable](a, b []T, order OrderType, less
 
 	// Remove duplicates
 	merged = RemoveDuplicates(merged)
+	if order == OrderNone {
+		return merged
+	}
 
 	// Sort merged slice
 	sort.Slice(merged, func(i, j int) bool {
@@ -299,21 +650,90 @@ func MergeSlicesWithDeduplication[T comparable](a, b []T, order OrderType, less
 	return merged
 }
 
-// Helper functions for specific types
+// MergeInto merges two slices into dst and sorts the merged elements in the specified
+// order, reusing the capacity of dst. Like append, the merged elements are added after
+// the existing elements of dst, which are left in place and not sorted, and a new backing
+// array is only allocated when dst is too small. High-throughput callers can therefore
+// pool buffers and pass buf[:0] to avoid allocating on every merge. The sort is stable,
+// and OrderNone keeps the elements of a followed by those of b.
+//
+// Aliasing: as with append, the result may share its backing array with dst, so the
+// caller must use the returned slice. a and b must not overlap the spare capacity of dst
+// (dst[len(dst):cap(dst)]), because it is overwritten while they are being read; passing
+// part of the same pooled buffer as dst and as an input is therefore not allowed.
+//
+// Time complexity: O((n + m) * log(n + m)) where n and m are the lengths of a and b
+// Space complexity: O(1) when dst has enough spare capacity, O(len(dst) + n + m) otherwise
+//
+// Example:
+//
+//	buf := pool.Get().([]int)
+//	merged := MergeInto(buf[:0], a, b, OrderAsc, func(x, y int) bool { return x < y })
+//	// ... use merged ...
+//	pool.Put(merged)
+func MergeInto[T any](dst, a, b []T, order OrderType, less func(T, T) bool) []T {
+	start := len(dst)
+	dst = slices.Grow(dst, len(a)+len(b))
+	dst = append(dst, a...)
+	dst = append(dst, b...)
+
+	if order != OrderNone {
+		tail := dst[start:]
+		SortBy(tail, func(x, y T) bool {
+			if order == OrderDesc {
+				return less(y, x)
+			}
+			return less(x, y)
+		})
+	}
+	return dst
+}
 
-// mergeIntSlices is a helper function that merges int slices
-func mergeIntSlices(a, b []int, order OrderType) []int {
-	return MergeSlicesInt(a, b, order)
+// MergeSlicesIntUnique merges two int slices, removes duplicates and sorts the result
+// in the specified order, without the less function MergeSlicesWithDeduplication needs.
+// It sorts first and then drops adjacent duplicates, which avoids the map allocation of
+// a hash-based deduplication on large inputs. OrderNone keeps the first occurrence of
+// every value in concatenation order instead. The input slices are not modified.
+//
+// Time complexity: O((n + m) * log(n + m)) where n and m are the lengths of the slices
+// Space complexity: O(n + m) for the result slice
+//
+// Example:
+//
+//	result := MergeSlicesIntUnique([]int{3, 1, 3}, []int{2, 1}, OrderAsc)
+//	// returns []int{1, 2, 3}
+func MergeSlicesIntUnique(a, b []int, order OrderType) []int {
+	return mergeUniqueOrdered(a, b, order)
 }
 
-// mergeStringSlices is a helper function that merges string slices
-func mergeStringSlices(a, b []string, order OrderType) []string {
-	return MergeSlicesString(a, b, order)
+// MergeSlicesFloat64Unique merges two float64 slices, removes duplicates and sorts the
+// result like MergeSlicesIntUnique. Values that compare equal with cmp.Compare are
+// duplicates, so all NaN values collapse into one, which sorts first, and -0 and +0
+// collapse into whichever sorts first.
+func MergeSlicesFloat64Unique(a, b []float64, order OrderType) []float64 {
+	return mergeUniqueOrdered(a, b, order)
 }
 
-// mergeFloat64Slices is a helper function that merges float64 slices
-func mergeFloat64Slices(a, b []float64, order OrderType) []float64 {
-	return MergeSlicesFloat64(a, b, order)
+// MergeSlicesStringUnique merges two string slices, removes duplicates and sorts the
+// result by byte order like MergeSlicesIntUnique.
+func MergeSlicesStringUnique(a, b []string, order OrderType) []string {
+	return mergeUniqueOrdered(a, b, order)
+}
+
+// mergeUniqueOrdered is a helper function that merges two slices, sorts the result and
+// removes adjacent duplicates, or deduplicates in concatenation order for OrderNone.
+func mergeUniqueOrdered[T cmp.Ordered](a, b []T, order OrderType) []T {
+	merged := Concat(a, b)
+	if order == OrderNone {
+		return RemoveDuplicatesFunc(merged, func(v T) T { return v })
+	}
+
+	slices.Sort(merged)
+	merged = slices.CompactFunc(merged, func(x, y T) bool { return cmp.Compare(x, y) == 0 })
+	if order == OrderDesc {
+		slices.Reverse(merged)
+	}
+	return slices.Clip(merged)
 }
 
 // MergeSlicesWithCustomSort merges two slices using a custom sorting function.
@@ -342,7 +762,8 @@ func MergeSlicesWithCustomSort[T any](a, b []T, sortFunc func([]T)) []T {
 }
 
 // MergeSlicesWithStableSort merges two slices using a stable sort algorithm.
-// Stable sort preserves the relative order of equal elements.
+// Stable sort preserves the relative order of equal elements, and OrderNone, which
+// treats every element as equal, therefore returns the concatenation unchanged.
 func MergeSlicesWithStableSort[T any](a, b []T, order OrderType, less func(T, T) bool) []T {
 	if a == nil && b == nil {
 		return nil
@@ -360,6 +781,10 @@ func MergeSlicesWithStableSort[T any](a, b []T, order OrderType, less func(T, T)
 		merged = append(merged, b...)
 	}
 
+	if order == OrderNone {
+		return merged
+	}
+
 	// Apply stable sort
 	sort.SliceStable(merged, func(i, j int) bool {
 		if order == OrderAsc {
@@ -370,3 +795,169 @@ func MergeSlicesWithStableSort[T any](a, b []T, order OrderType, less func(T, T)
 
 	return merged
 }
+
+// MergeByKey merges two slices into one element per key, resolving duplicate keys
+// according to the conflict policy. Keys are collected in the order they are first
+// seen in slice A followed by slice B; duplicates within a single slice are treated
+// as conflicts as well.
+//
+// The resolve function is only used with ConflictCustom and receives the value kept
+// so far together with the incoming one. ConflictSum requires a numeric element type
+// and returns ErrUnsupportedType before merging otherwise, or an error wrapping
+// ErrOverflow if an integer sum overflows. ConflictError returns an error wrapping
+// ErrConflict for any duplicate key, while ConflictErrorOnMismatch only does so when
+// the values differ according to reflect.DeepEqual, which suits reconciling two data
+// sources that are expected to agree. An unknown policy or a missing resolver returns
+// ErrInvalidPolicy.
+//
+// Time complexity: O(n + m) where n and m are the lengths of the slices
+// Space complexity: O(n + m) for the key index and result
+//
+// Example:
+//
+//	a := []Item{{SKU: "x", Qty: 1}}
+//	b := []Item{{SKU: "x", Qty: 2}, {SKU: "y", Qty: 3}}
+//	merged, err := MergeByKey(a, b, func(i Item) string { return i.SKU }, ConflictKeepLast, nil)
+//	// returns [{x 2} {y 3}], nil
+func MergeByKey[T any, K comparable](a, b []T, key func(T) K, policy ConflictPolicy, resolve func(existing, incoming T) T) ([]T, error) {
+	if err := validateConflictPolicy[T](policy, resolve != nil); err != nil {
+		return nil, err
+	}
+
+	positions := make(map[K]int, len(a)+len(b))
+	merged := make([]T, 0, len(a)+len(b))
+
+	for _, slice := range [][]T{a, b} {
+		for _, v := range slice {
+			k := key(v)
+			pos, exists := positions[k]
+			if !exists {
+				positions[k] = len(merged)
+				merged = append(merged, v)
+				continue
+			}
+
+			resolved, err := resolveConflict(k, merged[pos], v, policy, resolve)
+			if err != nil {
+				return nil, err
+			}
+			merged[pos] = resolved
+		}
+	}
+
+	return merged, nil
+}
+
+// MergeSliceMaps merges a slice of maps into a single map, resolving keys that occur
+// in more than one map according to the conflict policy. Maps are processed in order,
+// so "first" refers to the earliest map containing the key. Nil maps are skipped.
+// The policy rules and errors are the same as for MergeByKey.
+//
+// Example:
+//
+//	counts := []map[string]int{{"a": 1}, {"a": 2, "b": 1}}
+//	merged, err := MergeSliceMaps(counts, ConflictSum, nil)
+//	// returns map[a:3 b:1], nil
+func MergeSliceMaps[K comparable, V any](maps []map[K]V, policy ConflictPolicy, resolve func(existing, incoming V) V) (map[K]V, error) {
+	if err := validateConflictPolicy[V](policy, resolve != nil); err != nil {
+		return nil, err
+	}
+
+	merged := make(map[K]V)
+	for _, m := range maps {
+		for k, v := range m {
+			existing, exists := merged[k]
+			if !exists {
+				merged[k] = v
+				continue
+			}
+
+			resolved, err := resolveConflict(k, existing, v, policy, resolve)
+			if err != nil {
+				return nil, err
+			}
+			merged[k] = resolved
+		}
+	}
+
+	return merged, nil
+}
+
+// validateConflictPolicy is a helper function that checks that a policy is known, that
+// a resolver is available when the custom policy is requested, and that T is numeric
+// when the sum policy is requested.
+func validateConflictPolicy[T any](policy ConflictPolicy, hasResolver bool) error {
+	switch policy {
+	case ConflictKeepFirst, ConflictKeepLast, ConflictError, ConflictErrorOnMismatch:
+		return nil
+	case ConflictSum:
+		t := reflect.TypeFor[T]()
+		if kind := t.Kind(); !isIntegerKind(kind) && kind != reflect.Float32 && kind != reflect.Float64 {
+			return fmt.Errorf("%w: sum policy requires a numeric type, got %s", ErrUnsupportedType, t)
+		}
+		return nil
+	case ConflictCustom:
+		if !hasResolver {
+			return fmt.Errorf("%w: custom policy requires a resolver", ErrInvalidPolicy)
+		}
+		return nil
+	default:
+		return fmt.Errorf("%w: %q", ErrInvalidPolicy, policy)
+	}
+}
+
+// resolveConflict is a helper function that applies a conflict policy to two values sharing a key.
+func resolveConflict[K comparable, T any](key K, existing, incoming T, policy ConflictPolicy, resolve func(existing, incoming T) T) (T, error) {
+	switch policy {
+	case ConflictKeepFirst:
+		return existing, nil
+	case ConflictKeepLast:
+		return incoming, nil
+	case ConflictSum:
+		sum, err := addNumeric(existing, incoming)
+		if err != nil {
+			return existing, fmt.Errorf("%w: %v", err, key)
+		}
+		return sum, nil
+	case ConflictError:
+		return existing, fmt.Errorf("%w: %v", ErrConflict, key)
+	case ConflictErrorOnMismatch:
+		if !reflect.DeepEqual(existing, incoming) {
+			return existing, fmt.Errorf("%w: %v (%v != %v)", ErrConflict, key, existing, incoming)
+		}
+		return existing, nil
+	default:
+		return resolve(existing, incoming), nil
+	}
+}
+
+// addNumeric is a helper function that adds two values of a numeric kind using reflection.
+// It returns ErrOverflow if an integer sum does not fit in T, and ErrUnsupportedType for
+// non-numeric types.
+func addNumeric[T any](x, y T) (T, error) {
+	vx := reflect.ValueOf(&x).Elem()
+	vy := reflect.ValueOf(y)
+
+	switch vx.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		a, b := vx.Int(), vy.Int()
+		sum := a + b
+		if (b > 0 && sum < a) || (b < 0 && sum > a) || vx.OverflowInt(sum) {
+			return x, ErrOverflow
+		}
+		vx.SetInt(sum)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		a, b := vx.Uint(), vy.Uint()
+		sum := a + b
+		if sum < a || vx.OverflowUint(sum) {
+			return x, ErrOverflow
+		}
+		vx.SetUint(sum)
+	case reflect.Float32, reflect.Float64:
+		vx.SetFloat(vx.Float() + vy.Float())
+	default:
+		return x, ErrUnsupportedType
+	}
+
+	return x, nil
+}
@@ -1,8 +1,10 @@
 package sliceutil
 
 import (
+	"math"
 	"testing"
 
+	"github.com/devrob-go/sliceutil/pkg/sliceutil/benchcmp"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -84,6 +86,55 @@ func TestMergeSlices(t *testing.T) {
 	})
 }
 
+// TestMergeSlicesTyped tests the MergeSlicesTyped function
+func TestMergeSlicesTyped(t *testing.T) {
+	t.Run("Int Ascending", func(t *testing.T) {
+		result, err := MergeSlicesTyped([]int{5, 1, 3}, []int{4, 2, 6}, OrderAsc)
+		require.NoError(t, err)
+		assert.Equal(t, []int{1, 2, 3, 4, 5, 6}, result)
+	})
+
+	t.Run("String Descending", func(t *testing.T) {
+		result, err := MergeSlicesTyped([]string{"b", "d"}, []string{"a", "c"}, OrderDesc)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"d", "c", "b", "a"}, result)
+	})
+
+	t.Run("Other Ordered Types", func(t *testing.T) {
+		result, err := MergeSlicesTyped([]uint8{3, 1}, []uint8{2}, OrderAsc)
+		require.NoError(t, err)
+		assert.Equal(t, []uint8{1, 2, 3}, result)
+	})
+
+	t.Run("Inputs Not Modified", func(t *testing.T) {
+		a := []int{3, 1}
+		_, err := MergeSlicesTyped(a, nil, OrderAsc)
+		require.NoError(t, err)
+		assert.Equal(t, []int{3, 1}, a)
+	})
+
+	t.Run("Order None", func(t *testing.T) {
+		result, err := MergeSlicesTyped([]int{5, 1}, []int{4, 2}, OrderNone)
+		require.NoError(t, err)
+		assert.Equal(t, []int{5, 1, 4, 2}, result)
+	})
+
+	t.Run("Invalid Order", func(t *testing.T) {
+		_, err := MergeSlicesTyped([]int{1}, []int{2}, "INVALID")
+		assert.ErrorIs(t, err, ErrUnsupportedType)
+	})
+
+	t.Run("Nil and Empty", func(t *testing.T) {
+		result, err := MergeSlicesTyped[int](nil, nil, OrderAsc)
+		require.NoError(t, err)
+		assert.Nil(t, result)
+
+		result, err = MergeSlicesTyped([]int{}, nil, OrderAsc)
+		require.NoError(t, err)
+		assert.Empty(t, result)
+	})
+}
+
 // TestMergeSlicesGeneric tests the generic merge function
 func TestMergeSlicesGeneric(t *testing.T) {
 	t.Run("Merge with Custom Less Function", func(t *testing.T) {
@@ -169,6 +220,74 @@ func TestMergeSlicesString(t *testing.T) {
 	})
 }
 
+// TestMergeSlicesOrderNone tests that the merge functions keep the concatenation order
+// for OrderNone
+func TestMergeSlicesOrderNone(t *testing.T) {
+	t.Run("Concatenation Order", func(t *testing.T) {
+		assert.Equal(t, []int{3, 1, 2}, MergeSlicesInt([]int{3, 1}, []int{2}, OrderNone))
+		assert.Equal(t, []string{"b", "a", "c"}, MergeSlicesString([]string{"b", "a"}, []string{"c"}, OrderNone))
+		assert.Equal(t, []float64{2.5, 1}, MergeSlicesFloat64([]float64{2.5}, []float64{1}, OrderNone))
+
+		less := func(x, y int) bool { return x < y }
+		assert.Equal(t, []int{9, 8, 7}, MergeSlicesGeneric([]int{9}, []int{8, 7}, OrderNone, less))
+		assert.Equal(t, []int{3, 1, 2}, MergeSlicesWithStableSort([]int{3}, []int{1, 2}, OrderNone, less))
+		assert.Equal(t, []int{3, 1, 2}, MergeSlicesWithDeduplication([]int{3, 1, 3}, []int{2, 1}, OrderNone, less))
+	})
+
+	t.Run("Multiple Slices", func(t *testing.T) {
+		less := func(x, y int) bool { return x < y }
+		assert.Equal(t, []int{3, 2, 1}, MergeMultipleSlices([][]int{{3}, {2}, {1}}, OrderNone, less))
+		assert.Equal(t, []int{3, 1}, MergeMultipleSlices([][]int{{3, 1}}, OrderNone, less))
+		assert.Equal(t, []int{5, 1, 4, 2}, MergeSortedSlices([][]int{{5, 1}, nil, {4, 2}}, OrderNone, less))
+
+		result, err := MergeSortedSlicesChecked([][]int{{5, 1}, {4, 2}}, OrderNone, less)
+		require.NoError(t, err)
+		assert.Equal(t, []int{5, 1, 4, 2}, result)
+	})
+
+	t.Run("Inputs Not Aliased", func(t *testing.T) {
+		a := []int{1, 2}
+		result := MergeSlicesInt(a, nil, OrderNone)
+		result[0] = 99
+		assert.Equal(t, []int{1, 2}, a)
+	})
+
+	t.Run("Nil Slices", func(t *testing.T) {
+		assert.Nil(t, MergeSlicesString(nil, nil, OrderNone))
+	})
+}
+
+// TestMergeSlicesStringCollate tests the MergeSlicesStringCollate function
+func TestMergeSlicesStringCollate(t *testing.T) {
+	t.Run("Case Insensitive", func(t *testing.T) {
+		result := MergeSlicesStringCollate([]string{"banana", "Cherry"}, []string{"apple", "Banana"}, OrderAsc, CompareFold)
+		assert.Equal(t, []string{"apple", "Banana", "banana", "Cherry"}, result)
+
+		result = MergeSlicesStringCollate([]string{"b", "C"}, []string{"a"}, OrderDesc, CompareFold)
+		assert.Equal(t, []string{"C", "b", "a"}, result)
+	})
+
+	t.Run("Custom Collation Is Stable", func(t *testing.T) {
+		byLength := func(x, y string) int { return len(x) - len(y) }
+		result := MergeSlicesStringCollate([]string{"ccc", "bb"}, []string{"aa", "d"}, OrderAsc, byLength)
+		assert.Equal(t, []string{"d", "bb", "aa", "ccc"}, result)
+	})
+
+	t.Run("Nil Collation Uses Byte Order", func(t *testing.T) {
+		result := MergeSlicesStringCollate([]string{"b", "C"}, []string{"a"}, OrderAsc, nil)
+		assert.Equal(t, MergeSlicesString([]string{"b", "C"}, []string{"a"}, OrderAsc), result)
+	})
+
+	t.Run("Order None", func(t *testing.T) {
+		result := MergeSlicesStringCollate([]string{"b"}, []string{"a"}, OrderNone, CompareFold)
+		assert.Equal(t, []string{"b", "a"}, result)
+	})
+
+	t.Run("Nil Slices", func(t *testing.T) {
+		assert.Nil(t, MergeSlicesStringCollate(nil, nil, OrderAsc, CompareFold))
+	})
+}
+
 // TestMergeSlicesFloat64 tests the type-safe float64 merge function
 func TestMergeSlicesFloat64(t *testing.T) {
 	t.Run("Merge Float64 Slices Ascending", func(t *testing.T) {
@@ -242,6 +361,232 @@ func TestMergeMultipleSlices(t *testing.T) {
 	})
 }
 
+// TestMergeWithPriority tests the MergeWithPriority function
+func TestMergeWithPriority(t *testing.T) {
+	type post struct {
+		ID    string
+		Score float64
+	}
+	score := func(p post) float64 { return p.Score }
+	news := []post{{"n1", 0.5}, {"n2", 0.9}}
+	friends := []post{{"f1", 0.7}, {"f2", 0.5}}
+
+	t.Run("Descending Score", func(t *testing.T) {
+		result := MergeWithPriority([][]post{news, friends}, score, OrderDesc)
+		assert.Equal(t, []post{{"n2", 0.9}, {"f1", 0.7}, {"n1", 0.5}, {"f2", 0.5}}, result)
+	})
+
+	t.Run("Ascending Score Is Stable", func(t *testing.T) {
+		result := MergeWithPriority([][]post{news, friends}, score, OrderAsc)
+		assert.Equal(t, []post{{"n1", 0.5}, {"f2", 0.5}, {"f1", 0.7}, {"n2", 0.9}}, result)
+	})
+
+	t.Run("Weight Computed Once Per Element", func(t *testing.T) {
+		calls := 0
+		counting := func(p post) float64 {
+			calls++
+			return p.Score
+		}
+		MergeWithPriority([][]post{news, friends}, counting, OrderDesc)
+		assert.Equal(t, 4, calls)
+	})
+
+	t.Run("NaN Weights", func(t *testing.T) {
+		weights := [][]float64{{1, math.NaN(), 3}}
+		result := MergeWithPriority(weights, func(w float64) float64 { return w }, OrderDesc)
+		assert.Equal(t, []float64{3, 1}, result[:2])
+		assert.True(t, math.IsNaN(result[2]))
+	})
+
+	t.Run("Order None", func(t *testing.T) {
+		result := MergeWithPriority([][]post{news, friends}, score, OrderNone)
+		assert.Equal(t, Concat(news, friends), result)
+	})
+
+	t.Run("Does Not Modify Inputs", func(t *testing.T) {
+		input := []post{{"a", 1}, {"b", 2}}
+		MergeWithPriority([][]post{input}, score, OrderDesc)
+		assert.Equal(t, []post{{"a", 1}, {"b", 2}}, input)
+	})
+
+	t.Run("Nil and Empty", func(t *testing.T) {
+		assert.Nil(t, MergeWithPriority[post](nil, score, OrderAsc))
+		assert.Equal(t, []post{}, MergeWithPriority([][]post{{}}, score, OrderAsc))
+	})
+}
+
+// TestConcat tests the Concat function
+func TestConcat(t *testing.T) {
+	t.Run("Preserves Order Without Sorting", func(t *testing.T) {
+		result := Concat([]int{3, 1}, []int{2}, []int{5, 4})
+		assert.Equal(t, []int{3, 1, 2, 5, 4}, result)
+	})
+
+	t.Run("Preallocates Exact Capacity", func(t *testing.T) {
+		result := Concat([]int{1, 2}, []int{3})
+		assert.Equal(t, 3, cap(result))
+	})
+
+	t.Run("Does Not Alias Inputs", func(t *testing.T) {
+		a := []int{1, 2}
+		result := Concat(a)
+		result[0] = 99
+		assert.Equal(t, []int{1, 2}, a)
+	})
+
+	t.Run("Nil and Empty", func(t *testing.T) {
+		assert.Nil(t, Concat[int]())
+		assert.Nil(t, Concat[int](nil, nil))
+		assert.Equal(t, []int{1}, Concat(nil, []int{1}))
+		assert.NotNil(t, Concat([]int{}, nil))
+		assert.Empty(t, Concat([]int{}, nil))
+	})
+}
+
+// TestInterleave tests the Interleave function
+func TestInterleave(t *testing.T) {
+	t.Run("Takes One From Each In Turn", func(t *testing.T) {
+		result := Interleave([]int{1, 2, 3}, []int{10}, []int{20, 21})
+		assert.Equal(t, []int{1, 10, 20, 2, 21, 3}, result)
+	})
+
+	t.Run("Preserves Order Without Sorting", func(t *testing.T) {
+		result := Interleave([]string{"urgent", "normal"}, []string{"b-high", "b-low"})
+		assert.Equal(t, []string{"urgent", "b-high", "normal", "b-low"}, result)
+	})
+
+	t.Run("Single Slice", func(t *testing.T) {
+		assert.Equal(t, []int{3, 1, 2}, Interleave([]int{3, 1, 2}))
+	})
+
+	t.Run("Nil and Empty", func(t *testing.T) {
+		assert.Nil(t, Interleave[int]())
+		assert.Nil(t, Interleave[int](nil, nil))
+		assert.Equal(t, []int{}, Interleave([]int{}, nil))
+		assert.Equal(t, []int{1}, Interleave(nil, []int{1}))
+	})
+}
+
+// TestRoundRobin tests the RoundRobin function
+func TestRoundRobin(t *testing.T) {
+	t.Run("Weighted Quotas", func(t *testing.T) {
+		result, err := RoundRobin([][]string{{"a1", "a2", "a3"}, {"b1", "b2"}}, []int{2, 1})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"a1", "a2", "b1", "a3", "b2"}, result)
+	})
+
+	t.Run("Huge Quota", func(t *testing.T) {
+		result, err := RoundRobin([][]int{{1, 2}, {3}}, []int{math.MaxInt, 1})
+		require.NoError(t, err)
+		assert.Equal(t, []int{1, 2, 3}, result)
+	})
+
+	t.Run("Exhausted Sources Are Skipped", func(t *testing.T) {
+		result, err := RoundRobin([][]int{{1}, {2, 3, 4, 5, 6}}, []int{3, 2})
+		require.NoError(t, err)
+		assert.Equal(t, []int{1, 2, 3, 4, 5, 6}, result)
+	})
+
+	t.Run("Invalid Quotas", func(t *testing.T) {
+		_, err := RoundRobin([][]int{{1}, {2}}, []int{1})
+		assert.ErrorIs(t, err, ErrLengthMismatch)
+
+		_, err = RoundRobin([][]int{{1}, {2}}, []int{1, 0})
+		assert.ErrorIs(t, err, ErrOutOfRange)
+	})
+
+	t.Run("Nil and Empty", func(t *testing.T) {
+		result, err := RoundRobin[int](nil, nil)
+		require.NoError(t, err)
+		assert.Nil(t, result)
+	})
+}
+
+// TestFlatten tests the Flatten function
+func TestFlatten(t *testing.T) {
+	t.Run("Flattens Nested Slices", func(t *testing.T) {
+		result := Flatten([][]string{{"a", "b"}, {}, nil, {"c"}})
+		assert.Equal(t, []string{"a", "b", "c"}, result)
+	})
+
+	t.Run("Nil and Empty", func(t *testing.T) {
+		assert.Nil(t, Flatten[int](nil))
+		assert.Nil(t, Flatten([][]int{}))
+	})
+}
+
+// TestMergeSortedSlices tests the k-way merge of pre-sorted slices
+func TestMergeSortedSlices(t *testing.T) {
+	less := func(a, b int) bool { return a < b }
+
+	t.Run("Ascending", func(t *testing.T) {
+		slices := [][]int{{1, 4, 7}, {2, 5}, {3, 6, 8, 9}}
+		result := MergeSortedSlices(slices, OrderAsc, less)
+		assert.Equal(t, []int{1, 2, 3, 4, 5, 6, 7, 8, 9}, result)
+	})
+
+	t.Run("Descending", func(t *testing.T) {
+		slices := [][]int{{9, 4, 1}, {8, 5}, {7}}
+		result := MergeSortedSlices(slices, OrderDesc, less)
+		assert.Equal(t, []int{9, 8, 7, 5, 4, 1}, result)
+	})
+
+	t.Run("Equal Elements Keep Slice Order", func(t *testing.T) {
+		type item struct {
+			Key    int
+			Source string
+		}
+		slices := [][]item{
+			{{1, "a"}, {2, "a"}},
+			{{1, "b"}, {2, "b"}},
+		}
+		result := MergeSortedSlices(slices, OrderAsc, func(x, y item) bool { return x.Key < y.Key })
+		assert.Equal(t, []item{{1, "a"}, {1, "b"}, {2, "a"}, {2, "b"}}, result)
+	})
+
+	t.Run("Inputs Not Modified", func(t *testing.T) {
+		a := []int{1, 3}
+		b := []int{2, 4}
+		MergeSortedSlices([][]int{a, b}, OrderAsc, less)
+		assert.Equal(t, []int{1, 3}, a)
+		assert.Equal(t, []int{2, 4}, b)
+	})
+
+	t.Run("Nil and Empty", func(t *testing.T) {
+		assert.Nil(t, MergeSortedSlices(nil, OrderAsc, less))
+		assert.Empty(t, MergeSortedSlices([][]int{nil, {}}, OrderAsc, less))
+		assert.Equal(t, []int{1, 2}, MergeSortedSlices([][]int{nil, {1, 2}}, OrderAsc, less))
+	})
+}
+
+// TestMergeSortedSlicesChecked tests the validating k-way merge
+func TestMergeSortedSlicesChecked(t *testing.T) {
+	less := func(a, b int) bool { return a < b }
+
+	t.Run("Sorted Inputs", func(t *testing.T) {
+		result, err := MergeSortedSlicesChecked([][]int{{1, 3}, {2, 4}}, OrderAsc, less)
+		assert.NoError(t, err)
+		assert.Equal(t, []int{1, 2, 3, 4}, result)
+	})
+
+	t.Run("Unsorted Input", func(t *testing.T) {
+		result, err := MergeSortedSlicesChecked([][]int{{1, 2}, {5, 3}}, OrderAsc, less)
+		assert.ErrorIs(t, err, ErrNotSorted)
+		assert.Contains(t, err.Error(), "slice 1 at index 1")
+		assert.Nil(t, result)
+	})
+
+	t.Run("Wrong Direction", func(t *testing.T) {
+		_, err := MergeSortedSlicesChecked([][]int{{1, 2}}, OrderDesc, less)
+		assert.ErrorIs(t, err, ErrNotSorted)
+	})
+
+	t.Run("Invalid Order", func(t *testing.T) {
+		_, err := MergeSortedSlicesChecked([][]int{{1, 2}}, OrderType("INVALID"), less)
+		assert.ErrorIs(t, err, ErrUnsupportedType)
+	})
+}
+
 // TestMergeSlicesWithDeduplication tests merging with duplicate removal
 func TestMergeSlicesWithDeduplication(t *testing.T) {
 	t.Run("Merge with Duplicates", func(t *testing.T) {
@@ -265,6 +610,92 @@ func TestMergeSlicesWithDeduplication(t *testing.T) {
 	})
 }
 
+// TestMergeInto tests the MergeInto function
+func TestMergeInto(t *testing.T) {
+	less := func(x, y int) bool { return x < y }
+
+	t.Run("Reuses Capacity", func(t *testing.T) {
+		buf := make([]int, 0, 8)
+		result := MergeInto(buf, []int{5, 1, 3}, []int{4, 2}, OrderAsc, less)
+
+		assert.Equal(t, []int{1, 2, 3, 4, 5}, result)
+		assert.Same(t, &buf[:1][0], &result[0])
+	})
+
+	t.Run("Appends After Existing Elements", func(t *testing.T) {
+		dst := []int{9, 7}
+		result := MergeInto(dst, []int{2}, []int{3, 1}, OrderDesc, less)
+		assert.Equal(t, []int{9, 7, 3, 2, 1}, result)
+	})
+
+	t.Run("Grows When Too Small", func(t *testing.T) {
+		buf := make([]int, 0, 1)
+		result := MergeInto(buf, []int{2, 1}, []int{3}, OrderAsc, less)
+		assert.Equal(t, []int{1, 2, 3}, result)
+		assert.Equal(t, 0, len(buf))
+	})
+
+	t.Run("Stable And Order None", func(t *testing.T) {
+		type item struct{ Key, ID int }
+		byKey := func(x, y item) bool { return x.Key < y.Key }
+		result := MergeInto(nil, []item{{1, 1}, {0, 2}}, []item{{1, 3}}, OrderAsc, byKey)
+		assert.Equal(t, []item{{0, 2}, {1, 1}, {1, 3}}, result)
+
+		assert.Equal(t, []int{3, 1, 2}, MergeInto(nil, []int{3, 1}, []int{2}, OrderNone, less))
+	})
+
+	t.Run("Pooled Buffer Round Trip", func(t *testing.T) {
+		buf := make([]int, 0, 4)
+		for range 3 {
+			buf = MergeInto(buf[:0], []int{2, 1}, []int{4, 3}, OrderAsc, less)
+			assert.Equal(t, []int{1, 2, 3, 4}, buf)
+		}
+		assert.Equal(t, 4, cap(buf))
+	})
+
+	t.Run("Nil Inputs", func(t *testing.T) {
+		assert.Nil(t, MergeInto[int](nil, nil, nil, OrderAsc, less))
+		assert.Equal(t, []int{1}, MergeInto([]int{1}, nil, nil, OrderAsc, less))
+	})
+}
+
+// TestMergeSlicesUnique tests the typed deduplicating merge functions
+func TestMergeSlicesUnique(t *testing.T) {
+	t.Run("Int", func(t *testing.T) {
+		a := []int{3, 1, 3}
+		b := []int{2, 1, 5}
+		assert.Equal(t, []int{1, 2, 3, 5}, MergeSlicesIntUnique(a, b, OrderAsc))
+		assert.Equal(t, []int{5, 3, 2, 1}, MergeSlicesIntUnique(a, b, OrderDesc))
+		assert.Equal(t, []int{3, 1, 2, 5}, MergeSlicesIntUnique(a, b, OrderNone))
+		assert.Equal(t, []int{3, 1, 3}, a)
+	})
+
+	t.Run("Float64", func(t *testing.T) {
+		result := MergeSlicesFloat64Unique([]float64{2.5, math.NaN(), 1}, []float64{math.NaN(), 2.5}, OrderAsc)
+		require.Len(t, result, 3)
+		assert.True(t, math.IsNaN(result[0]))
+		assert.Equal(t, []float64{1, 2.5}, result[1:])
+	})
+
+	t.Run("String", func(t *testing.T) {
+		result := MergeSlicesStringUnique([]string{"b", "a"}, []string{"c", "b"}, OrderDesc)
+		assert.Equal(t, []string{"c", "b", "a"}, result)
+	})
+
+	t.Run("Matches MergeSlicesWithDeduplication", func(t *testing.T) {
+		a := []int{1, 2, 2, 3}
+		b := []int{3, 4, 4, 5}
+		less := func(a, b int) bool { return a < b }
+		assert.Equal(t, MergeSlicesWithDeduplication(a, b, OrderAsc, less), MergeSlicesIntUnique(a, b, OrderAsc))
+	})
+
+	t.Run("Nil and Empty", func(t *testing.T) {
+		assert.Nil(t, MergeSlicesIntUnique(nil, nil, OrderAsc))
+		assert.Equal(t, []string{"a"}, MergeSlicesStringUnique(nil, []string{"a", "a"}, OrderAsc))
+		assert.Empty(t, MergeSlicesFloat64Unique([]float64{}, nil, OrderAsc))
+	})
+}
+
 // TestMergeSlicesWithCustomSort tests custom sorting
 func TestMergeSlicesWithCustomSort(t *testing.T) {
 	t.Run("Custom Sort Function", func(t *testing.T) {
@@ -334,3 +765,181 @@ func TestEdgeCases(t *testing.T) {
 		assert.True(t, IsSortedInt(resultSlice))
 	})
 }
+
+// TestMergeByKey tests the MergeByKey function with every conflict policy
+func TestMergeByKey(t *testing.T) {
+	type item struct {
+		SKU string
+		Qty int
+	}
+	key := func(i item) string { return i.SKU }
+	a := []item{{"x", 1}, {"y", 2}}
+	b := []item{{"x", 5}, {"z", 3}}
+
+	t.Run("Keep First", func(t *testing.T) {
+		result, err := MergeByKey(a, b, key, ConflictKeepFirst, nil)
+		require.NoError(t, err)
+		assert.Equal(t, []item{{"x", 1}, {"y", 2}, {"z", 3}}, result)
+	})
+
+	t.Run("Keep Last", func(t *testing.T) {
+		result, err := MergeByKey(a, b, key, ConflictKeepLast, nil)
+		require.NoError(t, err)
+		assert.Equal(t, []item{{"x", 5}, {"y", 2}, {"z", 3}}, result)
+	})
+
+	t.Run("Custom", func(t *testing.T) {
+		sumQty := func(existing, incoming item) item {
+			return item{SKU: existing.SKU, Qty: existing.Qty + incoming.Qty}
+		}
+		result, err := MergeByKey(a, b, key, ConflictCustom, sumQty)
+		require.NoError(t, err)
+		assert.Equal(t, []item{{"x", 6}, {"y", 2}, {"z", 3}}, result)
+	})
+
+	t.Run("Error", func(t *testing.T) {
+		_, err := MergeByKey(a, b, key, ConflictError, nil)
+		assert.ErrorIs(t, err, ErrConflict)
+		assert.Contains(t, err.Error(), "x")
+
+		result, err := MergeByKey(a, []item{{"z", 1}}, key, ConflictError, nil)
+		require.NoError(t, err)
+		assert.Len(t, result, 3)
+	})
+
+	t.Run("Error On Mismatch", func(t *testing.T) {
+		// Equal duplicates are reconciled silently
+		result, err := MergeByKey(a, []item{{"x", 1}, {"z", 3}}, key, ConflictErrorOnMismatch, nil)
+		require.NoError(t, err)
+		assert.Equal(t, []item{{"x", 1}, {"y", 2}, {"z", 3}}, result)
+
+		_, err = MergeByKey(a, b, key, ConflictErrorOnMismatch, nil)
+		assert.ErrorIs(t, err, ErrConflict)
+		assert.Contains(t, err.Error(), "{x 1} != {x 5}")
+
+		// Duplicates within one slice are checked too
+		_, err = MergeByKey([]item{{"x", 1}, {"x", 2}}, nil, key, ConflictErrorOnMismatch, nil)
+		assert.ErrorIs(t, err, ErrConflict)
+	})
+
+	t.Run("Sum", func(t *testing.T) {
+		result, err := MergeByKey([]int{1, 2}, []int{1, 3}, func(v int) int { return v }, ConflictSum, nil)
+		require.NoError(t, err)
+		assert.Equal(t, []int{2, 2, 3}, result)
+
+		_, err = MergeByKey(a, b, key, ConflictSum, nil)
+		assert.ErrorIs(t, err, ErrUnsupportedType)
+
+		// Non-numeric types are rejected even when no keys collide
+		_, err = MergeByKey([]item{{"a", 1}}, []item{{"b", 2}}, key, ConflictSum, nil)
+		assert.ErrorIs(t, err, ErrUnsupportedType)
+	})
+
+	t.Run("Sum Overflow", func(t *testing.T) {
+		id := func(int8) int { return 0 }
+		_, err := MergeByKey([]int8{100}, []int8{100}, id, ConflictSum, nil)
+		assert.ErrorIs(t, err, ErrOverflow)
+		assert.Contains(t, err.Error(), ": 0")
+
+		_, err = MergeByKey([]int64{math.MinInt64}, []int64{-1}, func(int64) string { return "k" }, ConflictSum, nil)
+		assert.ErrorIs(t, err, ErrOverflow)
+
+		_, err = MergeSliceMaps([]map[string]uint64{{"k": math.MaxUint64}, {"k": 1}}, ConflictSum, nil)
+		assert.ErrorIs(t, err, ErrOverflow)
+		assert.Contains(t, err.Error(), "k")
+
+		result, err := MergeByKey([]int8{100}, []int8{-100}, id, ConflictSum, nil)
+		require.NoError(t, err)
+		assert.Equal(t, []int8{0}, result)
+	})
+
+	t.Run("Invalid Policy", func(t *testing.T) {
+		_, err := MergeByKey(a, b, key, ConflictPolicy("OTHER"), nil)
+		assert.ErrorIs(t, err, ErrInvalidPolicy)
+
+		_, err = MergeByKey(a, b, key, ConflictCustom, nil)
+		assert.ErrorIs(t, err, ErrInvalidPolicy)
+	})
+
+	t.Run("Nil Slices", func(t *testing.T) {
+		result, err := MergeByKey(nil, nil, key, ConflictKeepFirst, nil)
+		require.NoError(t, err)
+		assert.Empty(t, result)
+	})
+}
+
+// TestMergeSliceMaps tests the MergeSliceMaps function
+func TestMergeSliceMaps(t *testing.T) {
+	maps := []map[string]float64{{"a": 1.5, "b": 1}, nil, {"a": 2, "c": 4}}
+
+	t.Run("Sum", func(t *testing.T) {
+		result, err := MergeSliceMaps(maps, ConflictSum, nil)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]float64{"a": 3.5, "b": 1, "c": 4}, result)
+	})
+
+	t.Run("Keep First And Last", func(t *testing.T) {
+		first, err := MergeSliceMaps(maps, ConflictKeepFirst, nil)
+		require.NoError(t, err)
+		assert.Equal(t, 1.5, first["a"])
+
+		last, err := MergeSliceMaps(maps, ConflictKeepLast, nil)
+		require.NoError(t, err)
+		assert.Equal(t, 2.0, last["a"])
+	})
+
+	t.Run("Custom", func(t *testing.T) {
+		maxOf := func(existing, incoming float64) float64 {
+			if incoming > existing {
+				return incoming
+			}
+			return existing
+		}
+		result, err := MergeSliceMaps(maps, ConflictCustom, maxOf)
+		require.NoError(t, err)
+		assert.Equal(t, 2.0, result["a"])
+	})
+
+	t.Run("Error", func(t *testing.T) {
+		_, err := MergeSliceMaps(maps, ConflictError, nil)
+		assert.ErrorIs(t, err, ErrConflict)
+
+		_, err = MergeSliceMaps(maps, ConflictErrorOnMismatch, nil)
+		assert.ErrorIs(t, err, ErrConflict)
+
+		agreeing, err := MergeSliceMaps([]map[string]int{{"a": 1}, {"a": 1, "b": 2}}, ConflictErrorOnMismatch, nil)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]int{"a": 1, "b": 2}, agreeing)
+	})
+
+	t.Run("Empty Input", func(t *testing.T) {
+		result, err := MergeSliceMaps[string, int](nil, ConflictSum, nil)
+		require.NoError(t, err)
+		assert.Empty(t, result)
+	})
+}
+
+// BenchmarkMergeSlicesTyped_SmallMediumLarge benchmarks merging two unsorted int
+// slices of n elements each
+func BenchmarkMergeSlicesTyped_SmallMediumLarge(b *testing.B) {
+	benchcmp.SmallMediumLarge(b, func(b *testing.B, n int) {
+		x := Generate(n, func(i int) int { return i * 7919 % n })
+		y := Generate(n, func(i int) int { return i * 104729 % n })
+		for b.Loop() {
+			_, _ = MergeSlicesTyped(x, y, OrderAsc)
+		}
+	})
+}
+
+// BenchmarkMergeSlicesGeneric_SmallMediumLarge benchmarks merging two unsorted int
+// slices of n elements each with a less function
+func BenchmarkMergeSlicesGeneric_SmallMediumLarge(b *testing.B) {
+	benchcmp.SmallMediumLarge(b, func(b *testing.B, n int) {
+		x := Generate(n, func(i int) int { return i * 7919 % n })
+		y := Generate(n, func(i int) int { return i * 104729 % n })
+		less := func(p, q int) bool { return p < q }
+		for b.Loop() {
+			MergeSlicesGeneric(x, y, OrderAsc, less)
+		}
+	})
+}
@@ -0,0 +1,245 @@
+package sliceutil
+
+import (
+	"iter"
+	"sort"
+)
+
+// MultiSet is a collection that counts how many times each value occurs, also known as
+// a bag or counter. It formalizes the count-aware semantics of FindDifferencesWithCount
+// and the Multiset functions, so counts can be kept and updated across operations
+// instead of being recomputed from slices.
+//
+// Values are kept in the order they were first added, which determines the order of
+// ToSlice and the tie-breaking of MostCommon. A value whose count drops to zero is
+// removed and counts as new when it is added again.
+//
+// The zero value is an empty MultiSet ready to use. A MultiSet is not safe for
+// concurrent modification.
+type MultiSet[T comparable] struct {
+	entries map[T]multiSetEntry
+	// order holds the values in order of first insertion; entries whose pos does not
+	// point back to their index are stale and skipped
+	order []T
+	total int
+}
+
+// multiSetEntry is the count of a value and its position in MultiSet.order.
+type multiSetEntry struct {
+	count int
+	pos   int
+}
+
+// NewMultiSet creates a MultiSet holding the given values.
+//
+// Example:
+//
+//	words := NewMultiSet("a", "b", "a")
+//	count := words.Count("a") // returns 2
+func NewMultiSet[T comparable](values ...T) *MultiSet[T] {
+	return MultiSetOf(values)
+}
+
+// MultiSetOf creates a MultiSet holding the elements of a slice.
+//
+// Time complexity: O(n) where n is the length of the slice
+// Space complexity: O(k) where k is the number of distinct elements
+//
+// Example:
+//
+//	visits := MultiSetOf(pageViews)
+//	top := visits.MostCommon(10)
+func MultiSetOf[T comparable](s []T) *MultiSet[T] {
+	m := &MultiSet[T]{}
+	m.Add(s...)
+	return m
+}
+
+// Add adds one occurrence of each of the given values.
+func (m *MultiSet[T]) Add(values ...T) {
+	for _, v := range values {
+		m.AddN(v, 1)
+	}
+}
+
+// AddN adds n occurrences of v. Values of n less than one are ignored.
+func (m *MultiSet[T]) AddN(v T, n int) {
+	if n < 1 {
+		return
+	}
+	if m.entries == nil {
+		m.entries = make(map[T]multiSetEntry)
+	}
+
+	e, ok := m.entries[v]
+	if !ok {
+		e.pos = len(m.order)
+		m.order = append(m.order, v)
+	}
+	e.count += n
+	m.entries[v] = e
+	m.total += n
+}
+
+// Remove removes one occurrence of each of the given values. Values that are not
+// present are ignored.
+func (m *MultiSet[T]) Remove(values ...T) {
+	for _, v := range values {
+		m.RemoveN(v, 1)
+	}
+}
+
+// RemoveN removes up to n occurrences of v. Values of n less than one are ignored.
+func (m *MultiSet[T]) RemoveN(v T, n int) {
+	e, ok := m.entries[v]
+	if !ok || n < 1 {
+		return
+	}
+
+	n = min(n, e.count)
+	m.total -= n
+	if e.count > n {
+		e.count -= n
+		m.entries[v] = e
+		return
+	}
+
+	delete(m.entries, v)
+	// Drop stale positions once they make up most of the order
+	if len(m.order) >= 32 && len(m.order) > 2*len(m.entries) {
+		m.compact()
+	}
+}
+
+// Count returns the number of occurrences of v.
+func (m *MultiSet[T]) Count(v T) int {
+	if m == nil {
+		return 0
+	}
+	return m.entries[v].count
+}
+
+// Len returns the total number of occurrences of all values.
+func (m *MultiSet[T]) Len() int {
+	return m.total
+}
+
+// Distinct returns the number of distinct values.
+func (m *MultiSet[T]) Distinct() int {
+	return len(m.entries)
+}
+
+// MostCommon returns the n values with the highest counts, from the most to the least
+// common. Values with equal counts are ordered by when they were first added. If n is
+// negative or exceeds the number of distinct values, all values are returned.
+//
+// Time complexity: O(k log k) where k is the number of distinct values
+// Space complexity: O(k) for the result
+//
+// Example:
+//
+//	words := MultiSetOf(strings.Fields("the cat and the hat and the bat"))
+//	top := words.MostCommon(2) // returns [{the 3} {and 2}]
+func (m *MultiSet[T]) MostCommon(n int) []ElementCount[T] {
+	result := make([]ElementCount[T], 0, len(m.entries))
+	for v, count := range m.counts() {
+		result = append(result, ElementCount[T]{Value: v, Count: count})
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].Count > result[j].Count
+	})
+
+	if n >= 0 && n < len(result) {
+		result = result[:n]
+	}
+	return result
+}
+
+// Union returns a new MultiSet in which every value occurs as often as in whichever
+// of the two MultiSets holds it more often, like MultisetUnion.
+func (m *MultiSet[T]) Union(other *MultiSet[T]) *MultiSet[T] {
+	result := &MultiSet[T]{}
+	for v, count := range m.counts() {
+		result.AddN(v, max(count, other.Count(v)))
+	}
+	for v, count := range other.counts() {
+		if m.Count(v) == 0 {
+			result.AddN(v, count)
+		}
+	}
+	return result
+}
+
+// Intersect returns a new MultiSet in which every value occurs as often as in whichever
+// of the two MultiSets holds it less often, like MultisetIntersection.
+func (m *MultiSet[T]) Intersect(other *MultiSet[T]) *MultiSet[T] {
+	result := &MultiSet[T]{}
+	for v, count := range m.counts() {
+		result.AddN(v, min(count, other.Count(v)))
+	}
+	return result
+}
+
+// Subtract returns a new MultiSet holding the occurrences of m that remain after
+// removing one for every occurrence in other, like MultisetSubtract.
+func (m *MultiSet[T]) Subtract(other *MultiSet[T]) *MultiSet[T] {
+	result := &MultiSet[T]{}
+	for v, count := range m.counts() {
+		result.AddN(v, count-other.Count(v))
+	}
+	return result
+}
+
+// ToSlice returns every occurrence as a slice, with the occurrences of each value
+// grouped together in the order the values were first added. The result is never nil.
+//
+// Example:
+//
+//	s := NewMultiSet("b", "a", "b").ToSlice() // returns []string{"b", "b", "a"}
+func (m *MultiSet[T]) ToSlice() []T {
+	result := make([]T, 0, m.total)
+	for v, count := range m.counts() {
+		for range count {
+			result = append(result, v)
+		}
+	}
+	return result
+}
+
+// Counts returns the count of every value as a map, in the format of Frequencies.
+func (m *MultiSet[T]) Counts() map[T]int {
+	result := make(map[T]int, len(m.entries))
+	for v, e := range m.entries {
+		result[v] = e.count
+	}
+	return result
+}
+
+// counts is a helper function that yields every value with its count in order of
+// first insertion.
+func (m *MultiSet[T]) counts() iter.Seq2[T, int] {
+	return func(yield func(T, int) bool) {
+		if m == nil {
+			return
+		}
+		for i, v := range m.order {
+			e, ok := m.entries[v]
+			if !ok || e.pos != i {
+				continue
+			}
+			if !yield(v, e.count) {
+				return
+			}
+		}
+	}
+}
+
+// compact is a helper function that removes stale values from the insertion order.
+func (m *MultiSet[T]) compact() {
+	order := make([]T, 0, len(m.entries))
+	for v, count := range m.counts() {
+		m.entries[v] = multiSetEntry{count: count, pos: len(order)}
+		order = append(order, v)
+	}
+	m.order = order
+}
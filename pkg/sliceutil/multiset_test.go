@@ -0,0 +1,103 @@
+package sliceutil
+
+import (
+	"math/rand/v2"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestMultiSet tests the MultiSet type
+func TestMultiSet(t *testing.T) {
+	t.Run("Add Remove Count", func(t *testing.T) {
+		m := NewMultiSet("a", "b", "a")
+		m.AddN("c", 3)
+		assert.Equal(t, 2, m.Count("a"))
+		assert.Equal(t, 3, m.Count("c"))
+		assert.Equal(t, 0, m.Count("z"))
+		assert.Equal(t, 6, m.Len())
+		assert.Equal(t, 3, m.Distinct())
+
+		m.Remove("a", "z")
+		m.RemoveN("c", 10)
+		assert.Equal(t, 1, m.Count("a"))
+		assert.Equal(t, 0, m.Count("c"))
+		assert.Equal(t, 2, m.Len())
+		assert.Equal(t, 2, m.Distinct())
+
+		m.AddN("a", 0)
+		m.RemoveN("a", -1)
+		assert.Equal(t, 1, m.Count("a"))
+	})
+
+	t.Run("MostCommon", func(t *testing.T) {
+		words := MultiSetOf(strings.Fields("the cat and the hat and the bat"))
+		assert.Equal(t, []ElementCount[string]{{"the", 3}, {"and", 2}}, words.MostCommon(2))
+		assert.Equal(t, []ElementCount[string]{
+			{"the", 3}, {"and", 2}, {"cat", 1}, {"hat", 1}, {"bat", 1},
+		}, words.MostCommon(-1))
+		assert.Len(t, words.MostCommon(100), 5)
+		assert.Empty(t, words.MostCommon(0))
+	})
+
+	t.Run("Removed Values Count As New", func(t *testing.T) {
+		m := NewMultiSet(1, 2, 3)
+		m.Remove(1)
+		m.Add(1)
+		assert.Equal(t, []int{2, 3, 1}, m.ToSlice())
+	})
+
+	t.Run("Matches Multiset Functions", func(t *testing.T) {
+		a := []int{1, 1, 1, 2, 4}
+		b := []int{1, 1, 3, 4, 4}
+		ma, mb := MultiSetOf(a), MultiSetOf(b)
+
+		assert.Equal(t, Frequencies(MultisetUnion(a, b)), ma.Union(mb).Counts())
+		assert.Equal(t, Frequencies(MultisetIntersection(a, b)), ma.Intersect(mb).Counts())
+		assert.Equal(t, Frequencies(MultisetSubtract(a, b)), ma.Subtract(mb).Counts())
+		assert.Equal(t, []int{1, 1, 1, 2, 4, 4, 3}, ma.Union(mb).ToSlice())
+
+		// The operands are not modified
+		assert.Equal(t, Frequencies(a), ma.Counts())
+		assert.Equal(t, Frequencies(b), mb.Counts())
+	})
+
+	t.Run("Matches Frequencies After Many Updates", func(t *testing.T) {
+		rng := rand.New(rand.NewPCG(3, 4))
+		var m MultiSet[int]
+		model := make(map[int]int)
+
+		for range 5000 {
+			v := rng.IntN(50)
+			if rng.IntN(3) == 0 {
+				m.Add(v)
+				model[v]++
+			} else if model[v] > 0 {
+				m.Remove(v)
+				model[v]--
+				if model[v] == 0 {
+					delete(model, v)
+				}
+			}
+		}
+		assert.Equal(t, model, m.Counts())
+		assert.Equal(t, model, Frequencies(m.ToSlice()))
+		assert.LessOrEqual(t, len(m.order), max(32, 2*m.Distinct()+1))
+	})
+
+	t.Run("Nil and Empty", func(t *testing.T) {
+		var zero MultiSet[string]
+		assert.Equal(t, 0, zero.Len())
+		assert.Equal(t, 0, zero.Count("a"))
+		assert.Equal(t, []string{}, zero.ToSlice())
+		assert.Equal(t, []ElementCount[string]{}, zero.MostCommon(3))
+		assert.Equal(t, map[string]int{}, zero.Counts())
+
+		zero.Remove("a")
+		zero.Add("a")
+		assert.Equal(t, []string{"a"}, zero.ToSlice())
+		assert.Equal(t, []string{"a"}, zero.Union(nil).ToSlice())
+		assert.Equal(t, 0, MultiSetOf[string](nil).Len())
+	})
+}
@@ -0,0 +1,93 @@
+package sliceutil
+
+import "strings"
+
+// NaturalLess reports whether a sorts before b in natural order, where runs of ASCII
+// digits are compared by their numeric value and everything else byte by byte, so
+// "file2" sorts before "file10". Numbers of any length are supported without overflow.
+// Numbers of equal value with different leading zeros, such as "01" and "1", are
+// ordered by the shorter one first, and strings that remain tied are ordered by byte
+// order, so the ordering is total. NaturalLess can be passed to SortBy or
+// MergeSlicesGeneric as the less function.
+//
+// Example:
+//
+//	NaturalLess("file2", "file10") // returns true
+//	NaturalLess("v1.10", "v1.9")   // returns false
+func NaturalLess(a, b string) bool {
+	return naturalCompare(a, b) < 0
+}
+
+// SortNatural sorts a string slice in place in ascending natural order.
+//
+// Example:
+//
+//	files := []string{"file10", "file2", "file1"}
+//	SortNatural(files) // files is now []string{"file1", "file2", "file10"}
+func SortNatural(s []string) {
+	SortBy(s, NaturalLess)
+}
+
+// IsSortedNatural checks if a string slice is sorted in ascending natural order.
+func IsSortedNatural(s []string) bool {
+	for i := 1; i < len(s); i++ {
+		if NaturalLess(s[i], s[i-1]) {
+			return false
+		}
+	}
+	return true
+}
+
+// naturalCompare is a helper function that compares two strings in natural order and
+// returns a negative number, zero, or a positive number.
+func naturalCompare(a, b string) int {
+	zeroTie := 0
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		if !isDigit(a[i]) || !isDigit(b[j]) {
+			if a[i] != b[j] {
+				return int(a[i]) - int(b[j])
+			}
+			i++
+			j++
+			continue
+		}
+
+		// Compare the digit runs at i and j by numeric value
+		endA, endB := digitRunEnd(a, i), digitRunEnd(b, j)
+		numA := strings.TrimLeft(a[i:endA], "0")
+		numB := strings.TrimLeft(b[j:endB], "0")
+		if len(numA) != len(numB) {
+			return len(numA) - len(numB)
+		}
+		if c := strings.Compare(numA, numB); c != 0 {
+			return c
+		}
+		if zeroTie == 0 {
+			zeroTie = (endA - i) - (endB - j)
+		}
+		i, j = endA, endB
+	}
+
+	if rest := (len(a) - i) - (len(b) - j); rest != 0 {
+		return rest
+	}
+	if zeroTie != 0 {
+		return zeroTie
+	}
+	return strings.Compare(a, b)
+}
+
+// digitRunEnd is a helper function that returns the index just past the run of
+// ASCII digits starting at i.
+func digitRunEnd(s string, i int) int {
+	for i < len(s) && isDigit(s[i]) {
+		i++
+	}
+	return i
+}
+
+// isDigit is a helper function that reports whether b is an ASCII digit.
+func isDigit(b byte) bool {
+	return '0' <= b && b <= '9'
+}
@@ -0,0 +1,74 @@
+package sliceutil
+
+import (
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestNaturalLess tests the NaturalLess function
+func TestNaturalLess(t *testing.T) {
+	t.Run("Numeric Runs", func(t *testing.T) {
+		assert.True(t, NaturalLess("file2", "file10"))
+		assert.False(t, NaturalLess("file10", "file2"))
+		assert.True(t, NaturalLess("v1.9", "v1.10"))
+		assert.True(t, NaturalLess("2", "10"))
+	})
+
+	t.Run("Text Runs", func(t *testing.T) {
+		assert.True(t, NaturalLess("a10", "b2"))
+		assert.True(t, NaturalLess("file", "file1"))
+		assert.True(t, NaturalLess("img12", "img12a"))
+		assert.True(t, NaturalLess("1", "a"))
+	})
+
+	t.Run("Large Numbers", func(t *testing.T) {
+		assert.True(t, NaturalLess("x99999999999999999999998", "x99999999999999999999999"))
+		assert.True(t, NaturalLess("x9", "x100000000000000000000000"))
+	})
+
+	t.Run("Leading Zeros And Ties", func(t *testing.T) {
+		assert.True(t, NaturalLess("1", "01"))
+		assert.False(t, NaturalLess("01", "1"))
+		assert.True(t, NaturalLess("a1b02", "a01b2"))
+		assert.True(t, NaturalLess("01", "2"))
+		assert.False(t, NaturalLess("same", "same"))
+	})
+}
+
+// TestSortNatural tests the SortNatural and IsSortedNatural functions
+func TestSortNatural(t *testing.T) {
+	t.Run("Sorts Files", func(t *testing.T) {
+		files := []string{"file10.txt", "file2.txt", "file1.txt", "File3.txt", "file02.txt"}
+		SortNatural(files)
+		assert.Equal(t, []string{"File3.txt", "file1.txt", "file2.txt", "file02.txt", "file10.txt"}, files)
+		assert.True(t, IsSortedNatural(files))
+	})
+
+	t.Run("Deterministic Regardless Of Input Order", func(t *testing.T) {
+		s := []string{"a1", "a01", "a001", "a10", "a2", "b", "", "a", "1", "01"}
+		expected := slices.Clone(s)
+		SortNatural(expected)
+
+		rng := rand.New(rand.NewPCG(3, 4))
+		for range 20 {
+			shuffled := ShuffleCopy(s, rng)
+			SortNatural(shuffled)
+			assert.Equal(t, expected, shuffled)
+		}
+	})
+
+	t.Run("With SortBy And MergeSlicesGeneric", func(t *testing.T) {
+		result := MergeSlicesGeneric([]string{"x10", "x1"}, []string{"x9"}, OrderDesc, NaturalLess)
+		assert.Equal(t, []string{"x10", "x9", "x1"}, result)
+	})
+
+	t.Run("IsSortedNatural", func(t *testing.T) {
+		assert.True(t, IsSortedNatural([]string{"a2", "a10"}))
+		assert.False(t, IsSortedNatural([]string{"a10", "a2"}))
+		assert.True(t, IsSortedNatural(nil))
+		assert.True(t, IsSortedNatural([]string{"only"}))
+	})
+}
@@ -0,0 +1,52 @@
+package sliceutil
+
+import "fmt"
+
+// Paginate returns the elements on the given page of a slice, together with the
+// PageInfo describing where that page sits in the whole slice. Pages are numbered from
+// 1 and hold perPage elements each, except for the last one which may be shorter.
+// The returned page is a view of s with its capacity clipped, so appending to it never
+// overwrites the following elements.
+//
+// An empty slice has zero pages, but its first page can still be requested and is
+// empty, so handlers do not need to special-case empty results.
+// The function returns an error wrapping ErrOutOfRange if page or perPage is less
+// than 1, or if page is past the last page.
+//
+// Time complexity: O(1)
+// Space complexity: O(1)
+//
+// Example:
+//
+//	items, info, err := Paginate([]int{1, 2, 3, 4, 5}, 2, 2)
+//	// items: [3 4]
+//	// info: {Page: 2, PerPage: 2, TotalItems: 5, TotalPages: 3, HasNext: true, HasPrev: true}
+func Paginate[T any](s []T, page, perPage int) ([]T, PageInfo, error) {
+	if perPage < 1 {
+		return nil, PageInfo{}, fmt.Errorf("%w: perPage must be positive, got %d", ErrOutOfRange, perPage)
+	}
+	if page < 1 {
+		return nil, PageInfo{}, fmt.Errorf("%w: page must be positive, got %d", ErrOutOfRange, page)
+	}
+
+	totalPages := len(s) / perPage
+	if len(s)%perPage != 0 {
+		totalPages++
+	}
+	if page > max(totalPages, 1) {
+		return nil, PageInfo{}, fmt.Errorf("%w: page %d of %d", ErrOutOfRange, page, totalPages)
+	}
+
+	info := PageInfo{
+		Page:       page,
+		PerPage:    perPage,
+		TotalItems: len(s),
+		TotalPages: totalPages,
+		HasNext:    page < totalPages,
+		HasPrev:    page > 1,
+	}
+
+	start := (page - 1) * perPage
+	end := start + min(perPage, len(s)-start)
+	return s[start:end:end], info, nil
+}
@@ -0,0 +1,71 @@
+package sliceutil
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestPaginate tests the Paginate function
+func TestPaginate(t *testing.T) {
+	s := []int{1, 2, 3, 4, 5}
+
+	t.Run("Middle Page", func(t *testing.T) {
+		items, info, err := Paginate(s, 2, 2)
+		require.NoError(t, err)
+		assert.Equal(t, []int{3, 4}, items)
+		assert.Equal(t, PageInfo{Page: 2, PerPage: 2, TotalItems: 5, TotalPages: 3, HasNext: true, HasPrev: true}, info)
+	})
+
+	t.Run("First And Last Page", func(t *testing.T) {
+		items, info, err := Paginate(s, 1, 2)
+		require.NoError(t, err)
+		assert.Equal(t, []int{1, 2}, items)
+		assert.True(t, info.HasNext)
+		assert.False(t, info.HasPrev)
+
+		items, info, err = Paginate(s, 3, 2)
+		require.NoError(t, err)
+		assert.Equal(t, []int{5}, items)
+		assert.False(t, info.HasNext)
+		assert.True(t, info.HasPrev)
+	})
+
+	t.Run("Exact Multiple", func(t *testing.T) {
+		items, info, err := Paginate(s, 1, 5)
+		require.NoError(t, err)
+		assert.Equal(t, s, items)
+		assert.Equal(t, 1, info.TotalPages)
+		assert.False(t, info.HasNext)
+	})
+
+	t.Run("Page Is Clipped", func(t *testing.T) {
+		data := []int{1, 2, 3, 4}
+		items, _, err := Paginate(data, 1, 2)
+		require.NoError(t, err)
+		_ = append(items, 99)
+		assert.Equal(t, []int{1, 2, 3, 4}, data)
+	})
+
+	t.Run("Out Of Range", func(t *testing.T) {
+		_, _, err := Paginate(s, 4, 2)
+		assert.ErrorIs(t, err, ErrOutOfRange)
+		_, _, err = Paginate(s, 0, 2)
+		assert.ErrorIs(t, err, ErrOutOfRange)
+		_, _, err = Paginate(s, 1, 0)
+		assert.ErrorIs(t, err, ErrOutOfRange)
+		_, _, err = Paginate(s, -1, -1)
+		assert.ErrorIs(t, err, ErrOutOfRange)
+	})
+
+	t.Run("Nil and Empty", func(t *testing.T) {
+		items, info, err := Paginate([]int{}, 1, 10)
+		require.NoError(t, err)
+		assert.Empty(t, items)
+		assert.Equal(t, PageInfo{Page: 1, PerPage: 10}, info)
+
+		_, _, err = Paginate[int](nil, 2, 10)
+		assert.ErrorIs(t, err, ErrOutOfRange)
+	})
+}
@@ -0,0 +1,248 @@
+package sliceutil
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+)
+
+// Pretty renders the result as indented, human-readable text for test failures and
+// logs: the message on the first line, followed by one line per detail, such as every
+// mismatched index with both values, differing lengths, or a table of differing struct
+// fields. An equal result renders as just its message. Details decoded from JSON or
+// built by hand are rendered too; entries that cannot be interpreted are printed as is.
+//
+// Example:
+//
+//	fmt.Println(CompareSlicesWithResult([]int{1, 2, 3}, []int{1, 5, 7}).Pretty())
+//	// Slices differ at specific indices
+//	//   [1]: 2 != 5
+//	//   [2]: 3 != 7
+func (r CompareResult) Pretty() string {
+	var b strings.Builder
+	b.WriteString(r.Message)
+	if r.Equal {
+		return b.String()
+	}
+
+	d := r.Details
+	line := func(format string, args ...interface{}) {
+		b.WriteString("\n  ")
+		fmt.Fprintf(&b, format, args...)
+	}
+
+	if d.ANil || d.BNil {
+		line("a is nil: %t, b is nil: %t", d.ANil, d.BNil)
+	}
+	if d.TypeA != "" || d.TypeB != "" {
+		line("type: %s != %s", d.TypeA, d.TypeB)
+	}
+	if d.LengthA != 0 || d.LengthB != 0 {
+		line("length: %d != %d", d.LengthA, d.LengthB)
+	}
+	if d.ErrorA != "" {
+		line("a: %s", d.ErrorA)
+	}
+	if d.ErrorB != "" {
+		line("b: %s", d.ErrorB)
+	}
+	if d.Outcome != "" {
+		line("sum: %v vs %v (difference %v): %s", d.SumA, d.SumB, d.SumDifference, d.Outcome)
+	}
+
+	// Element mismatches are stored with their element type, so read them by reflection
+	shown := 0
+	if mismatches := reflect.ValueOf(d.Extra["mismatches"]); mismatches.Kind() == reflect.Slice {
+		for i := 0; i < mismatches.Len(); i++ {
+			line("%s", formatMismatch(mismatches.Index(i)))
+		}
+		shown = mismatches.Len()
+	} else if len(d.DifferenceIndices) > 0 {
+		line("differing indices: %v", d.DifferenceIndices)
+		shown = len(d.DifferenceIndices)
+	}
+	if d.Truncated {
+		line("... and %d more differences", d.DifferenceCount-shown)
+	}
+
+	if counts := reflect.ValueOf(d.Extra["count_differences"]); counts.Kind() == reflect.Map {
+		for _, key := range sortedMapKeys(counts, counts) {
+			line("%s: %s occurrences in a", formatValue(interfaceOrNil(key)), formatCount(counts.MapIndex(key)))
+		}
+	}
+
+	if len(d.FieldDiffs) > 0 {
+		for _, row := range strings.Split(strings.TrimSuffix(FormatFieldDiffs(d.FieldDiffs), "\n"), "\n") {
+			line("%s", row)
+		}
+	}
+	return b.String()
+}
+
+// formatMismatch is a helper function that renders one entry of the "mismatches" detail
+// as "[index]: a != b". Entries are normally ElementDiff values, but results decoded
+// from JSON hold maps keyed by the JSON field names instead; any other entry is
+// printed as is.
+func formatMismatch(m reflect.Value) string {
+	for m.Kind() == reflect.Interface || m.Kind() == reflect.Pointer {
+		if m.IsNil() {
+			return "<nil>"
+		}
+		m = m.Elem()
+	}
+
+	var index, a, b reflect.Value
+	switch {
+	case m.Kind() == reflect.Struct:
+		index, a, b = m.FieldByName("Index"), m.FieldByName("AValue"), m.FieldByName("BValue")
+	case m.Kind() == reflect.Map && m.Type().Key().Kind() == reflect.String:
+		entry := func(key string) reflect.Value {
+			return m.MapIndex(reflect.ValueOf(key).Convert(m.Type().Key()))
+		}
+		index, a, b = entry("index"), entry("a_value"), entry("b_value")
+	}
+	if !index.IsValid() || !a.IsValid() || !b.IsValid() {
+		return formatValue(interfaceOrNil(m))
+	}
+	return fmt.Sprintf("[%v]: %s != %s", interfaceOrNil(index), formatValue(interfaceOrNil(a)), formatValue(interfaceOrNil(b)))
+}
+
+// formatCount is a helper function that renders an entry of the "count_differences"
+// detail with an explicit sign. Non-numeric entries are printed as is.
+func formatCount(v reflect.Value) string {
+	for v.Kind() == reflect.Interface && !v.IsNil() {
+		v = v.Elem()
+	}
+	switch {
+	case v.CanInt():
+		return fmt.Sprintf("%+d", v.Int())
+	case v.CanUint():
+		return fmt.Sprintf("+%d", v.Uint())
+	case v.CanFloat():
+		return fmt.Sprintf("%+g", v.Float())
+	}
+	return fmt.Sprint(interfaceOrNil(v))
+}
+
+// FormatFieldDiffs renders struct field differences side by side as an aligned table
+// with a header row and one row per difference, showing the path, the old and the new
+// value. Strings are quoted so that empty and blank values remain visible. The result
+// ends with a newline; it is empty if there are no differences.
+//
+// Example:
+//
+//	fmt.Print(FormatFieldDiffs(CompareStructsWithResult(before, after).Details.FieldDiffs))
+//	// PATH          OLD         NEW
+//	// Address.City  "New York"  "Boston"
+//	// Tags[1]       "b"         "c"
+func FormatFieldDiffs(diffs []FieldDiff) string {
+	if len(diffs) == 0 {
+		return ""
+	}
+
+	var b strings.Builder
+	w := tabwriter.NewWriter(&b, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "PATH\tOLD\tNEW")
+	for _, diff := range diffs {
+		path := diff.Path
+		if path == "" {
+			path = "(value)"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\n", path, formatValue(diff.Old), formatValue(diff.New))
+	}
+	_ = w.Flush()
+
+	// Drop the padding tabwriter leaves at the end of the last column
+	lines := strings.Split(strings.TrimSuffix(b.String(), "\n"), "\n")
+	for i := range lines {
+		lines[i] = strings.TrimRight(lines[i], " ")
+	}
+	return strings.Join(lines, "\n") + "\n"
+}
+
+// FormatUnifiedDiff renders an edit script from ComputeDiff in the unified diff format
+// used by diff -u and git: changes are grouped into hunks with up to context unchanged
+// elements around them, each hunk starts with a "@@ -start,count +start,count @@"
+// header with 1-based positions, and every element is written on its own line
+// prefixed with " " (kept), "-" (deleted) or "+" (inserted). The result is empty when
+// the script contains no changes. A negative context is treated as zero.
+//
+// Example:
+//
+//	ops := ComputeDiff([]string{"a", "b", "c"}, []string{"a", "c", "d"})
+//	fmt.Print(FormatUnifiedDiff(ops, 1))
+//	// @@ -1,3 +1,3 @@
+//	//  a
+//	// -b
+//	//  c
+//	// +d
+func FormatUnifiedDiff[T any](ops []EditOp[T], context int) string {
+	context = max(context, 0)
+
+	var changes []int
+	for i, op := range ops {
+		if op.Kind != EditKeep {
+			changes = append(changes, i)
+		}
+	}
+
+	var b strings.Builder
+	for len(changes) > 0 {
+		// Extend the hunk while the next change is close enough to share context
+		last := 0
+		for last+1 < len(changes) && changes[last+1]-changes[last] <= 2*context+1 {
+			last++
+		}
+		start := max(changes[0]-context, 0)
+		end := min(changes[last]+context+1, len(ops))
+		writeHunk(&b, ops[start:end])
+		changes = changes[last+1:]
+	}
+	return b.String()
+}
+
+// writeHunk is a helper function that writes a single unified diff hunk.
+func writeHunk[T any](b *strings.Builder, ops []EditOp[T]) {
+	countA, countB := 0, 0
+	for _, op := range ops {
+		if op.Kind != EditInsert {
+			countA++
+		}
+		if op.Kind != EditDelete {
+			countB++
+		}
+	}
+
+	// An empty range starts at the line before it, as in diff -u
+	startA, startB := ops[0].AIndex, ops[0].BIndex
+	if countA > 0 {
+		startA++
+	}
+	if countB > 0 {
+		startB++
+	}
+	fmt.Fprintf(b, "@@ -%d,%d +%d,%d @@\n", startA, countA, startB, countB)
+
+	for _, op := range ops {
+		switch op.Kind {
+		case EditDelete:
+			b.WriteByte('-')
+		case EditInsert:
+			b.WriteByte('+')
+		default:
+			b.WriteByte(' ')
+		}
+		fmt.Fprintln(b, op.Value)
+	}
+}
+
+// formatValue is a helper function that formats a value for diff output, quoting
+// strings so that empty strings and surrounding whitespace remain visible.
+func formatValue(v interface{}) string {
+	if s, ok := v.(string); ok {
+		return strconv.Quote(s)
+	}
+	return fmt.Sprint(v)
+}
@@ -0,0 +1,161 @@
+package sliceutil
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestCompareResultPretty tests the CompareResult.Pretty method
+func TestCompareResultPretty(t *testing.T) {
+	t.Run("Equal", func(t *testing.T) {
+		assert.Equal(t, "Slices are equal", CompareSlicesWithResult([]int{1}, []int{1}).Pretty())
+	})
+
+	t.Run("Mismatched Indices", func(t *testing.T) {
+		result := CompareSlicesWithResult([]string{"a", "b", "c"}, []string{"a", "x", ""})
+		assert.Equal(t, "Slices differ at specific indices\n"+
+			"  [1]: \"b\" != \"x\"\n"+
+			"  [2]: \"c\" != \"\"", result.Pretty())
+	})
+
+	t.Run("Truncated", func(t *testing.T) {
+		result := CompareSlicesWithResultMax(Range(0, 10), Repeat(-1, 10), 2)
+		assert.Equal(t, "Slices differ at specific indices\n"+
+			"  [0]: 0 != -1\n"+
+			"  [1]: 1 != -1\n"+
+			"  ... and 8 more differences", result.Pretty())
+	})
+
+	t.Run("Lengths And Nil", func(t *testing.T) {
+		assert.Equal(t, "Slices have different lengths\n  length: 1 != 2",
+			CompareSlicesWithResult([]int{1}, []int{1, 2}).Pretty())
+		assert.Equal(t, "One slice is nil while the other is not\n  a is nil: true, b is nil: false",
+			CompareSlicesWithResult(nil, []int{}).Pretty())
+	})
+
+	t.Run("Count Differences", func(t *testing.T) {
+		result := CompareSlicesUnorderedWithResult([]string{"a", "a", "b"}, []string{"a", "c", "b"})
+		assert.Equal(t, "Slices contain different element counts\n"+
+			"  length: 3 != 3\n"+
+			"  \"a\": +1 occurrences in a\n"+
+			"  \"c\": -1 occurrences in a", result.Pretty())
+	})
+
+	t.Run("Struct Fields", func(t *testing.T) {
+		type Address struct {
+			City string
+		}
+		type Person struct {
+			Name    string
+			Age     int
+			Address Address
+		}
+		result := CompareStructsWithResult(
+			Person{Name: "Alice", Age: 30, Address: Address{City: "New York"}},
+			Person{Name: "Alice", Age: 31, Address: Address{City: "Boston"}},
+		)
+		assert.Equal(t, "Structs differ in specific fields\n"+
+			"  PATH          OLD         NEW\n"+
+			"  Age           30          31\n"+
+			"  Address.City  \"New York\"  \"Boston\"", result.Pretty())
+	})
+
+	t.Run("Decoded From JSON", func(t *testing.T) {
+		for _, result := range []CompareResult{
+			CompareSlicesWithResult([]string{"a", "b"}, []string{"a", "x"}),
+			CompareSlicesUnorderedWithResult([]string{"a", "a"}, []string{"a", "b"}),
+		} {
+			data, err := json.Marshal(result)
+			require.NoError(t, err)
+			var decoded CompareResult
+			require.NoError(t, json.Unmarshal(data, &decoded))
+			assert.Equal(t, result.Pretty(), decoded.Pretty())
+		}
+	})
+
+	t.Run("Hand-Built Details", func(t *testing.T) {
+		result := CompareResult{Message: "custom", Details: CompareDetails{Extra: map[string]interface{}{
+			"mismatches":        []interface{}{7, nil, map[string]int{"index": 1}, struct{ Index string }{"x"}},
+			"count_differences": map[string]interface{}{"a": "many", "b": uint(2), "c": nil},
+		}}}
+		assert.NotPanics(t, func() {
+			assert.Equal(t, "custom\n"+
+				"  7\n"+
+				"  <nil>\n"+
+				"  map[index:1]\n"+
+				"  {x}\n"+
+				"  \"a\": many occurrences in a\n"+
+				"  \"b\": +2 occurrences in a\n"+
+				"  \"c\": <nil> occurrences in a", result.Pretty())
+		})
+	})
+
+	t.Run("Sums", func(t *testing.T) {
+		result := CompareSumWithDetails([]int{1, 2}, []int{1})
+		assert.Contains(t, result.Pretty(), "sum: 3 vs 1 (difference 2)")
+	})
+}
+
+// TestFormatFieldDiffs tests the FormatFieldDiffs function
+func TestFormatFieldDiffs(t *testing.T) {
+	t.Run("Aligned Columns", func(t *testing.T) {
+		diffs := []FieldDiff{
+			{Path: "Name", Old: "Al", New: "Alice"},
+			{Path: "Tags[10]", Old: nil, New: 3},
+			{Path: "", Old: 1.5, New: 2},
+		}
+		assert.Equal(t, ""+
+			"PATH      OLD    NEW\n"+
+			"Name      \"Al\"   \"Alice\"\n"+
+			"Tags[10]  <nil>  3\n"+
+			"(value)   1.5    2\n", FormatFieldDiffs(diffs))
+	})
+
+	t.Run("Nil and Empty", func(t *testing.T) {
+		assert.Equal(t, "", FormatFieldDiffs(nil))
+	})
+}
+
+// TestFormatUnifiedDiff tests the FormatUnifiedDiff function
+func TestFormatUnifiedDiff(t *testing.T) {
+	t.Run("Single Hunk", func(t *testing.T) {
+		ops := ComputeDiff([]string{"a", "b", "c"}, []string{"a", "c", "d"})
+		assert.Equal(t, "@@ -1,3 +1,3 @@\n a\n-b\n c\n+d\n", FormatUnifiedDiff(ops, 1))
+	})
+
+	t.Run("Separate Hunks", func(t *testing.T) {
+		a := Range(1, 21)
+		b := append([]int{}, a...)
+		b[1] = 100
+		b[17] = 200
+		got := FormatUnifiedDiff(ComputeDiff(a, b), 2)
+		assert.Equal(t, ""+
+			"@@ -1,4 +1,4 @@\n 1\n-2\n+100\n 3\n 4\n"+
+			"@@ -16,5 +16,5 @@\n 16\n 17\n-18\n+200\n 19\n 20\n", got)
+
+		// With more context the hunks merge
+		assert.Equal(t, 1, strings.Count(FormatUnifiedDiff(ComputeDiff(a, b), 8), "@@ -"))
+	})
+
+	t.Run("Insert Into Empty", func(t *testing.T) {
+		ops := ComputeDiff([]int{}, []int{1, 2})
+		assert.Equal(t, "@@ -0,0 +1,2 @@\n+1\n+2\n", FormatUnifiedDiff(ops, 3))
+
+		ops = ComputeDiff([]int{1}, []int{})
+		assert.Equal(t, "@@ -1,1 +0,0 @@\n-1\n", FormatUnifiedDiff(ops, 3))
+	})
+
+	t.Run("Zero Context", func(t *testing.T) {
+		ops := ComputeDiff([]int{1, 2, 3}, []int{1, 3})
+		assert.Equal(t, "@@ -2,1 +1,0 @@\n-2\n", FormatUnifiedDiff(ops, -1))
+	})
+
+	t.Run("Nil and Empty", func(t *testing.T) {
+		assert.Equal(t, "", FormatUnifiedDiff[int](nil, 3))
+		assert.Equal(t, "", FormatUnifiedDiff(ComputeDiff([]int{1}, []int{1}), 3))
+	})
+}
@@ -0,0 +1,132 @@
+package sliceutil
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+)
+
+// MarshalJSON encodes the result with stable, snake_case field names. Details that
+// were not set are omitted, so an equal result encodes as just its equality and message.
+// Numeric details are encoded as described for CompareDetails.MarshalJSON.
+// Values in Details.Extra, FieldDiff values and ElementDiff values are encoded with
+// encoding/json, so they must be JSON-encodable; for example, a count_differences map
+// keyed by a struct type cannot be encoded.
+//
+// Example:
+//
+//	data, err := json.Marshal(CompareSlicesWithResult([]int{1, 2}, []int{1, 3}))
+//	// {"equal":false,"message":"Slices differ at specific indices",
+//	//  "details":{"difference_indices":[1],"difference_count":1,"extra":{...}}}
+func (r CompareResult) MarshalJSON() ([]byte, error) {
+	// compareResultJSON has the same fields but no methods, which avoids recursing
+	type compareResultJSON CompareResult
+	return json.Marshal(compareResultJSON(r))
+}
+
+// MarshalJSON encodes the details, omitting those that were not set. Numeric details
+// are encoded in groups whenever their comparison set them, even when a value is zero:
+// length_a and length_b for a length mismatch, sum_a, sum_b and sum_difference for a
+// sum comparison, and difference_count for a positional comparison. A length of 0 or
+// a sum of 0 is therefore never dropped.
+func (d CompareDetails) MarshalJSON() ([]byte, error) {
+	// compareDetailsJSON has the same fields but no methods, which avoids recursing
+	type compareDetailsJSON CompareDetails
+	aux := struct {
+		compareDetailsJSON
+		// The fields below shadow the numeric fields of the embedded details
+		LengthA         *int     `json:"length_a,omitempty"`
+		LengthB         *int     `json:"length_b,omitempty"`
+		DifferenceCount *int     `json:"difference_count,omitempty"`
+		SumA            *float64 `json:"sum_a,omitempty"`
+		SumB            *float64 `json:"sum_b,omitempty"`
+		SumDifference   *float64 `json:"sum_difference,omitempty"`
+	}{compareDetailsJSON: compareDetailsJSON(d)}
+
+	if d.LengthA != 0 || d.LengthB != 0 {
+		aux.LengthA, aux.LengthB = &d.LengthA, &d.LengthB
+	}
+	if d.DifferenceIndices != nil || d.DifferenceCount != 0 {
+		aux.DifferenceCount = &d.DifferenceCount
+	}
+	if d.Outcome != "" {
+		aux.SumA, aux.SumB, aux.SumDifference = &d.SumA, &d.SumB, &d.SumDifference
+	}
+	return json.Marshal(aux)
+}
+
+// String returns the JSON encoding of the result, which makes it suitable for logging.
+// If the result cannot be encoded, String falls back to the message and equality.
+func (r CompareResult) String() string {
+	data, err := r.MarshalJSON()
+	if err != nil {
+		return fmt.Sprintf("%s (equal: %t)", r.Message, r.Equal)
+	}
+	return string(data)
+}
+
+// Map returns the details as a map keyed by the names used before CompareDetails was
+// introduced, such as "difference_count", "differences" and "sum_a". It exists for
+// backward compatibility; prefer the typed fields in new code. Only keys for details
+// that were set are present, and entries from Extra are copied in unchanged. As before,
+// "sum_a", "sum_b" and "difference" hold ints when the sums are integral; fractional,
+// NaN, infinite or out-of-range sums are kept as float64.
+//
+// Example:
+//
+//	count := result.Details.Map()["difference_count"].(int)
+func (d CompareDetails) Map() map[string]interface{} {
+	m := make(map[string]interface{}, len(d.Extra))
+	if d.ANil || d.BNil {
+		m["a_nil"] = d.ANil
+		m["b_nil"] = d.BNil
+	}
+	if d.LengthA != 0 || d.LengthB != 0 {
+		m["length_a"] = d.LengthA
+		m["length_b"] = d.LengthB
+	}
+	if d.DifferenceIndices != nil {
+		m["differences"] = d.DifferenceIndices
+	}
+	if d.DifferenceCount != 0 {
+		m["difference_count"] = d.DifferenceCount
+	}
+	if d.Truncated {
+		m["truncated"] = true
+	}
+	if d.FieldDiffs != nil {
+		m["field_diffs"] = d.FieldDiffs
+	}
+	if d.TypeA != "" || d.TypeB != "" {
+		m["type_a"] = d.TypeA
+		m["type_b"] = d.TypeB
+	}
+	if d.Outcome != "" {
+		m["sum_a"] = legacySum(d.SumA)
+		m["sum_b"] = legacySum(d.SumB)
+		m["difference"] = legacySum(d.SumDifference)
+		m["result"] = d.Outcome
+	}
+	if d.NilPolicy != "" {
+		m["nil_policy"] = d.NilPolicy
+	}
+	if d.ErrorA != "" {
+		m["error_a"] = d.ErrorA
+	}
+	if d.ErrorB != "" {
+		m["error_b"] = d.ErrorB
+	}
+	for k, v := range d.Extra {
+		m[k] = v
+	}
+	return m
+}
+
+// legacySum is a helper function that returns a sum as an int, the type the legacy map
+// keys held, when it converts exactly, and as the float64 otherwise.
+func legacySum(x float64) interface{} {
+	if x == math.Trunc(x) && x >= math.MinInt && x < -math.MinInt {
+		return int(x)
+	}
+	return x
+}
@@ -0,0 +1,169 @@
+package sliceutil
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestCompareResultMarshalJSON tests the CompareResult.MarshalJSON method
+func TestCompareResultMarshalJSON(t *testing.T) {
+	t.Run("Equal Result Omits Details", func(t *testing.T) {
+		data, err := json.Marshal(CompareSlicesWithResult([]int{1, 2}, []int{1, 2}))
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"equal":true,"message":"Slices are equal"}`, string(data))
+	})
+
+	t.Run("Slice Differences", func(t *testing.T) {
+		data, err := json.Marshal(CompareSlicesWithResult([]int{1, 2, 3}, []int{1, 5, 3}))
+		require.NoError(t, err)
+		assert.JSONEq(t, `{
+			"equal": false,
+			"message": "Slices differ at specific indices",
+			"details": {
+				"difference_indices": [1],
+				"difference_count": 1,
+				"extra": {"mismatches": [{"index": 1, "a_value": 2, "b_value": 5}]}
+			}
+		}`, string(data))
+	})
+
+	t.Run("Length And Nil Details", func(t *testing.T) {
+		data, err := json.Marshal(CompareSlicesWithResult([]int{1, 2, 3}, []int{1}))
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"equal":false,"message":"Slices have different lengths",
+			"details":{"length_a":3,"length_b":1}}`, string(data))
+
+		data, err = json.Marshal(CompareSlicesWithResult(nil, []int{1}))
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"equal":false,"message":"One slice is nil while the other is not",
+			"details":{"a_nil":true}}`, string(data))
+	})
+
+	t.Run("Zero Numeric Details", func(t *testing.T) {
+		data, err := json.Marshal(CompareSlicesWithResult([]int{}, []int{1}))
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"equal":false,"message":"Slices have different lengths",
+			"details":{"length_a":0,"length_b":1}}`, string(data))
+
+		data, err = json.Marshal(CompareSumWithDetails([]int{-1, 1}, []int{2}).Details)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"sum_a":0,"sum_b":2,"sum_difference":-2,"outcome":"b is greater"}`, string(data))
+	})
+
+	t.Run("Struct Field Diffs", func(t *testing.T) {
+		type address struct{ City string }
+		type person struct {
+			Name    string
+			Address address
+		}
+		a := person{Name: "Ann", Address: address{City: "New York"}}
+		b := person{Name: "Ann", Address: address{City: "Boston"}}
+
+		data, err := json.Marshal(CompareStructsWithResult(a, b))
+		require.NoError(t, err)
+		assert.JSONEq(t, `{
+			"equal": false,
+			"message": "Structs differ in specific fields",
+			"details": {
+				"difference_count": 1,
+				"field_diffs": [{"path": "Address.City", "old": "New York", "new": "Boston", "kind": "STRUCT"}]
+			}
+		}`, string(data))
+	})
+
+	t.Run("Pointer Result", func(t *testing.T) {
+		result := CompareSlicesWithResult([]int{1}, []int{1})
+		data, err := json.Marshal(&result)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"equal":true,"message":"Slices are equal"}`, string(data))
+	})
+
+	t.Run("Unencodable Extra", func(t *testing.T) {
+		type key struct{ ID int }
+		result := CompareSlicesUnorderedWithResult([]key{{1}}, []key{{2}})
+		_, err := json.Marshal(result)
+		assert.Error(t, err)
+	})
+}
+
+// TestCompareResultString tests the CompareResult.String method
+func TestCompareResultString(t *testing.T) {
+	t.Run("JSON Encoding", func(t *testing.T) {
+		result := CompareSlicesWithResult([]int{1}, []int{1})
+		assert.Equal(t, `{"equal":true,"message":"Slices are equal"}`, result.String())
+		assert.Equal(t, result.String(), fmt.Sprint(result))
+	})
+
+	t.Run("Fallback", func(t *testing.T) {
+		type key struct{ ID int }
+		result := CompareSlicesUnorderedWithResult([]key{{1}}, []key{{2}})
+		assert.Equal(t, "Slices contain different element counts (equal: false)", result.String())
+	})
+}
+
+// TestCompareDetailsMap tests the CompareDetails.Map method
+func TestCompareDetailsMap(t *testing.T) {
+	t.Run("Slice Differences", func(t *testing.T) {
+		result := CompareSlicesWithResultMax([]int{1, 2, 3}, []int{0, 0, 0}, 2)
+		m := result.Details.Map()
+
+		assert.Equal(t, 3, m["difference_count"].(int))
+		assert.Equal(t, []int{0, 1}, m["differences"])
+		assert.Equal(t, true, m["truncated"])
+		assert.Len(t, m["mismatches"], 2)
+		assert.NotContains(t, m, "a_nil")
+		assert.NotContains(t, m, "length_a")
+	})
+
+	t.Run("Nil And Length", func(t *testing.T) {
+		m := CompareSlicesWithResult(nil, []int{1}).Details.Map()
+		assert.Equal(t, map[string]interface{}{"a_nil": true, "b_nil": false}, m)
+
+		m = CompareSlicesWithResult([]int{}, []int{1}).Details.Map()
+		assert.Equal(t, map[string]interface{}{"length_a": 0, "length_b": 1}, m)
+	})
+
+	t.Run("Sum Comparison", func(t *testing.T) {
+		m := CompareSumWithDetails([]int{1, 2}, []int{1, 2}).Details.Map()
+		assert.Equal(t, map[string]interface{}{
+			"sum_a":      3,
+			"sum_b":      3,
+			"difference": 0,
+			"result":     ResultEqual,
+		}, m)
+
+		m = CompareSumWithDetails(nil, []int{1}).Details.Map()
+		assert.Equal(t, NilAsZero, m["nil_policy"])
+	})
+
+	t.Run("Fractional And Non-Finite Sums", func(t *testing.T) {
+		m := CompareSumWithDetails([]float64{0.5}, []float64{0.2}).Details.Map()
+		assert.Equal(t, 0.5, m["sum_a"])
+		assert.Equal(t, 0.2, m["sum_b"])
+		assert.InDelta(t, 0.3, m["difference"], 1e-12)
+		assert.Equal(t, ResultAGreater, m["result"])
+
+		m = CompareSumWithDetails([]float64{2}, []float64{1e300}).Details.Map()
+		assert.Equal(t, 2, m["sum_a"])
+		assert.Equal(t, 1e300, m["sum_b"])
+
+		m = CompareSumWithDetails([]float64{math.Inf(1)}, []float64{1}).Details.Map()
+		assert.Equal(t, math.Inf(1), m["sum_a"])
+		assert.Equal(t, 1, m["sum_b"])
+	})
+
+	t.Run("Struct Types", func(t *testing.T) {
+		m := CompareStructsWithResult(struct{ A int }{1}, struct{ B int }{1}).Details.Map()
+		assert.Equal(t, "struct { A int }", m["type_a"])
+		assert.Equal(t, "struct { B int }", m["type_b"])
+	})
+
+	t.Run("Equal Result", func(t *testing.T) {
+		assert.Empty(t, CompareSlicesWithResult([]int{1}, []int{1}).Details.Map())
+	})
+}
@@ -0,0 +1,106 @@
+package sliceutil
+
+import (
+	"fmt"
+	"sync"
+)
+
+// SafeSlice is a slice guarded by a mutex, so concurrent producers can append to it
+// without external locking. Consumers take a Snapshot, which is an independent copy
+// that can be passed to the other functions in this package.
+// The zero value is an empty SafeSlice ready to use. A SafeSlice must not be copied
+// after first use.
+type SafeSlice[T comparable] struct {
+	mu    sync.RWMutex
+	items []T
+}
+
+// NewSafeSlice creates a SafeSlice holding a copy of the given values.
+//
+// Example:
+//
+//	results := NewSafeSlice[int]()
+//	var wg sync.WaitGroup
+//	for _, job := range jobs {
+//		wg.Add(1)
+//		go func() {
+//			defer wg.Done()
+//			results.Append(process(job))
+//		}()
+//	}
+//	wg.Wait()
+//	equal := CompareSlicesUnordered(results.Snapshot(), expected)
+func NewSafeSlice[T comparable](values ...T) *SafeSlice[T] {
+	return &SafeSlice[T]{items: append([]T{}, values...)}
+}
+
+// Append adds values to the end of the slice.
+func (s *SafeSlice[T]) Append(values ...T) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.items = append(s.items, values...)
+}
+
+// Get returns the element at index i.
+// The function returns ErrOutOfRange if i is outside the slice.
+func (s *SafeSlice[T]) Get(i int) (T, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if i < 0 || i >= len(s.items) {
+		var zero T
+		return zero, fmt.Errorf("%w: index %d with length %d", ErrOutOfRange, i, len(s.items))
+	}
+	return s.items[i], nil
+}
+
+// Len returns the number of elements in the slice.
+func (s *SafeSlice[T]) Len() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return len(s.items)
+}
+
+// Snapshot returns a copy of the current elements. The copy is never nil and is not
+// affected by later appends.
+func (s *SafeSlice[T]) Snapshot() []T {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return append([]T{}, s.items...)
+}
+
+// Contains reports whether the slice holds the given value.
+func (s *SafeSlice[T]) Contains(v T) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	for _, item := range s.items {
+		if item == v {
+			return true
+		}
+	}
+	return false
+}
+
+// Stats returns statistics about the current elements. Length, DistinctCount and
+// HasDuplicates are always set; for a SafeSlice[int] all fields are filled in as
+// by GetSliceStats.
+func (s *SafeSlice[T]) Stats() SliceStats {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if ints, ok := any(s.items).([]int); ok && ints != nil {
+		stats, _ := GetSliceStats(ints)
+		return stats
+	}
+
+	seen := make(map[T]struct{}, len(s.items))
+	for _, item := range s.items {
+		seen[item] = struct{}{}
+	}
+	return SliceStats{
+		Length:        len(s.items),
+		DistinctCount: len(seen),
+		HasDuplicates: len(seen) < len(s.items),
+	}
+}
@@ -0,0 +1,90 @@
+package sliceutil
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestSafeSlice tests the SafeSlice type
+func TestSafeSlice(t *testing.T) {
+	t.Run("Append and Get", func(t *testing.T) {
+		s := NewSafeSlice("a")
+		s.Append("b", "c")
+
+		assert.Equal(t, 3, s.Len())
+		v, err := s.Get(1)
+		require.NoError(t, err)
+		assert.Equal(t, "b", v)
+	})
+
+	t.Run("Get Out Of Range", func(t *testing.T) {
+		s := NewSafeSlice(1, 2)
+		_, err := s.Get(2)
+		assert.ErrorIs(t, err, ErrOutOfRange)
+		_, err = s.Get(-1)
+		assert.ErrorIs(t, err, ErrOutOfRange)
+	})
+
+	t.Run("Snapshot Is Independent", func(t *testing.T) {
+		initial := []int{1, 2}
+		s := NewSafeSlice(initial...)
+		initial[0] = 99
+
+		snapshot := s.Snapshot()
+		snapshot[1] = 42
+		s.Append(3)
+
+		assert.Equal(t, []int{1, 42}, snapshot)
+		assert.Equal(t, []int{1, 2, 3}, s.Snapshot())
+	})
+
+	t.Run("Contains", func(t *testing.T) {
+		s := NewSafeSlice(1, 2, 3)
+		assert.True(t, s.Contains(2))
+		assert.False(t, s.Contains(4))
+	})
+
+	t.Run("Stats", func(t *testing.T) {
+		ints := NewSafeSlice(-2, 0, 3, 3)
+		stats := ints.Stats()
+		assert.Equal(t, 4, stats.Length)
+		assert.Equal(t, -2, stats.Min)
+		assert.Equal(t, 3, stats.Max)
+		assert.Equal(t, 4, stats.Sum)
+		assert.Equal(t, 3, stats.DistinctCount)
+		assert.True(t, stats.HasDuplicates)
+
+		words := NewSafeSlice("a", "b")
+		assert.Equal(t, SliceStats{Length: 2, DistinctCount: 2}, words.Stats())
+	})
+
+	t.Run("Zero Value", func(t *testing.T) {
+		var s SafeSlice[int]
+		assert.Equal(t, 0, s.Len())
+		assert.Equal(t, []int{}, s.Snapshot())
+		assert.Equal(t, SliceStats{}, s.Stats())
+		s.Append(1)
+		assert.Equal(t, []int{1}, s.Snapshot())
+	})
+
+	t.Run("Concurrent Appends", func(t *testing.T) {
+		s := NewSafeSlice[int]()
+		var wg sync.WaitGroup
+		for i := 0; i < 50; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				s.Append(i)
+				s.Contains(i)
+				s.Stats()
+			}(i)
+		}
+		wg.Wait()
+
+		assert.Equal(t, 50, s.Len())
+		assert.True(t, CompareSlicesUnordered(Range(0, 50), s.Snapshot()))
+	})
+}
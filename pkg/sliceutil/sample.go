@@ -0,0 +1,167 @@
+package sliceutil
+
+import (
+	"cmp"
+	"iter"
+	"math"
+	"math/rand/v2"
+	"slices"
+)
+
+// Shuffle randomly permutes the elements of a slice in place using the Fisher-Yates
+// algorithm. Pass a seeded rng for reproducible results, or nil to use the global
+// random source.
+//
+// Time complexity: O(n) where n is the length of the slice
+// Space complexity: O(1)
+//
+// Example:
+//
+//	rng := rand.New(rand.NewPCG(1, 2))
+//	Shuffle(deck, rng)
+func Shuffle[T any](a []T, rng *rand.Rand) {
+	for i := len(a) - 1; i > 0; i-- {
+		j := randIntN(rng, i+1)
+		a[i], a[j] = a[j], a[i]
+	}
+}
+
+// ShuffleCopy returns a randomly permuted copy of a slice without modifying the original.
+func ShuffleCopy[T any](a []T, rng *rand.Rand) []T {
+	if a == nil {
+		return nil
+	}
+
+	result := make([]T, len(a))
+	copy(result, a)
+	Shuffle(result, rng)
+	return result
+}
+
+// Sample returns n elements chosen uniformly at random from a slice, without replacement.
+// Every element is picked at most once, and the order of the result is random.
+// If n exceeds the length of the slice, all elements are returned in random order;
+// if n is not positive, the result is empty. The original slice is not modified.
+//
+// Time complexity: O(len(a)) for the working copy
+// Space complexity: O(len(a))
+//
+// Example:
+//
+//	winners := Sample(entrants, 3, rand.New(rand.NewPCG(42, 0)))
+func Sample[T any](a []T, n int, rng *rand.Rand) []T {
+	if a == nil {
+		return nil
+	}
+	n = min(max(n, 0), len(a))
+
+	// Partial Fisher-Yates: only the first n positions need to be settled
+	pool := make([]T, len(a))
+	copy(pool, a)
+	for i := 0; i < n; i++ {
+		j := i + randIntN(rng, len(pool)-i)
+		pool[i], pool[j] = pool[j], pool[i]
+	}
+
+	return pool[:n:n]
+}
+
+// ReservoirSample returns n elements chosen uniformly at random from a sequence whose
+// length is not known in advance, reading it only once (Algorithm R). This makes it
+// suitable for streaming inputs that do not fit in memory. If the sequence yields fewer
+// than n elements, all of them are returned. If n is not positive, the result is empty
+// and the sequence is not consumed.
+//
+// Time complexity: O(m) where m is the number of elements in the sequence
+// Space complexity: O(n) for the reservoir
+//
+// Example:
+//
+//	lines := ReservoirSample(readLines(file), 100, rand.New(rand.NewPCG(1, 1)))
+func ReservoirSample[T any](seq iter.Seq[T], n int, rng *rand.Rand) []T {
+	if n <= 0 {
+		return []T{}
+	}
+
+	reservoir := make([]T, 0, n)
+	seen := 0
+	for v := range seq {
+		seen++
+		if len(reservoir) < n {
+			reservoir = append(reservoir, v)
+			continue
+		}
+		// Replace a random element with probability n/seen
+		if j := randIntN(rng, seen); j < n {
+			reservoir[j] = v
+		}
+	}
+
+	return reservoir
+}
+
+// WeightedSample returns up to n elements chosen at random without replacement, where
+// the chance of picking an element is proportional to its weight. Elements with a
+// weight that is zero, negative or NaN are never picked, so fewer than n elements are
+// returned when not enough elements have a positive weight. The result is in selection
+// order, so its first element is a weighted random choice among all elements.
+// If n is not positive, the result is empty. The original slice is not modified.
+//
+// The sample is drawn in a single pass by giving every element a random key scaled by
+// its weight and keeping the n largest keys (Efraimidis-Spirakis).
+//
+// Time complexity: O(len(a) * log(len(a))) for ranking the keys
+// Space complexity: O(len(a))
+//
+// Example:
+//
+//	ads := WeightedSample(candidates, 3, func(a Ad) float64 { return a.Bid }, rand.New(rand.NewPCG(1, 2)))
+func WeightedSample[T any](a []T, n int, weight func(T) float64, rng *rand.Rand) []T {
+	if n <= 0 {
+		return []T{}
+	}
+
+	type keyed struct {
+		value T
+		key   float64
+	}
+	candidates := make([]keyed, 0, len(a))
+	for _, v := range a {
+		w := weight(v)
+		if !(w > 0) {
+			continue
+		}
+		// log(u)/w orders elements like u^(1/w) without underflowing for large weights;
+		// 1-u lies in (0, 1], so the logarithm is finite
+		u := 1 - randFloat64(rng)
+		candidates = append(candidates, keyed{value: v, key: math.Log(u) / w})
+	}
+
+	slices.SortFunc(candidates, func(x, y keyed) int {
+		return cmp.Compare(y.key, x.key)
+	})
+
+	result := make([]T, min(n, len(candidates)))
+	for i := range result {
+		result[i] = candidates[i].value
+	}
+	return result
+}
+
+// randFloat64 is a helper function that returns a random float64 in [0, 1) from rng,
+// falling back to the global random source when rng is nil.
+func randFloat64(rng *rand.Rand) float64 {
+	if rng == nil {
+		return rand.Float64()
+	}
+	return rng.Float64()
+}
+
+// randIntN is a helper function that returns a random int in [0, n) from rng,
+// falling back to the global random source when rng is nil.
+func randIntN(rng *rand.Rand, n int) int {
+	if rng == nil {
+		return rand.IntN(n)
+	}
+	return rng.IntN(n)
+}
@@ -0,0 +1,168 @@
+package sliceutil
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestShuffle tests the Shuffle and ShuffleCopy functions
+func TestShuffle(t *testing.T) {
+	t.Run("Deterministic With Seeded Source", func(t *testing.T) {
+		a := []int{1, 2, 3, 4, 5, 6, 7, 8}
+		b := []int{1, 2, 3, 4, 5, 6, 7, 8}
+		Shuffle(a, rand.New(rand.NewPCG(1, 2)))
+		Shuffle(b, rand.New(rand.NewPCG(1, 2)))
+
+		assert.Equal(t, a, b)
+		assert.ElementsMatch(t, []int{1, 2, 3, 4, 5, 6, 7, 8}, a)
+	})
+
+	t.Run("Copy Leaves Original Unchanged", func(t *testing.T) {
+		original := []int{1, 2, 3, 4, 5}
+		result := ShuffleCopy(original, rand.New(rand.NewPCG(3, 4)))
+
+		assert.Equal(t, []int{1, 2, 3, 4, 5}, original)
+		assert.ElementsMatch(t, original, result)
+	})
+
+	t.Run("Global Source", func(t *testing.T) {
+		a := []int{1, 2, 3}
+		Shuffle(a, nil)
+		assert.ElementsMatch(t, []int{1, 2, 3}, a)
+	})
+
+	t.Run("Nil and Empty", func(t *testing.T) {
+		Shuffle[int](nil, nil) // Should not panic
+		assert.Nil(t, ShuffleCopy[int](nil, nil))
+		assert.Empty(t, ShuffleCopy([]int{}, nil))
+	})
+}
+
+// TestSample tests the Sample function
+func TestSample(t *testing.T) {
+	s := []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+
+	t.Run("Without Replacement", func(t *testing.T) {
+		result := Sample(s, 4, rand.New(rand.NewPCG(5, 6)))
+
+		assert.Len(t, result, 4)
+		assert.Len(t, RemoveDuplicates(result), 4)
+		assert.Subset(t, s, result)
+		assert.Equal(t, []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}, s) // Original unchanged
+	})
+
+	t.Run("Deterministic With Seeded Source", func(t *testing.T) {
+		a := Sample(s, 3, rand.New(rand.NewPCG(7, 8)))
+		b := Sample(s, 3, rand.New(rand.NewPCG(7, 8)))
+		assert.Equal(t, a, b)
+	})
+
+	t.Run("Every Element Can Be Chosen", func(t *testing.T) {
+		rng := rand.New(rand.NewPCG(9, 10))
+		chosen := make(map[int]bool)
+		for i := 0; i < 200; i++ {
+			for _, v := range Sample(s, 2, rng) {
+				chosen[v] = true
+			}
+		}
+		assert.Len(t, chosen, len(s))
+	})
+
+	t.Run("Size Bounds", func(t *testing.T) {
+		assert.ElementsMatch(t, []int{1, 2, 3}, Sample([]int{1, 2, 3}, 10, nil))
+		assert.Empty(t, Sample(s, 0, nil))
+		assert.Empty(t, Sample(s, -1, nil))
+	})
+
+	t.Run("Nil and Empty", func(t *testing.T) {
+		assert.Nil(t, Sample[int](nil, 2, nil))
+		assert.Empty(t, Sample([]int{}, 2, nil))
+	})
+}
+
+// TestReservoirSample tests the ReservoirSample function
+func TestReservoirSample(t *testing.T) {
+	s := []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+
+	t.Run("Samples From Stream", func(t *testing.T) {
+		result := ReservoirSample(Iter(s), 3, rand.New(rand.NewPCG(1, 1)))
+
+		assert.Len(t, result, 3)
+		assert.Len(t, RemoveDuplicates(result), 3)
+		assert.Subset(t, s, result)
+	})
+
+	t.Run("Deterministic With Seeded Source", func(t *testing.T) {
+		a := ReservoirSample(Iter(s), 4, rand.New(rand.NewPCG(2, 2)))
+		b := ReservoirSample(Iter(s), 4, rand.New(rand.NewPCG(2, 2)))
+		assert.Equal(t, a, b)
+	})
+
+	t.Run("Roughly Uniform", func(t *testing.T) {
+		rng := rand.New(rand.NewPCG(3, 3))
+		counts := make(map[int]int)
+		for i := 0; i < 5000; i++ {
+			for _, v := range ReservoirSample(Iter(s), 2, rng) {
+				counts[v]++
+			}
+		}
+		// Each element is expected 1000 times
+		for _, v := range s {
+			assert.InDelta(t, 1000, counts[v], 150, "element %d", v)
+		}
+	})
+
+	t.Run("Short Stream", func(t *testing.T) {
+		assert.Equal(t, []int{1, 2}, ReservoirSample(Iter([]int{1, 2}), 5, nil))
+	})
+
+	t.Run("Non-Positive Size", func(t *testing.T) {
+		assert.Empty(t, ReservoirSample(Iter(s), 0, nil))
+	})
+}
+
+// TestWeightedSample tests the WeightedSample function
+func TestWeightedSample(t *testing.T) {
+	identity := func(w float64) float64 { return w }
+
+	t.Run("Deterministic With Seeded Source", func(t *testing.T) {
+		a := []float64{1, 2, 3, 4, 5}
+		first := WeightedSample(a, 3, identity, rand.New(rand.NewPCG(1, 2)))
+		second := WeightedSample(a, 3, identity, rand.New(rand.NewPCG(1, 2)))
+		assert.Equal(t, first, second)
+		assert.Len(t, first, 3)
+	})
+
+	t.Run("Without Replacement", func(t *testing.T) {
+		a := []float64{1, 2, 3, 4, 5}
+		result := WeightedSample(a, 10, identity, rand.New(rand.NewPCG(3, 4)))
+		assert.ElementsMatch(t, a, result)
+	})
+
+	t.Run("Proportional To Weight", func(t *testing.T) {
+		rng := rand.New(rand.NewPCG(5, 6))
+		heavy := 0
+		for range 2000 {
+			if WeightedSample([]float64{1, 9}, 1, identity, rng)[0] == 9 {
+				heavy++
+			}
+		}
+		// Expect about 90% with a generous margin
+		assert.InDelta(t, 1800, heavy, 100)
+	})
+
+	t.Run("Non Positive Weights Are Never Picked", func(t *testing.T) {
+		a := []float64{0, -1, math.NaN(), 2}
+		result := WeightedSample(a, 3, identity, rand.New(rand.NewPCG(7, 8)))
+		assert.Equal(t, []float64{2}, result)
+	})
+
+	t.Run("Nil and Empty", func(t *testing.T) {
+		assert.Equal(t, []float64{}, WeightedSample(nil, 2, identity, nil))
+		assert.Equal(t, []float64{}, WeightedSample([]float64{1}, 0, identity, nil))
+		assert.Len(t, WeightedSample([]float64{1}, 1, identity, nil), 1)
+	})
+}
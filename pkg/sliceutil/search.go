@@ -0,0 +1,65 @@
+package sliceutil
+
+import (
+	"cmp"
+	"slices"
+	"sort"
+)
+
+// BinarySearch searches for a target value in a slice sorted in ascending order.
+// It returns the index where the target was found, or the index where it would be
+// inserted to keep the slice sorted, and a boolean indicating whether it was found.
+// When the target occurs multiple times, the index of the first occurrence is returned.
+// The result is the same as that of slices.BinarySearch, which it delegates to.
+//
+// Time complexity: O(log n) where n is the length of the slice
+// Space complexity: O(1)
+//
+// Example:
+//
+//	index, found := BinarySearch([]int{1, 3, 5, 7}, 5)
+//	// index: 2, found: true
+//	index, found = BinarySearch([]int{1, 3, 5, 7}, 4)
+//	// index: 2, found: false
+func BinarySearch[T cmp.Ordered](sorted []T, target T) (int, bool) {
+	return slices.BinarySearch(sorted, target)
+}
+
+// BinarySearchFunc works like BinarySearch, but uses a custom comparison function.
+// The slice must be sorted in the order defined by the comparator, which returns a
+// negative number, zero, or a positive number when a is less than, equal to, or
+// greater than b.
+//
+// Example:
+//
+//	people := []Person{{Name: "Alice", Age: 25}, {Name: "Bob", Age: 30}}
+//	index, found := BinarySearchFunc(people, Person{Age: 30}, func(a, b Person) int {
+//		return a.Age - b.Age
+//	})
+//	// index: 1, found: true
+func BinarySearchFunc[T any](sorted []T, target T, compare func(a, b T) int) (int, bool) {
+	return slices.BinarySearchFunc(sorted, target, compare)
+}
+
+// InsertSorted returns a new slice with the value inserted into a slice sorted in
+// ascending order, keeping the result sorted. Equal values are inserted after the
+// existing ones. The original slice is not modified.
+//
+// Time complexity: O(n) where n is the length of the slice
+// Space complexity: O(n) for the result slice
+//
+// Example:
+//
+//	result := InsertSorted([]int{1, 3, 5}, 4)
+//	// returns []int{1, 3, 4, 5}
+func InsertSorted[T cmp.Ordered](sorted []T, value T) []T {
+	index := sort.Search(len(sorted), func(i int) bool {
+		return sorted[i] > value
+	})
+
+	result := make([]T, 0, len(sorted)+1)
+	result = append(result, sorted[:index]...)
+	result = append(result, value)
+	result = append(result, sorted[index:]...)
+	return result
+}
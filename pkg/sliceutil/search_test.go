@@ -0,0 +1,94 @@
+package sliceutil
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestBinarySearch tests the BinarySearch function
+func TestBinarySearch(t *testing.T) {
+	sorted := []int{1, 3, 5, 5, 7}
+
+	t.Run("Found", func(t *testing.T) {
+		index, found := BinarySearch(sorted, 7)
+		assert.True(t, found)
+		assert.Equal(t, 4, index)
+	})
+
+	t.Run("First Of Duplicates", func(t *testing.T) {
+		index, found := BinarySearch(sorted, 5)
+		assert.True(t, found)
+		assert.Equal(t, 2, index)
+	})
+
+	t.Run("Not Found Returns Insertion Point", func(t *testing.T) {
+		index, found := BinarySearch(sorted, 4)
+		assert.False(t, found)
+		assert.Equal(t, 2, index)
+
+		index, found = BinarySearch(sorted, 10)
+		assert.False(t, found)
+		assert.Equal(t, 5, index)
+
+		index, found = BinarySearch(sorted, 0)
+		assert.False(t, found)
+		assert.Equal(t, 0, index)
+	})
+
+	t.Run("Nil and Empty", func(t *testing.T) {
+		index, found := BinarySearch[int](nil, 1)
+		assert.False(t, found)
+		assert.Equal(t, 0, index)
+	})
+}
+
+// TestBinarySearchFunc tests the BinarySearchFunc function
+func TestBinarySearchFunc(t *testing.T) {
+	type person struct {
+		Name string
+		Age  int
+	}
+	people := []person{{"Alice", 25}, {"Bob", 30}, {"Carol", 35}}
+	byAge := func(a, b person) int { return a.Age - b.Age }
+
+	t.Run("Found", func(t *testing.T) {
+		index, found := BinarySearchFunc(people, person{Age: 30}, byAge)
+		assert.True(t, found)
+		assert.Equal(t, 1, index)
+	})
+
+	t.Run("Not Found", func(t *testing.T) {
+		index, found := BinarySearchFunc(people, person{Age: 40}, byAge)
+		assert.False(t, found)
+		assert.Equal(t, 3, index)
+	})
+}
+
+// TestInsertSorted tests the InsertSorted function
+func TestInsertSorted(t *testing.T) {
+	t.Run("Insert In Middle", func(t *testing.T) {
+		sorted := []int{1, 3, 5}
+		result := InsertSorted(sorted, 4)
+
+		assert.Equal(t, []int{1, 3, 4, 5}, result)
+		assert.Equal(t, []int{1, 3, 5}, sorted) // Original unchanged
+	})
+
+	t.Run("Insert At Ends", func(t *testing.T) {
+		assert.Equal(t, []int{0, 1, 2}, InsertSorted([]int{1, 2}, 0))
+		assert.Equal(t, []int{1, 2, 3}, InsertSorted([]int{1, 2}, 3))
+	})
+
+	t.Run("Keeps Result Sorted", func(t *testing.T) {
+		result := []int{}
+		for _, v := range []int{5, 2, 8, 2, 1} {
+			result = InsertSorted(result, v)
+		}
+		assert.Equal(t, []int{1, 2, 2, 5, 8}, result)
+	})
+
+	t.Run("Nil and Empty", func(t *testing.T) {
+		assert.Equal(t, []int{1}, InsertSorted(nil, 1))
+	})
+}
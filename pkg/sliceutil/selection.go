@@ -0,0 +1,75 @@
+package sliceutil
+
+import (
+	"cmp"
+	"container/heap"
+	"sort"
+)
+
+// TopN returns the n largest elements of a slice in descending order without sorting
+// the whole slice. A bounded min-heap keeps the best candidates seen so far.
+// If n is not positive the result is empty; if n exceeds the length of the slice,
+// all elements are returned in descending order. The input slice is not modified.
+//
+// Time complexity: O(len(s) * log n)
+// Space complexity: O(n) for the heap
+//
+// Example:
+//
+//	top := TopN([]int{5, 1, 9, 3, 7}, 2) // returns []int{9, 7}
+func TopN[T cmp.Ordered](s []T, n int) []T {
+	return selectN(s, n, func(a, b T) bool { return a < b })
+}
+
+// BottomN returns the n smallest elements of a slice in ascending order without sorting
+// the whole slice. It mirrors TopN with the ordering reversed.
+//
+// Example:
+//
+//	bottom := BottomN([]int{5, 1, 9, 3, 7}, 2) // returns []int{1, 3}
+func BottomN[T cmp.Ordered](s []T, n int) []T {
+	return selectN(s, n, func(a, b T) bool { return a > b })
+}
+
+// selectN is a helper function that keeps the n "best" elements using a heap whose root is
+// the worst candidate according to worse, and returns them from best to worst.
+func selectN[T any](s []T, n int, worse func(a, b T) bool) []T {
+	if s == nil {
+		return nil
+	}
+	if n <= 0 {
+		return []T{}
+	}
+
+	h := &boundedHeap[T]{less: worse, items: make([]T, 0, min(n, len(s)))}
+	for _, v := range s {
+		if h.Len() < n {
+			heap.Push(h, v)
+			continue
+		}
+		if worse(h.items[0], v) {
+			h.items[0] = v
+			heap.Fix(h, 0)
+		}
+	}
+
+	result := h.items
+	sort.Slice(result, func(i, j int) bool { return worse(result[j], result[i]) })
+	return result
+}
+
+// boundedHeap is a heap.Interface implementation over a slice with a custom ordering.
+type boundedHeap[T any] struct {
+	items []T
+	less  func(a, b T) bool
+}
+
+func (h *boundedHeap[T]) Len() int           { return len(h.items) }
+func (h *boundedHeap[T]) Less(i, j int) bool { return h.less(h.items[i], h.items[j]) }
+func (h *boundedHeap[T]) Swap(i, j int)      { h.items[i], h.items[j] = h.items[j], h.items[i] }
+func (h *boundedHeap[T]) Push(x any)         { h.items = append(h.items, x.(T)) }
+func (h *boundedHeap[T]) Pop() any {
+	last := h.items[len(h.items)-1]
+	h.items = h.items[:len(h.items)-1]
+	return last
+}
@@ -0,0 +1,70 @@
+package sliceutil
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestTopN tests the TopN function
+func TestTopN(t *testing.T) {
+	t.Run("Largest Elements Descending", func(t *testing.T) {
+		slice := []int{5, 1, 9, 3, 7}
+		assert.Equal(t, []int{9, 7}, TopN(slice, 2))
+		assert.Equal(t, []int{5, 1, 9, 3, 7}, slice) // Original unchanged
+	})
+
+	t.Run("Duplicates", func(t *testing.T) {
+		assert.Equal(t, []int{9, 9, 7}, TopN([]int{9, 1, 9, 7, 3}, 3))
+	})
+
+	t.Run("N Exceeds Length", func(t *testing.T) {
+		assert.Equal(t, []string{"c", "b", "a"}, TopN([]string{"b", "c", "a"}, 10))
+	})
+
+	t.Run("Matches Full Sort", func(t *testing.T) {
+		r := rand.New(rand.NewSource(42))
+		slice := make([]int, 1000)
+		for i := range slice {
+			slice[i] = r.Intn(500)
+		}
+
+		sorted := append([]int{}, slice...)
+		sort.Sort(sort.Reverse(sort.IntSlice(sorted)))
+		assert.Equal(t, sorted[:25], TopN(slice, 25))
+	})
+
+	t.Run("Edge Cases", func(t *testing.T) {
+		assert.Nil(t, TopN[int](nil, 3))
+		assert.Empty(t, TopN([]int{1, 2}, 0))
+		assert.Empty(t, TopN([]int{1, 2}, -1))
+		assert.Empty(t, TopN([]int{}, 3))
+	})
+}
+
+// TestBottomN tests the BottomN function
+func TestBottomN(t *testing.T) {
+	t.Run("Smallest Elements Ascending", func(t *testing.T) {
+		assert.Equal(t, []int{1, 3}, BottomN([]int{5, 1, 9, 3, 7}, 2))
+		assert.Equal(t, []float64{-2.5, 0.5}, BottomN([]float64{0.5, 4, -2.5}, 2))
+	})
+
+	t.Run("Matches Full Sort", func(t *testing.T) {
+		r := rand.New(rand.NewSource(7))
+		slice := make([]int, 1000)
+		for i := range slice {
+			slice[i] = r.Intn(500)
+		}
+
+		sorted := append([]int{}, slice...)
+		sort.Ints(sorted)
+		assert.Equal(t, sorted[:25], BottomN(slice, 25))
+	})
+
+	t.Run("Edge Cases", func(t *testing.T) {
+		assert.Nil(t, BottomN[int](nil, 3))
+		assert.Empty(t, BottomN([]int{1, 2}, 0))
+	})
+}
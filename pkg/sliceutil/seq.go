@@ -0,0 +1,118 @@
+package sliceutil
+
+import "iter"
+
+// Iter returns a lazy sequence over the elements of a slice.
+// The sequence reads the slice as it is iterated, so it reflects any changes
+// made to the slice's elements before or during iteration.
+//
+// Example:
+//
+//	for v := range Iter([]int{1, 2, 3}) {
+//		fmt.Println(v)
+//	}
+func Iter[T any](s []T) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, v := range s {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// MapSeq lazily applies a transformation function to every element of a sequence.
+//
+// Example:
+//
+//	squares := MapSeq(Iter([]int{1, 2, 3}), func(v int) int { return v * v })
+//	result := Collect(squares) // returns []int{1, 4, 9}
+func MapSeq[T, U any](seq iter.Seq[T], fn func(T) U) iter.Seq[U] {
+	return func(yield func(U) bool) {
+		for v := range seq {
+			if !yield(fn(v)) {
+				return
+			}
+		}
+	}
+}
+
+// FilterSeq lazily yields only the elements of a sequence for which the predicate returns true.
+func FilterSeq[T any](seq iter.Seq[T], pred func(T) bool) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for v := range seq {
+			if pred(v) && !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// TakeSeq lazily yields at most the first n elements of a sequence.
+// The underlying sequence is not consumed beyond the n-th element.
+func TakeSeq[T any](seq iter.Seq[T], n int) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if n <= 0 {
+			return
+		}
+		taken := 0
+		for v := range seq {
+			if !yield(v) {
+				return
+			}
+			taken++
+			if taken >= n {
+				return
+			}
+		}
+	}
+}
+
+// SkipSeq lazily yields the elements of a sequence after skipping the first n.
+func SkipSeq[T any](seq iter.Seq[T], n int) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		skipped := 0
+		for v := range seq {
+			if skipped < n {
+				skipped++
+				continue
+			}
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Collect consumes a sequence and returns its elements as a slice.
+// An empty sequence yields an empty, non-nil slice.
+//
+// Example:
+//
+//	evens := Collect(FilterSeq(Iter(values), isEven))
+func Collect[T any](seq iter.Seq[T]) []T {
+	result := make([]T, 0)
+	for v := range seq {
+		result = append(result, v)
+	}
+	return result
+}
+
+// Count consumes a sequence and returns the number of elements it yielded.
+func Count[T any](seq iter.Seq[T]) int {
+	count := 0
+	for range seq {
+		count++
+	}
+	return count
+}
+
+// First returns the first element of a sequence and true, or the zero value and
+// false if the sequence is empty. Only the first element is consumed.
+func First[T any](seq iter.Seq[T]) (T, bool) {
+	for v := range seq {
+		return v, true
+	}
+	var zero T
+	return zero, false
+}
@@ -0,0 +1,67 @@
+package sliceutil
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestIter tests the Iter function and terminal operations
+func TestIter(t *testing.T) {
+	t.Run("Round Trip", func(t *testing.T) {
+		assert.Equal(t, []int{1, 2, 3}, Collect(Iter([]int{1, 2, 3})))
+	})
+
+	t.Run("Count And First", func(t *testing.T) {
+		assert.Equal(t, 3, Count(Iter([]string{"a", "b", "c"})))
+
+		v, ok := First(Iter([]string{"a", "b"}))
+		assert.True(t, ok)
+		assert.Equal(t, "a", v)
+	})
+
+	t.Run("Nil Slice", func(t *testing.T) {
+		assert.Empty(t, Collect(Iter[int](nil)))
+		assert.Equal(t, 0, Count(Iter[int](nil)))
+
+		v, ok := First(Iter[int](nil))
+		assert.False(t, ok)
+		assert.Equal(t, 0, v)
+	})
+}
+
+// TestLazySeqOperations tests the lazy sequence adapters
+func TestLazySeqOperations(t *testing.T) {
+	values := []int{1, 2, 3, 4, 5, 6}
+	isEven := func(v int) bool { return v%2 == 0 }
+
+	t.Run("MapSeq And FilterSeq", func(t *testing.T) {
+		seq := MapSeq(FilterSeq(Iter(values), isEven), func(v int) int { return v * 10 })
+		assert.Equal(t, []int{20, 40, 60}, Collect(seq))
+	})
+
+	t.Run("TakeSeq And SkipSeq", func(t *testing.T) {
+		assert.Equal(t, []int{1, 2}, Collect(TakeSeq(Iter(values), 2)))
+		assert.Equal(t, []int{5, 6}, Collect(SkipSeq(Iter(values), 4)))
+		assert.Equal(t, []int{3, 4}, Collect(TakeSeq(SkipSeq(Iter(values), 2), 2)))
+		assert.Empty(t, Collect(TakeSeq(Iter(values), 0)))
+		assert.Empty(t, Collect(SkipSeq(Iter(values), 10)))
+	})
+
+	t.Run("Evaluation Is Lazy", func(t *testing.T) {
+		calls := 0
+		seq := MapSeq(Iter(values), func(v int) int {
+			calls++
+			return v
+		})
+
+		v, ok := First(FilterSeq(seq, isEven))
+		assert.True(t, ok)
+		assert.Equal(t, 2, v)
+		assert.Equal(t, 2, calls)
+
+		calls = 0
+		Collect(TakeSeq(seq, 3))
+		assert.Equal(t, 3, calls)
+	})
+}
@@ -0,0 +1,141 @@
+package sliceutil
+
+import (
+	"iter"
+	"maps"
+)
+
+// Set is a collection of distinct comparable values with constant-time membership
+// checks. It is a map, so a Set built once can be queried repeatedly without rebuilding
+// lookup maps, and it converts freely to and from the map returned by ToSet.
+//
+// Use NewSet to create a Set; like a nil map, the zero value can be read but not added to.
+// A Set is not safe for concurrent modification.
+type Set[T comparable] map[T]struct{}
+
+// NewSet creates a Set holding the given values.
+//
+// Example:
+//
+//	roles := NewSet("read", "write")
+//	roles.Add("admin")
+//	ok := roles.Contains("write") // returns true
+func NewSet[T comparable](values ...T) Set[T] {
+	return SetOf(values)
+}
+
+// SetOf creates a Set holding the distinct elements of a slice.
+//
+// Time complexity: O(n) where n is the length of the slice
+// Space complexity: O(n) for the set
+//
+// Example:
+//
+//	seen := SetOf(previousIDs)
+//	fresh := Filter(ids, func(id int) bool { return !seen.Contains(id) })
+func SetOf[T comparable](s []T) Set[T] {
+	return Set[T](ToSet(s))
+}
+
+// Add adds the given values to the set.
+func (s Set[T]) Add(values ...T) {
+	for _, v := range values {
+		s[v] = struct{}{}
+	}
+}
+
+// Remove removes the given values from the set. Values that are not present are ignored.
+func (s Set[T]) Remove(values ...T) {
+	for _, v := range values {
+		delete(s, v)
+	}
+}
+
+// Contains reports whether the set holds v.
+func (s Set[T]) Contains(v T) bool {
+	_, ok := s[v]
+	return ok
+}
+
+// ContainsAll reports whether the set holds every one of the given values.
+// It returns true when no values are given.
+func (s Set[T]) ContainsAll(values ...T) bool {
+	for _, v := range values {
+		if !s.Contains(v) {
+			return false
+		}
+	}
+	return true
+}
+
+// Len returns the number of values in the set.
+func (s Set[T]) Len() int {
+	return len(s)
+}
+
+// Equal reports whether both sets hold the same values.
+func (s Set[T]) Equal(other Set[T]) bool {
+	return len(s) == len(other) && s.ContainsAll(other.ToSlice()...)
+}
+
+// Union returns a new set holding the values present in either set.
+func (s Set[T]) Union(other Set[T]) Set[T] {
+	result := make(Set[T], max(len(s), len(other)))
+	maps.Copy(result, s)
+	maps.Copy(result, other)
+	return result
+}
+
+// Intersect returns a new set holding the values present in both sets.
+func (s Set[T]) Intersect(other Set[T]) Set[T] {
+	// Iterate over the smaller set
+	if len(other) < len(s) {
+		s, other = other, s
+	}
+	result := make(Set[T])
+	for v := range s {
+		if other.Contains(v) {
+			result[v] = struct{}{}
+		}
+	}
+	return result
+}
+
+// Difference returns a new set holding the values of s that are not present in other.
+func (s Set[T]) Difference(other Set[T]) Set[T] {
+	result := make(Set[T])
+	for v := range s {
+		if !other.Contains(v) {
+			result[v] = struct{}{}
+		}
+	}
+	return result
+}
+
+// SymmetricDifference returns a new set holding the values present in exactly one of
+// the sets, like FindDifferences does for slices.
+func (s Set[T]) SymmetricDifference(other Set[T]) Set[T] {
+	result := s.Difference(other)
+	for v := range other {
+		if !s.Contains(v) {
+			result[v] = struct{}{}
+		}
+	}
+	return result
+}
+
+// ToSlice returns the values of the set as a slice in unspecified order, for use with
+// the slice functions in this package. The result is never nil; sort it with Sort when
+// a deterministic order is needed.
+func (s Set[T]) ToSlice() []T {
+	result := make([]T, 0, len(s))
+	for v := range s {
+		result = append(result, v)
+	}
+	return result
+}
+
+// All returns a sequence over the values of the set in unspecified order.
+func (s Set[T]) All() iter.Seq[T] {
+	return maps.Keys(s)
+}
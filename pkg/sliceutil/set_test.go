@@ -0,0 +1,72 @@
+package sliceutil
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestSet tests the Set type
+func TestSet(t *testing.T) {
+	t.Run("Add Remove Contains", func(t *testing.T) {
+		s := NewSet(1, 2)
+		s.Add(3, 3)
+		assert.Equal(t, 3, s.Len())
+		assert.True(t, s.Contains(3))
+
+		s.Remove(1, 10)
+		assert.False(t, s.Contains(1))
+		assert.True(t, s.ContainsAll(2, 3))
+		assert.False(t, s.ContainsAll(1, 2))
+		assert.True(t, s.ContainsAll())
+	})
+
+	t.Run("Set Operations", func(t *testing.T) {
+		a := NewSet(1, 2, 3)
+		b := NewSet(2, 3, 4)
+
+		assert.Equal(t, NewSet(1, 2, 3, 4), a.Union(b))
+		assert.Equal(t, NewSet(2, 3), a.Intersect(b))
+		assert.Equal(t, NewSet(2, 3), b.Intersect(a))
+		assert.Equal(t, NewSet(1), a.Difference(b))
+		assert.Equal(t, NewSet(1, 4), a.SymmetricDifference(b))
+
+		// The operands are not modified
+		assert.Equal(t, NewSet(1, 2, 3), a)
+		assert.Equal(t, NewSet(2, 3, 4), b)
+	})
+
+	t.Run("Matches Slice Functions", func(t *testing.T) {
+		a := []int{1, 2, 2, 3, 5}
+		b := []int{2, 3, 4}
+		assert.ElementsMatch(t, FindDifferences(RemoveDuplicates(a), b), SetOf(a).SymmetricDifference(SetOf(b)).ToSlice())
+		assert.ElementsMatch(t, Intersection(a, b), SetOf(a).Intersect(SetOf(b)).ToSlice())
+		assert.ElementsMatch(t, Union(a, b), SetOf(a).Union(SetOf(b)).ToSlice())
+		assert.ElementsMatch(t, Subtract(a, b), SetOf(a).Difference(SetOf(b)).ToSlice())
+	})
+
+	t.Run("Equal", func(t *testing.T) {
+		assert.True(t, NewSet("a", "b").Equal(NewSet("b", "a")))
+		assert.False(t, NewSet("a", "b").Equal(NewSet("a", "c")))
+		assert.False(t, NewSet("a").Equal(NewSet("a", "b")))
+	})
+
+	t.Run("Conversions", func(t *testing.T) {
+		s := Set[string](ToSet([]string{"x", "y"}))
+		assert.True(t, s.Contains("x"))
+		assert.ElementsMatch(t, []string{"x", "y"}, s.ToSlice())
+		assert.ElementsMatch(t, []string{"x", "y"}, Collect(s.All()))
+		assert.Equal(t, []string{"x", "y"}, SortedKeys(s, OrderAsc))
+	})
+
+	t.Run("Nil and Empty", func(t *testing.T) {
+		var zero Set[int]
+		assert.Equal(t, 0, zero.Len())
+		assert.False(t, zero.Contains(1))
+		assert.Equal(t, []int{}, zero.ToSlice())
+		assert.Equal(t, NewSet(1), zero.Union(NewSet(1)))
+		assert.Equal(t, NewSet[int](), zero.Intersect(NewSet(1)))
+		assert.True(t, zero.Equal(NewSet[int]()))
+		assert.Equal(t, 0, SetOf[int](nil).Len())
+	})
+}
@@ -0,0 +1,168 @@
+package sliceutil
+
+// Intersection returns the distinct elements present in both slices.
+// The result preserves the order of first appearance in slice A.
+//
+// Time complexity: O(n + m) where n and m are the lengths of the slices
+// Space complexity: O(n + m) for the lookup sets and result
+//
+// Example:
+//
+//	result := Intersection([]int{1, 2, 2, 3}, []int{2, 3, 4}) // returns []int{2, 3}
+func Intersection[T comparable](a, b []T) []T {
+	inB := ToSet(b)
+	seen := make(map[T]struct{})
+	result := make([]T, 0)
+
+	for _, v := range a {
+		if _, ok := inB[v]; !ok {
+			continue
+		}
+		if _, dup := seen[v]; !dup {
+			seen[v] = struct{}{}
+			result = append(result, v)
+		}
+	}
+	return result
+}
+
+// Union returns the distinct elements present in either slice.
+// The result preserves the order of first appearance in slice A followed by slice B.
+//
+// Example:
+//
+//	result := Union([]int{1, 2, 2}, []int{2, 3}) // returns []int{1, 2, 3}
+func Union[T comparable](a, b []T) []T {
+	seen := make(map[T]struct{}, len(a)+len(b))
+	result := make([]T, 0, len(a)+len(b))
+
+	for _, slice := range [][]T{a, b} {
+		for _, v := range slice {
+			if _, dup := seen[v]; !dup {
+				seen[v] = struct{}{}
+				result = append(result, v)
+			}
+		}
+	}
+	return result
+}
+
+// Subtract returns the distinct elements of slice A that are not present in slice B (A minus B).
+// The result preserves the order of first appearance in slice A.
+//
+// Example:
+//
+//	result := Subtract([]int{1, 2, 3, 1}, []int{2}) // returns []int{1, 3}
+func Subtract[T comparable](a, b []T) []T {
+	inB := ToSet(b)
+	seen := make(map[T]struct{})
+	result := make([]T, 0)
+
+	for _, v := range a {
+		if _, ok := inB[v]; ok {
+			continue
+		}
+		if _, dup := seen[v]; !dup {
+			seen[v] = struct{}{}
+			result = append(result, v)
+		}
+	}
+	return result
+}
+
+// SymmetricDifference returns the distinct elements present in exactly one of the slices.
+// Unlike FindDifferences, element counts are ignored and the result is ordered:
+// elements unique to slice A come first, followed by those unique to slice B.
+//
+// Example:
+//
+//	result := SymmetricDifference([]int{1, 2, 3}, []int{3, 4}) // returns []int{1, 2, 4}
+func SymmetricDifference[T comparable](a, b []T) []T {
+	result := Subtract(a, b)
+	return append(result, Subtract(b, a)...)
+}
+
+// MultisetIntersection returns the elements common to both slices, respecting counts:
+// an element appearing x times in A and y times in B appears min(x, y) times in the result.
+// Elements are emitted in the order of their occurrences in slice A.
+//
+// Example:
+//
+//	result := MultisetIntersection([]int{1, 1, 1, 2}, []int{1, 1, 3}) // returns []int{1, 1}
+func MultisetIntersection[T comparable](a, b []T) []T {
+	remaining := countElements(b)
+	result := make([]T, 0)
+
+	for _, v := range a {
+		if remaining[v] > 0 {
+			remaining[v]--
+			result = append(result, v)
+		}
+	}
+	return result
+}
+
+// MultisetUnion returns the elements of both slices, respecting counts:
+// an element appearing x times in A and y times in B appears max(x, y) times in the result.
+// Occurrences from slice A come first, followed by the surplus occurrences from slice B.
+//
+// Example:
+//
+//	result := MultisetUnion([]int{1, 2}, []int{1, 1, 3}) // returns []int{1, 2, 1, 3}
+func MultisetUnion[T comparable](a, b []T) []T {
+	available := countElements(a)
+	result := make([]T, 0, len(a)+len(b))
+	result = append(result, a...)
+
+	for _, v := range b {
+		if available[v] > 0 {
+			available[v]--
+			continue
+		}
+		result = append(result, v)
+	}
+	return result
+}
+
+// MultisetSubtract removes one occurrence from slice A for every occurrence in slice B:
+// an element appearing x times in A and y times in B appears max(x-y, 0) times in the result.
+// The remaining occurrences keep their order from slice A, with the earliest ones removed first.
+//
+// Example:
+//
+//	result := MultisetSubtract([]int{1, 1, 2, 3}, []int{1, 3}) // returns []int{1, 2}
+func MultisetSubtract[T comparable](a, b []T) []T {
+	toRemove := countElements(b)
+	result := make([]T, 0, len(a))
+
+	for _, v := range a {
+		if toRemove[v] > 0 {
+			toRemove[v]--
+			continue
+		}
+		result = append(result, v)
+	}
+	return result
+}
+
+// MultisetSymmetricDifference returns the occurrences that cannot be paired between the slices:
+// an element appearing x times in A and y times in B appears |x-y| times in the result.
+// Surplus occurrences from slice A come first, followed by those from slice B.
+// The counts match those reported by FindDifferencesWithCount.
+//
+// Example:
+//
+//	result := MultisetSymmetricDifference([]int{1, 1, 2}, []int{1, 3}) // returns []int{1, 2, 3}
+func MultisetSymmetricDifference[T comparable](a, b []T) []T {
+	result := MultisetSubtract(a, b)
+	return append(result, MultisetSubtract(b, a)...)
+}
+
+// countElements is a helper function that counts the occurrences of each element in a slice.
+func countElements[T comparable](s []T) map[T]int {
+	counts := make(map[T]int, len(s))
+	for _, v := range s {
+		counts[v]++
+	}
+	return counts
+}
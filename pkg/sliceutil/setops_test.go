@@ -0,0 +1,81 @@
+package sliceutil
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestSetOperations tests the distinct set operations
+func TestSetOperations(t *testing.T) {
+	a := []int{1, 2, 2, 3, 1}
+	b := []int{3, 4, 2, 4}
+
+	t.Run("Intersection", func(t *testing.T) {
+		assert.Equal(t, []int{2, 3}, Intersection(a, b))
+		assert.Empty(t, Intersection([]int{1}, []int{2}))
+	})
+
+	t.Run("Union", func(t *testing.T) {
+		assert.Equal(t, []int{1, 2, 3, 4}, Union(a, b))
+	})
+
+	t.Run("Subtract", func(t *testing.T) {
+		assert.Equal(t, []int{1}, Subtract(a, b))
+		assert.Equal(t, []int{4}, Subtract(b, a))
+	})
+
+	t.Run("SymmetricDifference", func(t *testing.T) {
+		result := SymmetricDifference(a, b)
+		assert.Equal(t, []int{1, 4}, result)
+		assert.ElementsMatch(t, FindDifferences([]int{1, 2, 3}, []int{3, 4}), SymmetricDifference([]int{1, 2, 3}, []int{3, 4}))
+	})
+
+	t.Run("Nil Slices", func(t *testing.T) {
+		assert.Empty(t, Intersection[int](nil, nil))
+		assert.Equal(t, []int{1}, Union(nil, []int{1}))
+		assert.Equal(t, []int{1}, Subtract([]int{1}, nil))
+		assert.Equal(t, []int{1}, SymmetricDifference(nil, []int{1}))
+	})
+}
+
+// TestMultisetOperations tests the count-aware set operations
+func TestMultisetOperations(t *testing.T) {
+	a := []int{1, 1, 1, 2, 5}
+	b := []int{1, 1, 3, 3, 5}
+
+	t.Run("MultisetIntersection", func(t *testing.T) {
+		assert.Equal(t, []int{1, 1, 5}, MultisetIntersection(a, b))
+	})
+
+	t.Run("MultisetUnion", func(t *testing.T) {
+		assert.Equal(t, []int{1, 1, 1, 2, 5, 3, 3}, MultisetUnion(a, b))
+	})
+
+	t.Run("MultisetSubtract", func(t *testing.T) {
+		assert.Equal(t, []int{1, 2}, MultisetSubtract(a, b))
+		assert.Equal(t, []int{3, 3}, MultisetSubtract(b, a))
+	})
+
+	t.Run("MultisetSymmetricDifference Matches FindDifferencesWithCount", func(t *testing.T) {
+		result := MultisetSymmetricDifference(a, b)
+		assert.Equal(t, []int{1, 2, 3, 3}, result)
+
+		counts := FindDifferencesWithCount(a, b)
+		total := 0
+		for _, c := range counts {
+			if c < 0 {
+				c = -c
+			}
+			total += c
+		}
+		assert.Len(t, result, total)
+	})
+
+	t.Run("Nil Slices", func(t *testing.T) {
+		assert.Empty(t, MultisetIntersection[int](nil, []int{1}))
+		assert.Equal(t, []int{1, 1}, MultisetUnion(nil, []int{1, 1}))
+		assert.Equal(t, []int{1}, MultisetSubtract([]int{1}, nil))
+		assert.Empty(t, MultisetSymmetricDifference[int](nil, nil))
+	})
+}
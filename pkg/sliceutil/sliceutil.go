@@ -10,6 +10,7 @@
 package sliceutil
 
 import (
+	"container/list"
 	"errors"
 	"sync"
 )
@@ -20,8 +21,40 @@ var (
 	ErrNilSlice        = errors.New("slice cannot be nil")
 	ErrTypeMismatch    = errors.New("slice types do not match")
 	ErrUnsupportedType = errors.New("unsupported slice type")
+	ErrSizeOverflow    = errors.New("result size overflows int")
+	ErrUnknownVersion  = errors.New("unknown snapshot version")
+	ErrConflict        = errors.New("conflicting values for key")
+	ErrInvalidPolicy   = errors.New("invalid conflict policy")
+	ErrPatchMismatch   = errors.New("patch does not apply to slice")
+	ErrOutOfRange      = errors.New("argument out of range")
+	ErrNotSorted       = errors.New("slice is not sorted")
+	ErrLengthMismatch  = errors.New("slice lengths do not match")
+	ErrOverflow        = errors.New("integer overflow")
+	ErrNotJSONArray    = errors.New("input is not a JSON array")
+	ErrInvalidEncoding = errors.New("invalid slice encoding")
 )
 
+// Integer is a constraint that permits any integer type
+type Integer interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr
+}
+
+// Float is a constraint that permits any floating-point type
+type Float interface {
+	~float32 | ~float64
+}
+
+// Number is a constraint that permits any integer or floating-point type
+type Number interface {
+	Integer | Float
+}
+
+// Primitive is a constraint that permits any number, boolean or string type
+type Primitive interface {
+	Number | ~bool | ~string
+}
+
 // OrderType represents the sorting order for merge operations
 type OrderType string
 
@@ -30,6 +63,57 @@ const (
 	OrderAsc OrderType = "ASC"
 	// OrderDesc sorts elements in descending order
 	OrderDesc OrderType = "DESC"
+	// OrderNone leaves elements unsorted, so merged slices keep their concatenation order
+	OrderNone OrderType = "NONE"
+)
+
+// KeepPolicy determines which occurrence of a duplicate is retained by deduplication functions
+type KeepPolicy string
+
+const (
+	// KeepFirst retains the earliest occurrence of each duplicate
+	KeepFirst KeepPolicy = "FIRST"
+	// KeepLast retains the most recent occurrence of each duplicate
+	KeepLast KeepPolicy = "LAST"
+)
+
+// ConflictPolicy determines how keyed merge operations resolve multiple values for the same key
+type ConflictPolicy string
+
+const (
+	// ConflictKeepFirst keeps the value that was seen first
+	ConflictKeepFirst ConflictPolicy = "KEEP_FIRST"
+	// ConflictKeepLast keeps the value that was seen last
+	ConflictKeepLast ConflictPolicy = "KEEP_LAST"
+	// ConflictSum adds numeric values together
+	ConflictSum ConflictPolicy = "SUM"
+	// ConflictError aborts the merge with ErrConflict
+	ConflictError ConflictPolicy = "ERROR"
+	// ConflictErrorOnMismatch keeps equal duplicates once and aborts the merge with
+	// ErrConflict only when the values for a key differ
+	ConflictErrorOnMismatch ConflictPolicy = "ERROR_ON_MISMATCH"
+	// ConflictCustom delegates the resolution to a caller-provided resolver
+	ConflictCustom ConflictPolicy = "CUSTOM"
+)
+
+// LengthPolicy determines how functions that combine slices pairwise handle slices of different lengths
+type LengthPolicy string
+
+const (
+	// LengthTruncate stops at the end of the shorter slice
+	LengthTruncate LengthPolicy = "TRUNCATE"
+	// LengthError fails with ErrLengthMismatch when the lengths differ
+	LengthError LengthPolicy = "ERROR"
+)
+
+// NilPolicy determines how sum comparisons handle nil slices
+type NilPolicy string
+
+const (
+	// NilAsZero treats a nil slice like an empty one, with a sum of zero
+	NilAsZero NilPolicy = "AS_ZERO"
+	// NilError fails the comparison with ErrNilSlice
+	NilError NilPolicy = "ERROR"
 )
 
 // Result represents the result of comparing two slices
@@ -44,11 +128,98 @@ const (
 	ResultEqual Result = "both are equal"
 )
 
-// CompareResult holds the result of a slice comparison operation
+// CompareResult holds the result of a slice comparison operation.
+// It can be marshaled to JSON with stable field names, so it can be logged or
+// returned from an HTTP handler directly.
 type CompareResult struct {
-	Equal   bool
-	Message string
-	Details map[string]interface{}
+	Equal   bool           `json:"equal"`
+	Message string         `json:"message"`
+	Details CompareDetails `json:"details,omitzero"`
+}
+
+// CompareDetails explains why a comparison failed. Only the fields relevant to the
+// comparison that produced it are set; all others keep their zero value.
+type CompareDetails struct {
+	// ANil and BNil report which input was nil when only one of them is
+	ANil bool `json:"a_nil,omitempty"`
+	BNil bool `json:"b_nil,omitempty"`
+	// LengthA and LengthB are set when the inputs have different lengths
+	LengthA int `json:"length_a"`
+	LengthB int `json:"length_b"`
+	// DifferenceIndices lists the positions at which the inputs differ
+	DifferenceIndices []int `json:"difference_indices,omitempty"`
+	// DifferenceCount is the total number of differences, even when fewer were recorded
+	DifferenceCount int `json:"difference_count"`
+	// Truncated is set when not every difference was recorded
+	Truncated bool `json:"truncated,omitempty"`
+	// FieldDiffs lists every differing field of a struct comparison
+	FieldDiffs []FieldDiff `json:"field_diffs,omitempty"`
+	// TypeA and TypeB name the dynamic types of the inputs when they differ
+	TypeA string `json:"type_a,omitempty"`
+	TypeB string `json:"type_b,omitempty"`
+	// SumA, SumB and SumDifference (SumA - SumB) are set by sum comparisons,
+	// together with the Outcome of the comparison. Sums of any numeric type are
+	// reported as float64, so integers beyond 2^53 are rounded.
+	SumA          float64 `json:"sum_a"`
+	SumB          float64 `json:"sum_b"`
+	SumDifference float64 `json:"sum_difference"`
+	Outcome       Result  `json:"outcome,omitempty"`
+	// NilPolicy is set by sum comparisons when an input was nil and the policy was applied
+	NilPolicy NilPolicy `json:"nil_policy,omitempty"`
+	// ErrorA and ErrorB describe why an input could not be processed
+	ErrorA string `json:"error_a,omitempty"`
+	ErrorB string `json:"error_b,omitempty"`
+	// Extra holds details whose type depends on the compared elements, such as
+	// "mismatches" ([]ElementDiff[T]) and "count_differences" (map[T]int)
+	Extra map[string]interface{} `json:"extra,omitempty"`
+}
+
+// IndexedValue pairs an element with its index in the slice it was taken from
+type IndexedValue[T any] struct {
+	Index int
+	Value T
+}
+
+// Pair holds two related values, such as matched elements from two slices
+type Pair[A, B any] struct {
+	First  A
+	Second B
+}
+
+// ElementCount pairs a value with the number of times it occurs
+type ElementCount[T any] struct {
+	Value T
+	Count int
+}
+
+// Run describes a maximal sequence of equal consecutive values within a slice
+type Run[T any] struct {
+	Value  T
+	Start  int
+	Length int
+}
+
+// ElementDiff describes a position at which two slices hold different values
+type ElementDiff[T any] struct {
+	Index  int `json:"index"`
+	AValue T   `json:"a_value"`
+	BValue T   `json:"b_value"`
+}
+
+// Differences splits the distinct elements of two slices by the side they were found on
+type Differences[T any] struct {
+	OnlyInA []T
+	OnlyInB []T
+	InBoth  []T
+}
+
+// IndexDifferences describes how two slices differ position by position
+type IndexDifferences[T any] struct {
+	// Mismatches lists the positions present in both slices that hold different values
+	Mismatches []ElementDiff[T]
+	// ExtraInA and ExtraInB hold the trailing elements beyond the length of the other slice
+	ExtraInA []IndexedValue[T]
+	ExtraInB []IndexedValue[T]
 }
 
 // SliceStats provides statistical information about a slice
@@ -56,13 +227,89 @@ type SliceStats struct {
 	Length        int
 	Min           interface{}
 	Max           interface{}
+	Range         interface{}
 	Sum           interface{}
 	Average       interface{}
 	HasDuplicates bool
+	DistinctCount int
+	ZeroCount     int
+	NegativeCount int
+}
+
+// ExtendedStats provides distribution statistics about a numeric slice
+type ExtendedStats struct {
+	Length   int
+	Mean     float64
+	Median   float64
+	Mode     float64
+	Variance float64
+	StdDev   float64
+}
+
+// FloatStats provides summary statistics, quartiles and outliers of a float64 slice.
+// NaN values are excluded from every statistic and only counted in NaNCount.
+type FloatStats struct {
+	Length   int
+	NaNCount int
+	Min      float64
+	Max      float64
+	Range    float64
+	Sum      float64
+	Mean     float64
+	// Q1, Q2 and Q3 are the 25th, 50th (median) and 75th percentiles
+	Q1  float64
+	Q2  float64
+	Q3  float64
+	IQR float64
+	// Values outside [LowerFence, UpperFence] are outliers by the 1.5×IQR rule
+	LowerFence float64
+	UpperFence float64
+	Outliers   []float64
+}
+
+// HistogramBucket describes a histogram bucket covering the half-open range [Lower, Upper).
+// The last bucket of a histogram also includes its upper bound.
+type HistogramBucket struct {
+	Lower float64
+	Upper float64
+	Count int
 }
 
-// Memoization cache for struct comparisons to improve performance
+// PageInfo describes a page returned by Paginate. Page numbers start at 1.
+type PageInfo struct {
+	Page       int  `json:"page"`
+	PerPage    int  `json:"per_page"`
+	TotalItems int  `json:"total_items"`
+	TotalPages int  `json:"total_pages"`
+	HasNext    bool `json:"has_next"`
+	HasPrev    bool `json:"has_prev"`
+}
+
+// StructCacheConfig configures the memoization cache used by CompareStructs
+type StructCacheConfig struct {
+	// Enabled turns caching on; the cache is disabled by default
+	Enabled bool
+	// MaxEntries bounds the number of cached results; the least recently used
+	// result is evicted when the cache is full. Zero or less uses DefaultStructCacheSize.
+	MaxEntries int
+}
+
+// DefaultStructCacheSize is the number of entries kept by the struct cache when
+// StructCacheConfig.MaxEntries is not set
+const DefaultStructCacheSize = 1024
+
+// Memoization cache for struct comparisons to improve performance.
+// Entries are keyed by a content hash of both values and kept in LRU order.
 var structCache = struct {
-	sync.RWMutex
-	cache map[string]bool
-}{cache: make(map[string]bool)}
+	sync.Mutex
+	config    StructCacheConfig
+	cache     map[string]*list.Element
+	order     *list.List
+	hits      int
+	misses    int
+	evictions int
+}{
+	config: StructCacheConfig{MaxEntries: DefaultStructCacheSize},
+	cache:  make(map[string]*list.Element),
+	order:  list.New(),
+}
@@ -1,8 +1,12 @@
 package sliceutil
 
 import (
+	"math"
+	"sort"
+	"strings"
 	"testing"
 
+	"github.com/devrob-go/sliceutil/pkg/sliceutil/benchcmp"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -71,8 +75,8 @@ func TestCompareSlicesWithResult(t *testing.T) {
 
 		assert.False(t, result.Equal)
 		assert.Equal(t, "Slices have different lengths", result.Message)
-		assert.Equal(t, 3, result.Details["length_a"])
-		assert.Equal(t, 2, result.Details["length_b"])
+		assert.Equal(t, 3, result.Details.LengthA)
+		assert.Equal(t, 2, result.Details.LengthB)
 	})
 
 	t.Run("Different Values Slices", func(t *testing.T) {
@@ -82,8 +86,8 @@ func TestCompareSlicesWithResult(t *testing.T) {
 
 		assert.False(t, result.Equal)
 		assert.Equal(t, "Slices differ at specific indices", result.Message)
-		assert.Equal(t, 1, result.Details["difference_count"])
-		assert.Equal(t, []int{1}, result.Details["differences"])
+		assert.Equal(t, 1, result.Details.DifferenceCount)
+		assert.Equal(t, []int{1}, result.Details.DifferenceIndices)
 	})
 
 	t.Run("Nil Slices", func(t *testing.T) {
@@ -91,8 +95,8 @@ func TestCompareSlicesWithResult(t *testing.T) {
 
 		assert.False(t, result.Equal)
 		assert.Equal(t, "One slice is nil while the other is not", result.Message)
-		assert.True(t, result.Details["a_nil"].(bool))
-		assert.False(t, result.Details["b_nil"].(bool))
+		assert.True(t, result.Details.ANil)
+		assert.False(t, result.Details.BNil)
 	})
 }
 
@@ -157,6 +161,346 @@ func TestFindDifferencesWithCount(t *testing.T) {
 	})
 }
 
+// TestFindDifferencesFunc tests the FindDifferencesFunc function
+func TestFindDifferencesFunc(t *testing.T) {
+	type user struct {
+		ID    int
+		Name  string
+		Roles []string
+	}
+	byID := func(u user) int { return u.ID }
+
+	t.Run("Diff By Key Returns Full Structs", func(t *testing.T) {
+		oldUsers := []user{{1, "Ann", []string{"admin"}}, {2, "Bob", nil}, {3, "Cid", nil}}
+		newUsers := []user{{2, "Bob", []string{"dev"}}, {3, "Cid", nil}, {4, "Dee", nil}}
+
+		result := FindDifferencesFunc(oldUsers, newUsers, byID)
+		assert.Equal(t, []user{{1, "Ann", []string{"admin"}}, {4, "Dee", nil}}, result)
+	})
+
+	t.Run("Each Key Reported Once", func(t *testing.T) {
+		a := []user{{1, "first", nil}, {1, "second", nil}}
+		result := FindDifferencesFunc(a, []user{}, byID)
+		assert.Equal(t, []user{{1, "first", nil}}, result)
+	})
+
+	t.Run("Same Keys", func(t *testing.T) {
+		a := []user{{1, "Ann", nil}}
+		b := []user{{1, "Renamed", nil}}
+		assert.Empty(t, FindDifferencesFunc(a, b, byID))
+	})
+
+	t.Run("Nil Slices", func(t *testing.T) {
+		assert.Equal(t, []user{{5, "Eve", nil}}, FindDifferencesFunc(nil, []user{{5, "Eve", nil}}, byID))
+		assert.Equal(t, []user{}, FindDifferencesFunc[user, int](nil, nil, byID))
+	})
+}
+
+// TestFindDifferencesDetailed tests the FindDifferencesDetailed function
+func TestFindDifferencesDetailed(t *testing.T) {
+	t.Run("Splits By Side", func(t *testing.T) {
+		result := FindDifferencesDetailed([]int{1, 2, 3, 4}, []int{3, 4, 5, 6})
+
+		assert.Equal(t, []int{1, 2}, result.OnlyInA)
+		assert.Equal(t, []int{5, 6}, result.OnlyInB)
+		assert.Equal(t, []int{3, 4}, result.InBoth)
+	})
+
+	t.Run("Distinct Values In Order Of Appearance", func(t *testing.T) {
+		result := FindDifferencesDetailed([]string{"c", "a", "c", "b"}, []string{"z", "b", "y", "z"})
+
+		assert.Equal(t, []string{"c", "a"}, result.OnlyInA)
+		assert.Equal(t, []string{"z", "y"}, result.OnlyInB)
+		assert.Equal(t, []string{"b"}, result.InBoth)
+	})
+
+	t.Run("Agrees With FindDifferences", func(t *testing.T) {
+		a := []int{1, 2, 3, 4}
+		b := []int{3, 4, 5, 6}
+		result := FindDifferencesDetailed(a, b)
+
+		assert.ElementsMatch(t, FindDifferences(a, b), append(result.OnlyInA, result.OnlyInB...))
+	})
+
+	t.Run("Nil Slices", func(t *testing.T) {
+		result := FindDifferencesDetailed(nil, []int{1, 1})
+		assert.Equal(t, Differences[int]{OnlyInA: []int{}, OnlyInB: []int{1}, InBoth: []int{}}, result)
+
+		result = FindDifferencesDetailed[int](nil, nil)
+		assert.Equal(t, Differences[int]{OnlyInA: []int{}, OnlyInB: []int{}, InBoth: []int{}}, result)
+	})
+}
+
+// TestFindDifferencesByIndex tests the FindDifferencesByIndex function
+func TestFindDifferencesByIndex(t *testing.T) {
+	t.Run("Equal Length", func(t *testing.T) {
+		result := FindDifferencesByIndex([]int{1, 2, 3, 4}, []int{1, 5, 3, 6})
+
+		expected := []ElementDiff[int]{
+			{Index: 1, AValue: 2, BValue: 5},
+			{Index: 3, AValue: 4, BValue: 6},
+		}
+		assert.Equal(t, expected, result.Mismatches)
+		assert.Empty(t, result.ExtraInA)
+		assert.Empty(t, result.ExtraInB)
+	})
+
+	t.Run("Trailing Extras", func(t *testing.T) {
+		result := FindDifferencesByIndex([]string{"id", "name", "email"}, []string{"id", "full_name"})
+
+		assert.Equal(t, []ElementDiff[string]{{Index: 1, AValue: "name", BValue: "full_name"}}, result.Mismatches)
+		assert.Equal(t, []IndexedValue[string]{{Index: 2, Value: "email"}}, result.ExtraInA)
+		assert.Empty(t, result.ExtraInB)
+
+		result = FindDifferencesByIndex([]string{"id"}, []string{"id", "a", "b"})
+		assert.Empty(t, result.Mismatches)
+		assert.Equal(t, []IndexedValue[string]{{Index: 1, Value: "a"}, {Index: 2, Value: "b"}}, result.ExtraInB)
+	})
+
+	t.Run("Identical Slices", func(t *testing.T) {
+		result := FindDifferencesByIndex([]int{1, 2}, []int{1, 2})
+		assert.Equal(t, IndexDifferences[int]{
+			Mismatches: []ElementDiff[int]{},
+			ExtraInA:   []IndexedValue[int]{},
+			ExtraInB:   []IndexedValue[int]{},
+		}, result)
+	})
+
+	t.Run("Nil Slices", func(t *testing.T) {
+		result := FindDifferencesByIndex(nil, []int{7})
+		assert.Empty(t, result.Mismatches)
+		assert.Equal(t, []IndexedValue[int]{{Index: 0, Value: 7}}, result.ExtraInB)
+	})
+}
+
+// TestMissingFrom tests the MissingFrom function
+func TestMissingFrom(t *testing.T) {
+	t.Run("Reports Missing Elements With Indices", func(t *testing.T) {
+		a := []string{"x", "y", "z", "x"}
+		b := []string{"y"}
+		expected := []IndexedValue[string]{
+			{Index: 0, Value: "x"},
+			{Index: 2, Value: "z"},
+			{Index: 3, Value: "x"},
+		}
+
+		assert.Equal(t, expected, MissingFrom(a, b))
+	})
+
+	t.Run("Nothing Missing", func(t *testing.T) {
+		a := []int{1, 2}
+		b := []int{2, 1, 3}
+
+		assert.Empty(t, MissingFrom(a, b))
+	})
+
+	t.Run("Nil Slices", func(t *testing.T) {
+		assert.Empty(t, MissingFrom[int](nil, []int{1}))
+		assert.Equal(t, []IndexedValue[int]{{Index: 0, Value: 1}}, MissingFrom([]int{1}, nil))
+	})
+}
+
+// TestGenericNumericFunctions tests the generic Max, Min, Sum and Average functions
+func TestGenericNumericFunctions(t *testing.T) {
+	t.Run("Max And Min", func(t *testing.T) {
+		maxU8, err := Max([]uint8{4, 9, 1})
+		require.NoError(t, err)
+		assert.Equal(t, uint8(9), maxU8)
+
+		minF32, err := Min([]float32{2.5, 0.5, 1})
+		require.NoError(t, err)
+		assert.Equal(t, float32(0.5), minF32)
+
+		maxStr, err := Max([]string{"apple", "cherry", "banana"})
+		require.NoError(t, err)
+		assert.Equal(t, "cherry", maxStr)
+	})
+
+	t.Run("Sum And Average", func(t *testing.T) {
+		sum, err := Sum([]int64{1, 2, 3})
+		require.NoError(t, err)
+		assert.Equal(t, int64(6), sum)
+
+		avg, err := Average([]uint{1, 2, 3, 4})
+		require.NoError(t, err)
+		assert.Equal(t, 2.5, avg)
+
+		avg, err = Average([]int8{-4, 2})
+		require.NoError(t, err)
+		assert.Equal(t, -1.0, avg)
+	})
+
+	t.Run("Average Does Not Overflow", func(t *testing.T) {
+		avg, err := Average([]int8{100, 100})
+		require.NoError(t, err)
+		assert.Equal(t, 100.0, avg)
+
+		avg, err = Average([]int8{math.MinInt8, math.MinInt8, math.MaxInt8})
+		require.NoError(t, err)
+		assert.InDelta(t, -43.0, avg, 1e-9)
+
+		avg, err = Average([]uint8{math.MaxUint8, math.MaxUint8, 1})
+		require.NoError(t, err)
+		assert.InDelta(t, 511.0/3, avg, 1e-9)
+
+		avg, err = Average([]int64{math.MaxInt64, math.MaxInt64})
+		require.NoError(t, err)
+		assert.Equal(t, float64(math.MaxInt64), avg)
+
+		avg, err = Average([]uint64{math.MaxUint64, math.MaxUint64, 0, 0})
+		require.NoError(t, err)
+		assert.Equal(t, float64(math.MaxUint64)/2, avg)
+
+		avg, err = Average([]int64{math.MinInt64, math.MinInt64})
+		require.NoError(t, err)
+		assert.Equal(t, float64(math.MinInt64), avg)
+	})
+
+	t.Run("Named Types", func(t *testing.T) {
+		type celsius float64
+		max, err := Max([]celsius{20.5, 31, 18})
+		require.NoError(t, err)
+		assert.Equal(t, celsius(31), max)
+
+		sum, err := Sum([]celsius{1.5, 2.5})
+		require.NoError(t, err)
+		assert.Equal(t, celsius(4), sum)
+	})
+
+	t.Run("Nil and Empty", func(t *testing.T) {
+		_, err := Max[int16](nil)
+		assert.Equal(t, ErrNilSlice, err)
+		_, err = Min([]uint32{})
+		assert.Equal(t, ErrEmptySlice, err)
+		_, err = Sum[float32](nil)
+		assert.Equal(t, ErrNilSlice, err)
+		sum, err := Sum([]int{})
+		assert.NoError(t, err)
+		assert.Equal(t, 0, sum)
+		_, err = Average([]uint64{})
+		assert.Equal(t, ErrEmptySlice, err)
+	})
+}
+
+// TestMaxByMinBy tests the MaxBy and MinBy functions
+func TestMaxByMinBy(t *testing.T) {
+	type product struct {
+		Name  string
+		Price float64
+	}
+	products := []product{{"pen", 1.5}, {"book", 12}, {"lamp", 30}, {"mug", 1.5}, {"desk", 30}}
+	price := func(p product) float64 { return p.Price }
+
+	t.Run("MaxBy", func(t *testing.T) {
+		best, index, err := MaxBy(products, price)
+		require.NoError(t, err)
+		assert.Equal(t, "lamp", best.Name) // Earliest of the ties
+		assert.Equal(t, 2, index)
+	})
+
+	t.Run("MinBy", func(t *testing.T) {
+		cheapest, index, err := MinBy(products, price)
+		require.NoError(t, err)
+		assert.Equal(t, "pen", cheapest.Name)
+		assert.Equal(t, 0, index)
+	})
+
+	t.Run("Key Called Once Per Element", func(t *testing.T) {
+		calls := 0
+		_, _, err := MaxBy(products, func(p product) string { calls++; return p.Name })
+		require.NoError(t, err)
+		assert.Equal(t, len(products), calls)
+	})
+
+	t.Run("Nil and Empty", func(t *testing.T) {
+		_, index, err := MaxBy(nil, price)
+		assert.Equal(t, ErrNilSlice, err)
+		assert.Equal(t, -1, index)
+
+		_, index, err = MinBy([]product{}, price)
+		assert.Equal(t, ErrEmptySlice, err)
+		assert.Equal(t, -1, index)
+	})
+}
+
+// TestArgMaxArgMin tests the ArgMax and ArgMin functions
+func TestArgMaxArgMin(t *testing.T) {
+	t.Run("Indices Of Extremes", func(t *testing.T) {
+		index, err := ArgMax([]float64{0.2, 0.9, 0.4})
+		require.NoError(t, err)
+		assert.Equal(t, 1, index)
+
+		index, err = ArgMin([]int{3, 1, 2, 1})
+		require.NoError(t, err)
+		assert.Equal(t, 1, index) // First occurrence
+	})
+
+	t.Run("Strings", func(t *testing.T) {
+		index, err := ArgMax([]string{"b", "c", "a"})
+		require.NoError(t, err)
+		assert.Equal(t, 1, index)
+	})
+
+	t.Run("Nil and Empty", func(t *testing.T) {
+		index, err := ArgMax[int](nil)
+		assert.Equal(t, ErrNilSlice, err)
+		assert.Equal(t, -1, index)
+
+		index, err = ArgMin([]int{})
+		assert.Equal(t, ErrEmptySlice, err)
+		assert.Equal(t, -1, index)
+	})
+}
+
+// TestSumByAverageBy tests the SumBy and AverageBy functions
+func TestSumByAverageBy(t *testing.T) {
+	type order struct {
+		ID     int
+		Amount float64
+		Items  int
+	}
+	orders := []order{{1, 10.5, 2}, {2, 4.5, 1}, {3, 30, 3}}
+
+	t.Run("SumBy", func(t *testing.T) {
+		total, err := SumBy(orders, func(o order) float64 { return o.Amount })
+		require.NoError(t, err)
+		assert.Equal(t, 45.0, total)
+
+		items, err := SumBy(orders, func(o order) int { return o.Items })
+		require.NoError(t, err)
+		assert.Equal(t, 6, items)
+	})
+
+	t.Run("AverageBy", func(t *testing.T) {
+		avg, err := AverageBy(orders, func(o order) float64 { return o.Amount })
+		require.NoError(t, err)
+		assert.Equal(t, 15.0, avg)
+
+		avgItems, err := AverageBy(orders, func(o order) int { return o.Items })
+		require.NoError(t, err)
+		assert.Equal(t, 2.0, avgItems)
+
+		avgSmall, err := AverageBy(orders, func(o order) uint8 { return math.MaxUint8 })
+		require.NoError(t, err)
+		assert.Equal(t, 255.0, avgSmall)
+	})
+
+	t.Run("Nil and Empty", func(t *testing.T) {
+		amount := func(o order) float64 { return o.Amount }
+
+		_, err := SumBy(nil, amount)
+		assert.Equal(t, ErrNilSlice, err)
+		sum, err := SumBy([]order{}, amount)
+		assert.NoError(t, err)
+		assert.Equal(t, 0.0, sum)
+
+		_, err = AverageBy(nil, amount)
+		assert.Equal(t, ErrNilSlice, err)
+		_, err = AverageBy([]order{}, amount)
+		assert.Equal(t, ErrEmptySlice, err)
+	})
+}
+
 // TestMaxMinInt tests the MaxInt and MinInt functions
 func TestMaxMinInt(t *testing.T) {
 	t.Run("MaxInt Success", func(t *testing.T) {
@@ -229,6 +573,52 @@ func TestFloat64Functions(t *testing.T) {
 		require.NoError(t, err)
 		assert.Equal(t, 2.5, avg)
 	})
+
+	t.Run("SumFloat64Kahan Cancellation", func(t *testing.T) {
+		// Naive summation loses the 1 when it is added to 1e16
+		slice := []float64{1e16, 1, -1e16}
+		naive, _ := SumFloat64(slice)
+		assert.Equal(t, 0.0, naive)
+
+		sum, err := SumFloat64Kahan(slice)
+		require.NoError(t, err)
+		assert.Equal(t, 1.0, sum)
+
+		avg, err := AverageFloat64(slice)
+		require.NoError(t, err)
+		assert.Equal(t, 1.0/3, avg)
+	})
+
+	t.Run("SumFloat64Kahan Many Small Values", func(t *testing.T) {
+		slice := Repeat(0.1, 1_000_000)
+		naive, _ := SumFloat64(slice)
+		assert.NotEqual(t, 100000.0, naive)
+
+		sum, err := SumFloat64Kahan(slice)
+		require.NoError(t, err)
+		assert.Equal(t, 100000.0, sum)
+	})
+
+	t.Run("SumFloat64Kahan Special Values", func(t *testing.T) {
+		sum, err := SumFloat64Kahan([]float64{math.Inf(1), 1})
+		require.NoError(t, err)
+		assert.True(t, math.IsInf(sum, 1))
+
+		sum, _ = SumFloat64Kahan([]float64{math.Inf(1), math.Inf(-1)})
+		assert.True(t, math.IsNaN(sum))
+
+		sum, _ = SumFloat64Kahan([]float64{1, math.NaN()})
+		assert.True(t, math.IsNaN(sum))
+	})
+
+	t.Run("SumFloat64Kahan Nil and Empty", func(t *testing.T) {
+		_, err := SumFloat64Kahan(nil)
+		assert.ErrorIs(t, err, ErrNilSlice)
+
+		sum, err := SumFloat64Kahan([]float64{})
+		require.NoError(t, err)
+		assert.Equal(t, 0.0, sum)
+	})
 }
 
 // TestSumAndAverage tests the sum and average functions
@@ -246,6 +636,37 @@ func TestSumAndAverage(t *testing.T) {
 		assert.ErrorIs(t, err, ErrNilSlice)
 	})
 
+	t.Run("SumIntChecked", func(t *testing.T) {
+		sum, err := SumIntChecked([]int{1, 2, 3})
+		require.NoError(t, err)
+		assert.Equal(t, 6, sum)
+
+		sum, err = SumIntChecked([]int{math.MaxInt, math.MinInt})
+		require.NoError(t, err)
+		assert.Equal(t, -1, sum)
+
+		_, err = SumIntChecked([]int{math.MaxInt, 1})
+		assert.ErrorIs(t, err, ErrOverflow)
+		_, err = SumIntChecked([]int{math.MinInt, -1})
+		assert.ErrorIs(t, err, ErrOverflow)
+
+		_, err = SumIntChecked(nil)
+		assert.ErrorIs(t, err, ErrNilSlice)
+	})
+
+	t.Run("SumBig", func(t *testing.T) {
+		total := SumBig([]int64{math.MaxInt64, math.MaxInt64, 2})
+		assert.Equal(t, "18446744073709551616", total.String())
+
+		total = SumBig([]int64{math.MinInt64, math.MinInt64, math.MaxInt64})
+		assert.Equal(t, "-9223372036854775809", total.String())
+
+		total = SumBig([]int64{math.MaxInt64, math.MinInt64, 5})
+		assert.Equal(t, "4", total.String())
+
+		assert.Equal(t, "0", SumBig(nil).String())
+	})
+
 	t.Run("AverageInt", func(t *testing.T) {
 		slice := []int{1, 2, 3, 4, 5}
 		avg, err := AverageInt(slice)
@@ -287,6 +708,55 @@ func TestCompareSum(t *testing.T) {
 		result := CompareSum(nil, []int{1, 2, 3})
 		assert.Equal(t, ResultBGreater, result)
 	})
+
+	t.Run("Generic Numeric Types", func(t *testing.T) {
+		assert.Equal(t, ResultAGreater, CompareSum([]float64{0.5, 0.25}, []float64{0.7}))
+		assert.Equal(t, ResultBGreater, CompareSum([]uint8{1, 2}, []uint8{4}))
+		assert.Equal(t, ResultEqual, CompareSum([]int64{-1, 1}, nil))
+	})
+
+	t.Run("Small Integer Types Do Not Wrap", func(t *testing.T) {
+		assert.Equal(t, ResultAGreater, CompareSum([]int8{100, 100}, []int8{1}))
+		assert.Equal(t, ResultAGreater, CompareSum([]uint8{200, 100}, []uint8{50}))
+		assert.Equal(t, ResultBGreater, CompareSum([]int8{math.MinInt8, -1}, []int8{math.MinInt8}))
+		assert.Equal(t, ResultAGreater, CompareSum([]uint64{math.MaxUint64, 1}, []uint64{math.MaxUint64}))
+
+		details := CompareSumWithDetails([]int8{100, 100}, []int8{1}).Details
+		assert.Equal(t, 200.0, details.SumA)
+		assert.Equal(t, 199.0, details.SumDifference)
+	})
+}
+
+// TestCompareSumWithOptions tests the CompareSumWithOptions function
+func TestCompareSumWithOptions(t *testing.T) {
+	t.Run("Default Treats Nil As Zero", func(t *testing.T) {
+		result, err := CompareSumWithOptions(nil, []int{1})
+		require.NoError(t, err)
+		assert.Equal(t, ResultBGreater, result)
+
+		result, err = CompareSumWithOptions([]int{-1}, nil, WithNilPolicy(NilAsZero))
+		require.NoError(t, err)
+		assert.Equal(t, ResultBGreater, result)
+	})
+
+	t.Run("Error On Nil", func(t *testing.T) {
+		_, err := CompareSumWithOptions(nil, []int{1}, WithNilPolicy(NilError))
+		assert.ErrorIs(t, err, ErrNilSlice)
+		assert.Contains(t, err.Error(), "slice A")
+
+		_, err = CompareSumWithOptions([]float64{1}, nil, WithNilPolicy(NilError))
+		assert.ErrorIs(t, err, ErrNilSlice)
+		assert.Contains(t, err.Error(), "slice B")
+
+		result, err := CompareSumWithOptions([]int{}, []int{}, WithNilPolicy(NilError))
+		require.NoError(t, err)
+		assert.Equal(t, ResultEqual, result)
+	})
+
+	t.Run("Invalid Policy", func(t *testing.T) {
+		_, err := CompareSumWithOptions([]int{1}, []int{1}, WithNilPolicy("OTHER"))
+		assert.ErrorIs(t, err, ErrInvalidPolicy)
+	})
 }
 
 // TestCompareSumWithDetails tests the CompareSumWithDetails function
@@ -298,10 +768,36 @@ func TestCompareSumWithDetails(t *testing.T) {
 
 		assert.False(t, result.Equal)
 		assert.Equal(t, "Slice B has greater sum", result.Message)
-		assert.Equal(t, 6, result.Details["sum_a"])
-		assert.Equal(t, 15, result.Details["sum_b"])
-		assert.Equal(t, -9, result.Details["difference"])
-		assert.Equal(t, ResultBGreater, result.Details["result"])
+		assert.Equal(t, 6.0, result.Details.SumA)
+		assert.Equal(t, 15.0, result.Details.SumB)
+		assert.Equal(t, -9.0, result.Details.SumDifference)
+		assert.Equal(t, ResultBGreater, result.Details.Outcome)
+		assert.Empty(t, result.Details.ErrorA)
+		assert.Empty(t, result.Details.NilPolicy)
+	})
+
+	t.Run("Unsigned Difference Does Not Wrap", func(t *testing.T) {
+		result := CompareSumWithDetails([]uint{1}, []uint{3})
+		assert.Equal(t, -2.0, result.Details.SumDifference)
+	})
+
+	t.Run("Nil Slice", func(t *testing.T) {
+		result := CompareSumWithDetails(nil, []int{1})
+
+		assert.Equal(t, ErrNilSlice.Error(), result.Details.ErrorA)
+		assert.Equal(t, 0.0, result.Details.SumA)
+		assert.Equal(t, ResultBGreater, result.Details.Outcome)
+		assert.Equal(t, NilAsZero, result.Details.NilPolicy)
+	})
+
+	t.Run("Nil Slice With Error Policy", func(t *testing.T) {
+		result := CompareSumWithDetails([]int{1}, nil, WithNilPolicy(NilError))
+
+		assert.False(t, result.Equal)
+		assert.Contains(t, result.Message, "slice B")
+		assert.Equal(t, ErrNilSlice.Error(), result.Details.ErrorB)
+		assert.Equal(t, NilError, result.Details.NilPolicy)
+		assert.Empty(t, result.Details.Outcome)
 	})
 }
 
@@ -318,6 +814,10 @@ func TestGetSliceStats(t *testing.T) {
 		assert.Equal(t, 15, stats.Sum)
 		assert.Equal(t, 3.0, stats.Average)
 		assert.False(t, stats.HasDuplicates)
+		assert.Equal(t, 4, stats.Range)
+		assert.Equal(t, 5, stats.DistinctCount)
+		assert.Equal(t, 0, stats.ZeroCount)
+		assert.Equal(t, 0, stats.NegativeCount)
 	})
 
 	t.Run("Slice with Duplicates", func(t *testing.T) {
@@ -326,6 +826,30 @@ func TestGetSliceStats(t *testing.T) {
 
 		require.NoError(t, err)
 		assert.True(t, stats.HasDuplicates)
+		assert.Equal(t, 3, stats.DistinctCount)
+	})
+
+	t.Run("Zero and Negative Values", func(t *testing.T) {
+		slice := []int{-5, 0, 3, 0, -1, 3}
+		stats, err := GetSliceStats(slice)
+
+		require.NoError(t, err)
+		assert.Equal(t, -5, stats.Min)
+		assert.Equal(t, 3, stats.Max)
+		assert.Equal(t, 8, stats.Range)
+		assert.Equal(t, 0, stats.Sum)
+		assert.Equal(t, 4, stats.DistinctCount)
+		assert.Equal(t, 2, stats.ZeroCount)
+		assert.Equal(t, 2, stats.NegativeCount)
+	})
+
+	t.Run("Single Element", func(t *testing.T) {
+		stats, err := GetSliceStats([]int{7})
+
+		require.NoError(t, err)
+		assert.Equal(t, 0, stats.Range)
+		assert.Equal(t, 1, stats.DistinctCount)
+		assert.False(t, stats.HasDuplicates)
 	})
 
 	t.Run("Empty Slice", func(t *testing.T) {
@@ -340,6 +864,23 @@ func TestGetSliceStats(t *testing.T) {
 		_, err := GetSliceStats(nil)
 		assert.ErrorIs(t, err, ErrNilSlice)
 	})
+
+	t.Run("Checked Arithmetic", func(t *testing.T) {
+		overflowingSum := []int{math.MaxInt, 1}
+		_, err := GetSliceStats(overflowingSum)
+		assert.NoError(t, err)
+		_, err = GetSliceStats(overflowingSum, WithCheckedArithmetic())
+		assert.ErrorIs(t, err, ErrOverflow)
+
+		overflowingRange := []int{math.MinInt, math.MaxInt}
+		_, err = GetSliceStats(overflowingRange, WithCheckedArithmetic())
+		assert.ErrorIs(t, err, ErrOverflow)
+
+		stats, err := GetSliceStats([]int{math.MaxInt, 0}, WithCheckedArithmetic())
+		require.NoError(t, err)
+		assert.Equal(t, math.MaxInt, stats.Sum)
+		assert.Equal(t, math.MaxInt, stats.Range)
+	})
 }
 
 // TestSortingFunctions tests the sorting utility functions
@@ -357,6 +898,53 @@ func TestSortingFunctions(t *testing.T) {
 		assert.True(t, IsSortedString([]string{}))
 		assert.True(t, IsSortedString(nil))
 	})
+
+	t.Run("IsSorted", func(t *testing.T) {
+		assert.True(t, IsSorted(sort.IntSlice{1, 2, 2, 3}))
+		assert.False(t, IsSorted(sort.StringSlice{"b", "a"}))
+		assert.True(t, IsSorted(sort.Float64Slice(nil)))
+	})
+
+	t.Run("IsSortedOrder", func(t *testing.T) {
+		assert.True(t, IsSortedOrder([]float64{1, 2, 2, 3.5}, OrderAsc))
+		assert.False(t, IsSortedOrder([]float64{1, 3, 2}, OrderAsc))
+		assert.True(t, IsSortedOrder([]float64{3.5, 2, 2, 1}, OrderDesc))
+		assert.False(t, IsSortedOrder([]float64{1, 2}, OrderDesc))
+		assert.True(t, IsSortedOrder([]int{3, 1, 2}, OrderNone))
+		assert.True(t, IsSortedOrder([]string{}, OrderDesc))
+		assert.True(t, IsSortedOrder[int](nil, OrderAsc))
+	})
+
+	t.Run("Sort Orders NaN", func(t *testing.T) {
+		slice := []float64{2, math.NaN(), -1}
+		Sort(slice, OrderAsc)
+		assert.True(t, math.IsNaN(slice[0]))
+		assert.Equal(t, []float64{-1, 2}, slice[1:])
+
+		Sort(slice, OrderDesc)
+		assert.Equal(t, []float64{2, -1}, slice[:2])
+		assert.True(t, math.IsNaN(slice[2]))
+	})
+
+	t.Run("IsSortedOrder After Sort", func(t *testing.T) {
+		for _, order := range []OrderType{OrderAsc, OrderDesc} {
+			slice := []float64{2, math.NaN(), -1, 7, 2}
+			Sort(slice, order)
+			assert.True(t, IsSortedOrder(slice, order))
+		}
+	})
+
+	t.Run("IsSortedFunc", func(t *testing.T) {
+		type person struct {
+			Name string
+			Age  int
+		}
+		byAge := func(a, b person) bool { return a.Age < b.Age }
+
+		assert.True(t, IsSortedFunc([]person{{"a", 20}, {"b", 20}, {"c", 31}}, byAge))
+		assert.False(t, IsSortedFunc([]person{{"a", 40}, {"b", 31}}, byAge))
+		assert.True(t, IsSortedFunc(nil, byAge))
+	})
 }
 
 // TestReverseFunctions tests the reverse utility functions
@@ -382,6 +970,83 @@ func TestReverseFunctions(t *testing.T) {
 	})
 }
 
+// TestRotateFunctions tests the Rotate and RotateCopy functions
+func TestRotateFunctions(t *testing.T) {
+	t.Run("Rotate Left", func(t *testing.T) {
+		slice := []int{1, 2, 3, 4, 5}
+		Rotate(slice, 2)
+		assert.Equal(t, []int{3, 4, 5, 1, 2}, slice)
+	})
+
+	t.Run("Rotate Right With Negative K", func(t *testing.T) {
+		slice := []int{1, 2, 3, 4, 5}
+		Rotate(slice, -1)
+		assert.Equal(t, []int{5, 1, 2, 3, 4}, slice)
+	})
+
+	t.Run("K Wraps Around", func(t *testing.T) {
+		slice := []int{1, 2, 3}
+		Rotate(slice, 7)
+		assert.Equal(t, []int{2, 3, 1}, slice)
+
+		Rotate(slice, -7)
+		assert.Equal(t, []int{1, 2, 3}, slice)
+
+		Rotate(slice, 3)
+		assert.Equal(t, []int{1, 2, 3}, slice)
+	})
+
+	t.Run("RotateCopy", func(t *testing.T) {
+		original := []string{"a", "b", "c"}
+		rotated := RotateCopy(original, 1)
+
+		assert.Equal(t, []string{"b", "c", "a"}, rotated)
+		assert.Equal(t, []string{"a", "b", "c"}, original) // Original unchanged
+	})
+
+	t.Run("Rotate Nil and Empty", func(t *testing.T) {
+		Rotate[int](nil, 3) // Should not panic
+		Rotate([]int{}, 3)  // Should not panic
+		assert.Nil(t, RotateCopy[int](nil, 1))
+	})
+}
+
+// TestSwapFunctions tests the Swap and SwapRanges functions
+func TestSwapFunctions(t *testing.T) {
+	t.Run("Swap", func(t *testing.T) {
+		slice := []int{1, 2, 3}
+		require.NoError(t, Swap(slice, 0, 2))
+		assert.Equal(t, []int{3, 2, 1}, slice)
+
+		assert.ErrorIs(t, Swap(slice, 0, 3), ErrOutOfRange)
+		assert.ErrorIs(t, Swap(slice, -1, 0), ErrOutOfRange)
+	})
+
+	t.Run("SwapRanges", func(t *testing.T) {
+		slice := []int{1, 2, 3, 4, 5, 6}
+		require.NoError(t, SwapRanges(slice, 0, 4, 2))
+		assert.Equal(t, []int{5, 6, 3, 4, 1, 2}, slice)
+	})
+
+	t.Run("SwapRanges Adjacent and Empty", func(t *testing.T) {
+		slice := []int{1, 2, 3, 4}
+		require.NoError(t, SwapRanges(slice, 0, 2, 2))
+		assert.Equal(t, []int{3, 4, 1, 2}, slice)
+
+		require.NoError(t, SwapRanges(slice, 1, 1, 0))
+		require.NoError(t, SwapRanges(slice, 1, 1, 2)) // Same range is a no-op
+		assert.Equal(t, []int{3, 4, 1, 2}, slice)
+	})
+
+	t.Run("SwapRanges Invalid", func(t *testing.T) {
+		slice := []int{1, 2, 3, 4}
+		assert.ErrorIs(t, SwapRanges(slice, 0, 1, 2), ErrOutOfRange) // Overlap
+		assert.ErrorIs(t, SwapRanges(slice, 0, 3, 2), ErrOutOfRange) // Past the end
+		assert.ErrorIs(t, SwapRanges(slice, 0, 2, -1), ErrOutOfRange)
+		assert.Equal(t, []int{1, 2, 3, 4}, slice) // Unchanged
+	})
+}
+
 // TestRemoveDuplicates tests the RemoveDuplicates function
 func TestRemoveDuplicates(t *testing.T) {
 	t.Run("Remove Duplicates", func(t *testing.T) {
@@ -406,6 +1071,119 @@ func TestRemoveDuplicates(t *testing.T) {
 	})
 }
 
+// TestRemoveDuplicatesWithPolicy tests the RemoveDuplicatesWithPolicy function
+func TestRemoveDuplicatesWithPolicy(t *testing.T) {
+	t.Run("Keep First", func(t *testing.T) {
+		slice := []int{1, 2, 1, 3, 2}
+		result := RemoveDuplicatesWithPolicy(slice, KeepFirst)
+
+		assert.Equal(t, []int{1, 2, 3}, result)
+		assert.Equal(t, RemoveDuplicates(slice), result)
+	})
+
+	t.Run("Keep Last", func(t *testing.T) {
+		slice := []int{1, 2, 1, 3, 2}
+		result := RemoveDuplicatesWithPolicy(slice, KeepLast)
+
+		assert.Equal(t, []int{1, 3, 2}, result)
+		assert.Equal(t, []int{1, 2, 1, 3, 2}, slice) // Original unchanged
+	})
+
+	t.Run("Unknown Policy Falls Back To Keep First", func(t *testing.T) {
+		result := RemoveDuplicatesWithPolicy([]string{"a", "b", "a"}, KeepPolicy("OTHER"))
+		assert.Equal(t, []string{"a", "b"}, result)
+	})
+
+	t.Run("Nil and Empty", func(t *testing.T) {
+		assert.Nil(t, RemoveDuplicatesWithPolicy[int](nil, KeepLast))
+		assert.Empty(t, RemoveDuplicatesWithPolicy([]int{}, KeepLast))
+		assert.Equal(t, []int{1}, RemoveDuplicatesWithPolicy([]int{1}, KeepLast))
+	})
+}
+
+// TestDistinctBy tests the DistinctBy function
+func TestDistinctBy(t *testing.T) {
+	type event struct {
+		ID  int
+		Seq int
+	}
+	id := func(e event) int { return e.ID }
+	events := []event{{1, 1}, {2, 2}, {1, 3}, {3, 4}, {2, 5}}
+
+	t.Run("Keep First", func(t *testing.T) {
+		result := DistinctBy(events, id, KeepFirst)
+		assert.Equal(t, []event{{1, 1}, {2, 2}, {3, 4}}, result)
+	})
+
+	t.Run("Keep Last", func(t *testing.T) {
+		result := DistinctBy(events, id, KeepLast)
+		assert.Equal(t, []event{{1, 3}, {3, 4}, {2, 5}}, result)
+	})
+
+	t.Run("Non-Comparable Elements", func(t *testing.T) {
+		rows := [][]int{{1, 2}, {3}, {4, 5}}
+		result := DistinctBy(rows, func(r []int) int { return len(r) }, KeepLast)
+		assert.Equal(t, [][]int{{3}, {4, 5}}, result)
+	})
+
+	t.Run("Nil and Empty", func(t *testing.T) {
+		assert.Nil(t, DistinctBy(nil, id, KeepLast))
+		assert.Empty(t, DistinctBy([]event{}, id, KeepLast))
+	})
+}
+
+// TestRemoveDuplicatesFunc tests the key-based deduplication functions
+func TestRemoveDuplicatesFunc(t *testing.T) {
+	type user struct {
+		ID   int
+		Name string
+	}
+	id := func(u user) int { return u.ID }
+	users := []user{{1, "Alice"}, {2, "Bob"}, {1, "Alicia"}, {3, "Carol"}, {2, "Robert"}}
+
+	t.Run("Keeps First Occurrence", func(t *testing.T) {
+		result := RemoveDuplicatesFunc(users, id)
+		assert.Equal(t, []user{{1, "Alice"}, {2, "Bob"}, {3, "Carol"}}, result)
+	})
+
+	t.Run("Non-Comparable Elements", func(t *testing.T) {
+		slices := [][]int{{1, 2}, {3}, {1, 2}}
+		result := RemoveDuplicatesFunc(slices, func(s []int) int { return len(s) })
+		assert.Equal(t, [][]int{{1, 2}, {3}}, result)
+	})
+
+	t.Run("Nil and Empty", func(t *testing.T) {
+		assert.Nil(t, RemoveDuplicatesFunc(nil, id))
+		assert.Empty(t, RemoveDuplicatesFunc([]user{}, id))
+	})
+}
+
+// TestCompact tests the Compact and CompactFunc functions
+func TestCompact(t *testing.T) {
+	t.Run("Removes Adjacent Duplicates", func(t *testing.T) {
+		s := []int{1, 1, 2, 2, 1}
+		assert.Equal(t, []int{1, 2, 1}, Compact(s))
+		assert.Equal(t, []int{1, 1, 2, 2, 1}, s)
+	})
+
+	t.Run("Result Does Not Alias Input", func(t *testing.T) {
+		s := []int{1, 1, 2}
+		result := Compact(s)
+		result = append(result, 9)
+		assert.Equal(t, []int{1, 2, 9}, result)
+		assert.Equal(t, []int{1, 1, 2}, s)
+	})
+
+	t.Run("CompactFunc", func(t *testing.T) {
+		assert.Equal(t, []string{"Go", "Rust"}, CompactFunc([]string{"Go", "go", "Rust"}, strings.EqualFold))
+	})
+
+	t.Run("Nil and Empty", func(t *testing.T) {
+		assert.Nil(t, Compact[int](nil))
+		assert.Equal(t, []int{}, Compact([]int{}))
+	})
+}
+
 // TestSearchFunctions tests the search utility functions
 func TestSearchFunctions(t *testing.T) {
 	t.Run("Contains", func(t *testing.T) {
@@ -415,6 +1193,15 @@ func TestSearchFunctions(t *testing.T) {
 		assert.False(t, Contains[int](nil, 1))
 	})
 
+	t.Run("ContainsAll", func(t *testing.T) {
+		slice := []string{"read", "write", "admin"}
+		assert.True(t, ContainsAll(slice, "read", "write"))
+		assert.False(t, ContainsAll(slice, "read", "delete"))
+		assert.True(t, ContainsAll(slice))
+		assert.True(t, ContainsAll(slice, NewSet("admin").ToSlice()...))
+		assert.False(t, ContainsAll[string](nil, "read"))
+	})
+
 	t.Run("IndexOf", func(t *testing.T) {
 		slice := []int{1, 2, 3, 4, 5}
 		assert.Equal(t, 2, IndexOf(slice, 3))
@@ -422,6 +1209,53 @@ func TestSearchFunctions(t *testing.T) {
 		assert.Equal(t, -1, IndexOf[int](nil, 1))
 	})
 
+	t.Run("LastIndexOf", func(t *testing.T) {
+		slice := []int{1, 2, 3, 2, 1}
+		assert.Equal(t, 3, LastIndexOf(slice, 2))
+		assert.Equal(t, 4, LastIndexOf(slice, 1))
+		assert.Equal(t, -1, LastIndexOf(slice, 6))
+		assert.Equal(t, -1, LastIndexOf[int](nil, 1))
+	})
+
+	t.Run("IndexOfFunc", func(t *testing.T) {
+		slice := []int{1, 4, 3, 6}
+		isEven := func(v int) bool { return v%2 == 0 }
+		assert.Equal(t, 1, IndexOfFunc(slice, isEven))
+		assert.Equal(t, 3, LastIndexOfFunc(slice, isEven))
+		assert.Equal(t, -1, IndexOfFunc(slice, func(v int) bool { return v > 10 }))
+		assert.Equal(t, -1, LastIndexOfFunc[int](nil, isEven))
+	})
+
+	t.Run("FindFirst and FindLast", func(t *testing.T) {
+		type user struct {
+			Name string
+			Role string
+		}
+		users := []user{{"Alice", "dev"}, {"Bob", "admin"}, {"Carol", "admin"}}
+		isAdmin := func(u user) bool { return u.Role == "admin" }
+
+		first, ok := FindFirst(users, isAdmin)
+		assert.True(t, ok)
+		assert.Equal(t, "Bob", first.Name)
+
+		last, ok := FindLast(users, isAdmin)
+		assert.True(t, ok)
+		assert.Equal(t, "Carol", last.Name)
+
+		missing, ok := FindFirst(users, func(u user) bool { return u.Role == "owner" })
+		assert.False(t, ok)
+		assert.Equal(t, user{}, missing)
+
+		_, ok = FindLast[user](nil, isAdmin)
+		assert.False(t, ok)
+	})
+
+	t.Run("AllIndicesOf", func(t *testing.T) {
+		assert.Equal(t, []int{0, 2, 4}, AllIndicesOf([]int{1, 2, 1, 3, 1}, 1))
+		assert.Empty(t, AllIndicesOf([]int{1, 2}, 5))
+		assert.NotNil(t, AllIndicesOf[int](nil, 1))
+	})
+
 	t.Run("CountOccurrences", func(t *testing.T) {
 		slice := []int{1, 2, 2, 3, 2, 4}
 		assert.Equal(t, 3, CountOccurrences(slice, 2))
@@ -437,17 +1271,75 @@ func TestErrorConstants(t *testing.T) {
 	assert.NotNil(t, ErrNilSlice)
 	assert.NotNil(t, ErrTypeMismatch)
 	assert.NotNil(t, ErrUnsupportedType)
+	assert.NotNil(t, ErrSizeOverflow)
+	assert.NotNil(t, ErrOutOfRange)
+	assert.NotNil(t, ErrNotSorted)
+	assert.NotNil(t, ErrLengthMismatch)
+	assert.NotNil(t, ErrOverflow)
+	assert.NotNil(t, ErrNotJSONArray)
+	assert.NotNil(t, ErrInvalidEncoding)
 
 	assert.Equal(t, "slice cannot be empty", ErrEmptySlice.Error())
 	assert.Equal(t, "slice cannot be nil", ErrNilSlice.Error())
 	assert.Equal(t, "slice types do not match", ErrTypeMismatch.Error())
 	assert.Equal(t, "unsupported slice type", ErrUnsupportedType.Error())
+	assert.Equal(t, "result size overflows int", ErrSizeOverflow.Error())
+	assert.Equal(t, "integer overflow", ErrOverflow.Error())
+	assert.Equal(t, "invalid conflict policy", ErrInvalidPolicy.Error())
+	assert.Equal(t, "input is not a JSON array", ErrNotJSONArray.Error())
+	assert.Equal(t, "invalid slice encoding", ErrInvalidEncoding.Error())
 }
 
 // TestOrderTypeConstants tests that order type constants are properly defined
 func TestOrderTypeConstants(t *testing.T) {
 	assert.Equal(t, OrderType("ASC"), OrderAsc)
 	assert.Equal(t, OrderType("DESC"), OrderDesc)
+	assert.Equal(t, OrderType("NONE"), OrderNone)
+}
+
+// TestKeepPolicyConstants tests that keep policy constants are properly defined
+func TestKeepPolicyConstants(t *testing.T) {
+	assert.Equal(t, KeepPolicy("FIRST"), KeepFirst)
+	assert.Equal(t, KeepPolicy("LAST"), KeepLast)
+}
+
+// TestConflictPolicyConstants tests that conflict policy constants are properly defined
+func TestConflictPolicyConstants(t *testing.T) {
+	assert.Equal(t, ConflictPolicy("KEEP_FIRST"), ConflictKeepFirst)
+	assert.Equal(t, ConflictPolicy("KEEP_LAST"), ConflictKeepLast)
+	assert.Equal(t, ConflictPolicy("SUM"), ConflictSum)
+	assert.Equal(t, ConflictPolicy("ERROR"), ConflictError)
+	assert.Equal(t, ConflictPolicy("ERROR_ON_MISMATCH"), ConflictErrorOnMismatch)
+	assert.Equal(t, ConflictPolicy("CUSTOM"), ConflictCustom)
+}
+
+// TestLengthPolicyConstants tests that length policy constants are properly defined
+func TestLengthPolicyConstants(t *testing.T) {
+	assert.Equal(t, LengthPolicy("TRUNCATE"), LengthTruncate)
+	assert.Equal(t, LengthPolicy("ERROR"), LengthError)
+}
+
+// TestNilPolicyConstants tests that nil policy constants are properly defined
+func TestNilPolicyConstants(t *testing.T) {
+	assert.Equal(t, NilPolicy("AS_ZERO"), NilAsZero)
+	assert.Equal(t, NilPolicy("ERROR"), NilError)
+}
+
+// TestFieldDiffKindConstants tests that field diff kind constants are properly defined
+func TestFieldDiffKindConstants(t *testing.T) {
+	assert.Equal(t, FieldDiffKind("VALUE"), FieldDiffValue)
+	assert.Equal(t, FieldDiffKind("STRUCT"), FieldDiffStruct)
+	assert.Equal(t, FieldDiffKind("SLICE"), FieldDiffSlice)
+	assert.Equal(t, FieldDiffKind("POINTER"), FieldDiffPointer)
+	assert.Equal(t, FieldDiffKind("MAP"), FieldDiffMap)
+	assert.Equal(t, FieldDiffKind("INTERFACE"), FieldDiffInterface)
+}
+
+// TestEditKindConstants tests that edit kind constants are properly defined
+func TestEditKindConstants(t *testing.T) {
+	assert.Equal(t, EditKind("KEEP"), EditKeep)
+	assert.Equal(t, EditKind("DELETE"), EditDelete)
+	assert.Equal(t, EditKind("INSERT"), EditInsert)
 }
 
 // TestResultConstants tests that result constants are properly defined
@@ -456,3 +1348,15 @@ func TestResultConstants(t *testing.T) {
 	assert.Equal(t, Result("b is greater"), ResultBGreater)
 	assert.Equal(t, Result("both are equal"), ResultEqual)
 }
+
+// BenchmarkRemoveDuplicates_SmallMediumLarge benchmarks deduplicating a slice of n
+// elements in which every value occurs four times
+func BenchmarkRemoveDuplicates_SmallMediumLarge(b *testing.B) {
+	benchcmp.SmallMediumLarge(b, func(b *testing.B, n int) {
+		s := Generate(n, func(i int) int { return i % max(n/4, 1) })
+
+		for b.Loop() {
+			RemoveDuplicates(s)
+		}
+	})
+}
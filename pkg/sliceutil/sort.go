@@ -0,0 +1,152 @@
+package sliceutil
+
+import (
+	"cmp"
+	"slices"
+	"sort"
+)
+
+// SortKey describes one level of a multi-key sort: a comparator that returns a negative
+// number, zero, or a positive number when a sorts before, equal to, or after b in
+// ascending order, and the order in which the key should be applied.
+type SortKey[T any] struct {
+	Compare func(a, b T) int
+	Order   OrderType
+}
+
+// By creates a SortKey that orders elements by the value returned from the key function.
+//
+// Example:
+//
+//	SortByMulti(people, By(func(p Person) string { return p.City }, OrderAsc),
+//		By(func(p Person) int { return p.Age }, OrderDesc))
+func By[T any, K cmp.Ordered](key func(T) K, order OrderType) SortKey[T] {
+	return SortKey[T]{
+		Compare: func(a, b T) int { return cmp.Compare(key(a), key(b)) },
+		Order:   order,
+	}
+}
+
+// AsSortInterface wraps a slice and a less function in a sort.Interface, for use with
+// APIs from the standard library or other packages that expect one, such as sort.Sort,
+// sort.IsSorted or container/heap. Swap modifies the wrapped slice in place.
+//
+// Example:
+//
+//	data := AsSortInterface(people, func(a, b Person) bool { return a.Age < b.Age })
+//	sort.Stable(data)
+//	sorted := sort.IsSorted(data) // returns true
+func AsSortInterface[T any](s []T, less func(a, b T) bool) sort.Interface {
+	return sortAdapter[T]{s: s, less: less}
+}
+
+// sortAdapter implements sort.Interface for AsSortInterface.
+type sortAdapter[T any] struct {
+	s    []T
+	less func(a, b T) bool
+}
+
+func (a sortAdapter[T]) Len() int           { return len(a.s) }
+func (a sortAdapter[T]) Less(i, j int) bool { return a.less(a.s[i], a.s[j]) }
+func (a sortAdapter[T]) Swap(i, j int)      { a.s[i], a.s[j] = a.s[j], a.s[i] }
+
+// Sort sorts a slice of an ordered type in place in the specified order.
+// OrderNone leaves the slice unchanged, and any other order except OrderDesc sorts in
+// ascending order, matching the merge functions. Floating-point NaNs are ordered as
+// cmp.Compare orders them, before every other value in ascending order and after
+// every other value in descending order, so IsSortedOrder holds after Sort.
+//
+// Time complexity: O(n log n) where n is the length of the slice
+// Space complexity: O(1)
+//
+// Example:
+//
+//	slice := []int{3, 1, 2}
+//	Sort(slice, OrderDesc) // slice is now []int{3, 2, 1}
+func Sort[T cmp.Ordered](a []T, order OrderType) {
+	switch order {
+	case OrderNone:
+		return
+	case OrderDesc:
+		slices.SortFunc(a, func(x, y T) int { return cmp.Compare(y, x) })
+	default:
+		slices.Sort(a)
+	}
+}
+
+// SortCopy returns a sorted copy of a slice without modifying the original.
+func SortCopy[T cmp.Ordered](a []T, order OrderType) []T {
+	if a == nil {
+		return nil
+	}
+
+	result := make([]T, len(a))
+	copy(result, a)
+	Sort(result, order)
+	return result
+}
+
+// SortBy sorts a slice in place using a custom less function.
+// The sort is stable, so elements that compare equal keep their relative order.
+//
+// Example:
+//
+//	SortBy(people, func(a, b Person) bool { return a.Age < b.Age })
+func SortBy[T any](a []T, less func(a, b T) bool) {
+	sort.SliceStable(a, func(i, j int) bool {
+		return less(a[i], a[j])
+	})
+}
+
+// SortByCopy returns a copy of a slice sorted using a custom less function.
+func SortByCopy[T any](a []T, less func(a, b T) bool) []T {
+	if a == nil {
+		return nil
+	}
+
+	result := make([]T, len(a))
+	copy(result, a)
+	SortBy(result, less)
+	return result
+}
+
+// SortByMulti sorts a slice in place by several keys. Elements are ordered by the first
+// key, ties are broken by the second key, and so on. Each key may use its own order.
+// The sort is stable, so elements that are equal on every key keep their relative order.
+// A key with OrderNone is ignored, just as Sort leaves a slice unchanged for OrderNone.
+//
+// Example:
+//
+//	SortByMulti(people,
+//		By(func(p Person) string { return p.City }, OrderAsc),
+//		By(func(p Person) int { return p.Age }, OrderDesc),
+//	)
+func SortByMulti[T any](a []T, keys ...SortKey[T]) {
+	SortBy(a, func(x, y T) bool {
+		for _, key := range keys {
+			if key.Order == OrderNone {
+				continue
+			}
+			c := key.Compare(x, y)
+			if key.Order == OrderDesc {
+				c = -c
+			}
+			if c != 0 {
+				return c < 0
+			}
+		}
+		return false
+	})
+}
+
+// SortByMultiCopy returns a copy of a slice sorted by several keys.
+func SortByMultiCopy[T any](a []T, keys ...SortKey[T]) []T {
+	if a == nil {
+		return nil
+	}
+
+	result := make([]T, len(a))
+	copy(result, a)
+	SortByMulti(result, keys...)
+	return result
+}
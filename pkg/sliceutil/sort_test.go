@@ -0,0 +1,147 @@
+package sliceutil
+
+import (
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestSort tests the Sort and SortCopy functions
+func TestSort(t *testing.T) {
+	t.Run("In Place", func(t *testing.T) {
+		slice := []int{3, 1, 2}
+		Sort(slice, OrderAsc)
+		assert.Equal(t, []int{1, 2, 3}, slice)
+
+		Sort(slice, OrderDesc)
+		assert.Equal(t, []int{3, 2, 1}, slice)
+	})
+
+	t.Run("Copy", func(t *testing.T) {
+		slice := []string{"b", "c", "a"}
+		result := SortCopy(slice, OrderDesc)
+
+		assert.Equal(t, []string{"c", "b", "a"}, result)
+		assert.Equal(t, []string{"b", "c", "a"}, slice) // Original unchanged
+	})
+
+	t.Run("Order None", func(t *testing.T) {
+		slice := []int{3, 1, 2}
+		Sort(slice, OrderNone)
+		assert.Equal(t, []int{3, 1, 2}, slice)
+	})
+
+	t.Run("Nil and Empty", func(t *testing.T) {
+		Sort[int](nil, OrderAsc) // Should not panic
+		assert.Nil(t, SortCopy[int](nil, OrderAsc))
+		assert.Empty(t, SortCopy([]int{}, OrderAsc))
+	})
+}
+
+// TestSortBy tests the SortBy and SortByCopy functions
+func TestSortBy(t *testing.T) {
+	type person struct {
+		Name string
+		Age  int
+	}
+	byAge := func(a, b person) bool { return a.Age < b.Age }
+
+	t.Run("Stable Custom Sort", func(t *testing.T) {
+		people := []person{{"Alice", 30}, {"Bob", 25}, {"Carol", 30}, {"Dave", 25}}
+		SortBy(people, byAge)
+
+		assert.Equal(t, []person{{"Bob", 25}, {"Dave", 25}, {"Alice", 30}, {"Carol", 30}}, people)
+	})
+
+	t.Run("Copy", func(t *testing.T) {
+		people := []person{{"Alice", 30}, {"Bob", 25}}
+		result := SortByCopy(people, byAge)
+
+		assert.Equal(t, "Bob", result[0].Name)
+		assert.Equal(t, "Alice", people[0].Name)
+		assert.Nil(t, SortByCopy(nil, byAge))
+	})
+}
+
+// TestSortByMulti tests the SortByMulti and SortByMultiCopy functions
+func TestSortByMulti(t *testing.T) {
+	type person struct {
+		Name string
+		City string
+		Age  int
+	}
+	people := []person{
+		{"Alice", "NY", 30},
+		{"Bob", "LA", 25},
+		{"Carol", "NY", 35},
+		{"Dave", "LA", 25},
+		{"Eve", "NY", 30},
+	}
+
+	t.Run("Multiple Keys With Mixed Orders", func(t *testing.T) {
+		result := SortByMultiCopy(people,
+			By(func(p person) string { return p.City }, OrderAsc),
+			By(func(p person) int { return p.Age }, OrderDesc),
+		)
+
+		names := Map(result, func(p person) string { return p.Name })
+		assert.Equal(t, []string{"Bob", "Dave", "Carol", "Alice", "Eve"}, names)
+		assert.Equal(t, "Alice", people[0].Name) // Original unchanged
+	})
+
+	t.Run("OrderNone Key Is Ignored", func(t *testing.T) {
+		result := SortByMultiCopy(people,
+			By(func(p person) string { return p.Name }, OrderNone),
+			By(func(p person) int { return p.Age }, OrderAsc),
+		)
+
+		names := Map(result, func(p person) string { return p.Name })
+		assert.Equal(t, []string{"Bob", "Dave", "Alice", "Eve", "Carol"}, names)
+	})
+
+	t.Run("Custom Comparator Key", func(t *testing.T) {
+		slice := []string{"b", "A", "c"}
+		SortByMulti(slice, SortKey[string]{
+			Compare: func(a, b string) int { return strings.Compare(strings.ToLower(a), strings.ToLower(b)) },
+			Order:   OrderAsc,
+		})
+		assert.Equal(t, []string{"A", "b", "c"}, slice)
+	})
+
+	t.Run("No Keys Keeps Order", func(t *testing.T) {
+		slice := []int{3, 1, 2}
+		SortByMulti(slice)
+		assert.Equal(t, []int{3, 1, 2}, slice)
+	})
+}
+
+// TestAsSortInterface tests the AsSortInterface function
+func TestAsSortInterface(t *testing.T) {
+	type Person struct {
+		Name string
+		Age  int
+	}
+	byAge := func(a, b Person) bool { return a.Age < b.Age }
+
+	t.Run("Sort Package", func(t *testing.T) {
+		people := []Person{{"Alice", 30}, {"Bob", 25}, {"Carol", 30}, {"Dave", 20}}
+		data := AsSortInterface(people, byAge)
+		assert.Equal(t, 4, data.Len())
+		assert.False(t, sort.IsSorted(data))
+
+		sort.Stable(data)
+		assert.True(t, sort.IsSorted(data))
+		assert.Equal(t, []Person{{"Dave", 20}, {"Bob", 25}, {"Alice", 30}, {"Carol", 30}}, people)
+
+		sort.Sort(sort.Reverse(data))
+		assert.Equal(t, 30, people[0].Age)
+	})
+
+	t.Run("Nil and Empty", func(t *testing.T) {
+		data := AsSortInterface[int](nil, func(a, b int) bool { return a < b })
+		assert.Equal(t, 0, data.Len())
+		assert.True(t, sort.IsSorted(data))
+	})
+}
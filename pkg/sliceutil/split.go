@@ -0,0 +1,104 @@
+package sliceutil
+
+// Split slices s into all sub-slices separated by sep, mirroring strings.Split.
+// Separators are removed from the result, and consecutive, leading or trailing
+// separators produce empty sub-slices. A nil slice returns nil.
+//
+// Time complexity: O(n) where n is the length of the slice
+// Space complexity: O(n) for the result sub-slices
+//
+// Example:
+//
+//	parts := Split([]int{1, 2, 0, 3, 0, 0, 4}, 0)
+//	// returns [][]int{{1, 2}, {3}, {}, {4}}
+func Split[T comparable](s []T, sep T) [][]T {
+	return SplitN(s, sep, -1)
+}
+
+// SplitN slices s into sub-slices separated by sep, mirroring strings.SplitN.
+// The count determines the number of sub-slices to return:
+//
+//	n > 0: at most n sub-slices; the last sub-slice is the unsplit remainder
+//	n == 0: the result is nil (zero sub-slices)
+//	n < 0: all sub-slices, equivalent to Split
+//
+// A nil slice returns nil.
+//
+// Example:
+//
+//	parts := SplitN([]int{1, 0, 2, 0, 3}, 0, 2)
+//	// returns [][]int{{1}, {2, 0, 3}}
+func SplitN[T comparable](s []T, sep T, n int) [][]T {
+	if s == nil || n == 0 {
+		return nil
+	}
+
+	result := make([][]T, 0)
+	start := 0
+	for i, v := range s {
+		if n > 0 && len(result) == n-1 {
+			break
+		}
+		if v == sep {
+			result = append(result, append([]T{}, s[start:i]...))
+			start = i + 1
+		}
+	}
+	result = append(result, append([]T{}, s[start:]...))
+
+	return result
+}
+
+// Cut slices s around the first occurrence of sep, mirroring bytes.Cut.
+// It returns the elements before and after the separator and reports whether
+// the separator was found. If sep does not occur, before is a copy of s,
+// after is nil and found is false.
+//
+// Example:
+//
+//	before, after, found := Cut([]string{"k", "=", "v"}, "=")
+//	// returns []string{"k"}, []string{"v"}, true
+func Cut[T comparable](s []T, sep T) (before, after []T, found bool) {
+	if s == nil {
+		return nil, nil, false
+	}
+
+	if i := IndexOf(s, sep); i >= 0 {
+		return append([]T{}, s[:i]...), append([]T{}, s[i+1:]...), true
+	}
+	return append([]T{}, s...), nil, false
+}
+
+// SplitFunc splits s around each run of elements for which isSep returns true,
+// mirroring strings.FieldsFunc. Unlike Split, empty sub-slices are never returned,
+// so a slice consisting only of separators yields an empty result.
+//
+// Example:
+//
+//	tokens := SplitFunc([]int{0, 1, 2, -1, 0, 3}, func(v int) bool { return v <= 0 })
+//	// returns [][]int{{1, 2}, {3}}
+func SplitFunc[T any](s []T, isSep func(T) bool) [][]T {
+	if s == nil {
+		return nil
+	}
+
+	result := make([][]T, 0)
+	start := -1
+	for i, v := range s {
+		if isSep(v) {
+			if start >= 0 {
+				result = append(result, append([]T{}, s[start:i]...))
+				start = -1
+			}
+			continue
+		}
+		if start < 0 {
+			start = i
+		}
+	}
+	if start >= 0 {
+		result = append(result, append([]T{}, s[start:]...))
+	}
+
+	return result
+}
@@ -0,0 +1,106 @@
+package sliceutil
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestSplit tests the Split function
+func TestSplit(t *testing.T) {
+	t.Run("Splits Around Separator", func(t *testing.T) {
+		result := Split([]int{1, 2, 0, 3, 0, 0, 4}, 0)
+		assert.Equal(t, [][]int{{1, 2}, {3}, {}, {4}}, result)
+	})
+
+	t.Run("Leading And Trailing Separators", func(t *testing.T) {
+		result := Split([]string{"|", "a", "|"}, "|")
+		assert.Equal(t, [][]string{{}, {"a"}, {}}, result)
+	})
+
+	t.Run("No Separator", func(t *testing.T) {
+		assert.Equal(t, [][]int{{1, 2, 3}}, Split([]int{1, 2, 3}, 0))
+	})
+
+	t.Run("Nil and Empty", func(t *testing.T) {
+		assert.Nil(t, Split[int](nil, 0))
+		assert.Equal(t, [][]int{{}}, Split([]int{}, 0))
+	})
+}
+
+// TestSplitN tests the SplitN function
+func TestSplitN(t *testing.T) {
+	s := []int{1, 0, 2, 0, 3}
+
+	t.Run("Limits Number Of Sub-slices", func(t *testing.T) {
+		assert.Equal(t, [][]int{{1}, {2, 0, 3}}, SplitN(s, 0, 2))
+		assert.Equal(t, [][]int{{1, 0, 2, 0, 3}}, SplitN(s, 0, 1))
+	})
+
+	t.Run("Large And Negative Counts Split Everything", func(t *testing.T) {
+		assert.Equal(t, [][]int{{1}, {2}, {3}}, SplitN(s, 0, 10))
+		assert.Equal(t, Split(s, 0), SplitN(s, 0, -1))
+	})
+
+	t.Run("Zero Count", func(t *testing.T) {
+		assert.Nil(t, SplitN(s, 0, 0))
+	})
+}
+
+// TestCut tests the Cut function
+func TestCut(t *testing.T) {
+	t.Run("Separator Found", func(t *testing.T) {
+		before, after, found := Cut([]string{"k", "=", "v", "=", "w"}, "=")
+
+		assert.True(t, found)
+		assert.Equal(t, []string{"k"}, before)
+		assert.Equal(t, []string{"v", "=", "w"}, after)
+	})
+
+	t.Run("Separator At Edges", func(t *testing.T) {
+		before, after, found := Cut([]int{0, 1}, 0)
+		assert.True(t, found)
+		assert.Empty(t, before)
+		assert.Equal(t, []int{1}, after)
+
+		before, after, found = Cut([]int{1, 0}, 0)
+		assert.True(t, found)
+		assert.Equal(t, []int{1}, before)
+		assert.Empty(t, after)
+	})
+
+	t.Run("Separator Missing", func(t *testing.T) {
+		before, after, found := Cut([]int{1, 2}, 0)
+
+		assert.False(t, found)
+		assert.Equal(t, []int{1, 2}, before)
+		assert.Nil(t, after)
+	})
+
+	t.Run("Nil Slice", func(t *testing.T) {
+		before, after, found := Cut[int](nil, 0)
+
+		assert.False(t, found)
+		assert.Nil(t, before)
+		assert.Nil(t, after)
+	})
+}
+
+// TestSplitFunc tests the SplitFunc function
+func TestSplitFunc(t *testing.T) {
+	isSep := func(v int) bool { return v <= 0 }
+
+	t.Run("Drops Separator Runs", func(t *testing.T) {
+		result := SplitFunc([]int{0, 1, 2, -1, 0, 3}, isSep)
+		assert.Equal(t, [][]int{{1, 2}, {3}}, result)
+	})
+
+	t.Run("Only Separators", func(t *testing.T) {
+		assert.Empty(t, SplitFunc([]int{0, -1, 0}, isSep))
+	})
+
+	t.Run("Nil and Empty", func(t *testing.T) {
+		assert.Nil(t, SplitFunc[int](nil, isSep))
+		assert.Empty(t, SplitFunc([]int{}, isSep))
+	})
+}
@@ -0,0 +1,331 @@
+package sliceutil
+
+import (
+	"fmt"
+	"math"
+	"sort"
+)
+
+// Median returns the middle value of a numeric slice. For slices with an even number
+// of elements, the average of the two middle values is returned.
+// The function returns an error if the slice is empty or nil.
+//
+// Time complexity: O(n log n) where n is the length of the slice
+// Space complexity: O(n) for the sorted copy
+//
+// Example:
+//
+//	median, err := Median([]int{3, 1, 4, 2}) // returns 2.5, nil
+func Median[T Number](a []T) (float64, error) {
+	sorted, err := sortedFloats(a)
+	if err != nil {
+		return 0, err
+	}
+
+	mid := len(sorted) / 2
+	if len(sorted)%2 == 0 {
+		return (sorted[mid-1] + sorted[mid]) / 2, nil
+	}
+	return sorted[mid], nil
+}
+
+// Mode returns the most frequent value of a slice. When several values share the
+// highest frequency, the one that occurs first in the slice is returned.
+// The function returns an error if the slice is empty or nil.
+//
+// Time complexity: O(n) where n is the length of the slice
+// Space complexity: O(n) for the frequency map
+//
+// Example:
+//
+//	mode, err := Mode([]int{1, 2, 2, 3, 3}) // returns 2, nil
+func Mode[T comparable](a []T) (T, error) {
+	var zero T
+	if a == nil {
+		return zero, ErrNilSlice
+	}
+	if len(a) == 0 {
+		return zero, ErrEmptySlice
+	}
+
+	counts := countElements(a)
+
+	// Walk the slice in order so ties resolve to the earliest value
+	mode, best := a[0], 0
+	for _, v := range a {
+		if counts[v] > best {
+			mode, best = v, counts[v]
+		}
+	}
+	return mode, nil
+}
+
+// Variance returns the population variance of a numeric slice, i.e. the average
+// squared deviation from the mean. The squared deviations are added with compensated
+// summation to limit rounding errors on long slices.
+// The function returns an error if the slice is empty or nil.
+//
+// Example:
+//
+//	variance, err := Variance([]int{2, 4, 4, 4, 5, 5, 7, 9}) // returns 4, nil
+func Variance[T Number](a []T) (float64, error) {
+	_, variance, err := meanAndVariance(a)
+	return variance, err
+}
+
+// meanAndVariance is a helper function that returns the mean and the population
+// variance of a numeric slice, so callers needing both compute the mean only once.
+func meanAndVariance[T Number](a []T) (float64, float64, error) {
+	mean, err := Average(a)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	var acc kahanAccumulator
+	for _, v := range a {
+		d := float64(v) - mean
+		acc.add(d * d)
+	}
+	return mean, acc.result() / float64(len(a)), nil
+}
+
+// StdDev returns the population standard deviation of a numeric slice.
+// The function returns an error if the slice is empty or nil.
+//
+// Example:
+//
+//	stddev, err := StdDev([]int{2, 4, 4, 4, 5, 5, 7, 9}) // returns 2, nil
+func StdDev[T Number](a []T) (float64, error) {
+	variance, err := Variance(a)
+	if err != nil {
+		return 0, err
+	}
+	return math.Sqrt(variance), nil
+}
+
+// Covariance returns the population covariance of two numeric slices of equal length,
+// i.e. the average product of the deviations of paired elements from their means.
+// It is positive when the values tend to move together and negative when they move
+// in opposite directions.
+// The function returns an error if either slice is nil or empty, and an error wrapping
+// ErrLengthMismatch if the slices differ in length.
+//
+// Time complexity: O(n) where n is the length of the slices
+// Space complexity: O(1)
+//
+// Example:
+//
+//	cov, err := Covariance([]float64{1, 2, 3}, []float64{2, 4, 6}) // returns 1.3333333333333333, nil
+func Covariance[T Number](a, b []T) (float64, error) {
+	meanA, meanB, err := pairedMeans(a, b)
+	if err != nil {
+		return 0, err
+	}
+
+	var acc kahanAccumulator
+	for i := range a {
+		acc.add((float64(a[i]) - meanA) * (float64(b[i]) - meanB))
+	}
+	return acc.result() / float64(len(a)), nil
+}
+
+// Pearson returns the Pearson correlation coefficient of two numeric slices of equal
+// length, a value between -1 (perfect negative linear relationship) and 1 (perfect
+// positive linear relationship). If either slice is constant the coefficient is
+// undefined and NaN is returned.
+// The function returns an error if either slice is nil or empty, and an error wrapping
+// ErrLengthMismatch if the slices differ in length.
+//
+// Time complexity: O(n) where n is the length of the slices
+// Space complexity: O(1)
+//
+// Example:
+//
+//	r, err := Pearson([]float64{1, 2, 3, 4}, []float64{10, 20, 30, 40}) // returns 1, nil
+func Pearson[T Number](a, b []T) (float64, error) {
+	meanA, meanB, err := pairedMeans(a, b)
+	if err != nil {
+		return 0, err
+	}
+
+	var cov, varA, varB kahanAccumulator
+	for i := range a {
+		da := float64(a[i]) - meanA
+		db := float64(b[i]) - meanB
+		cov.add(da * db)
+		varA.add(da * da)
+		varB.add(db * db)
+	}
+
+	denominator := math.Sqrt(varA.result() * varB.result())
+	if denominator == 0 {
+		return math.NaN(), nil
+	}
+	// Rounding can push the ratio slightly past the theoretical bounds
+	return max(-1, min(1, cov.result()/denominator)), nil
+}
+
+// pairedMeans is a helper function that validates two slices for a paired statistic
+// and returns their means.
+func pairedMeans[T Number](a, b []T) (float64, float64, error) {
+	if len(a) != len(b) && a != nil && b != nil {
+		return 0, 0, fmt.Errorf("%w: %d and %d", ErrLengthMismatch, len(a), len(b))
+	}
+
+	meanA, err := Average(a)
+	if err != nil {
+		return 0, 0, err
+	}
+	meanB, err := Average(b)
+	if err != nil {
+		return 0, 0, err
+	}
+	return meanA, meanB, nil
+}
+
+// Percentile returns the p-th percentile (0 <= p <= 100) of a numeric slice using
+// linear interpolation between the closest ranks. Percentile(a, 50) equals Median(a).
+// The function returns an error if the slice is empty or nil, or ErrOutOfRange if p
+// is outside [0, 100].
+//
+// Time complexity: O(n log n) where n is the length of the slice
+// Space complexity: O(n) for the sorted copy
+//
+// Example:
+//
+//	p90, err := Percentile([]int{1, 2, 3, 4, 5}, 90) // returns 4.6, nil
+func Percentile[T Number](a []T, p float64) (float64, error) {
+	if p < 0 || p > 100 || math.IsNaN(p) {
+		return 0, ErrOutOfRange
+	}
+
+	sorted, err := sortedFloats(a)
+	if err != nil {
+		return 0, err
+	}
+	return percentileOfSorted(sorted, p), nil
+}
+
+// GetExtendedStats computes the mean, median, mode, variance and standard deviation
+// of a numeric slice in one call.
+// The function returns an error if the slice is empty or nil.
+func GetExtendedStats[T Number](a []T) (ExtendedStats, error) {
+	if a == nil {
+		return ExtendedStats{}, ErrNilSlice
+	}
+	if len(a) == 0 {
+		return ExtendedStats{}, ErrEmptySlice
+	}
+
+	stats := ExtendedStats{Length: len(a)}
+
+	mean, variance, err := meanAndVariance(a)
+	if err != nil {
+		return stats, err
+	}
+	stats.Mean = mean
+	stats.Variance = variance
+	stats.StdDev = math.Sqrt(variance)
+
+	if stats.Median, err = Median(a); err != nil {
+		return stats, err
+	}
+
+	mode, err := Mode(a)
+	if err != nil {
+		return stats, err
+	}
+	stats.Mode = float64(mode)
+
+	return stats, nil
+}
+
+// GetSliceStatsFloat64 computes summary statistics of a float64 slice without converting
+// it to integers: min, max, range, sum, mean, the quartiles Q1, Q2 and Q3, the
+// interquartile range, and the outliers according to the 1.5×IQR rule (Tukey's fences).
+// Quartiles are interpolated like Percentile, and the sum uses compensated summation.
+// Outliers are returned in the order they appear in the slice and the slice is never nil.
+// NaN values are ignored and counted in NaNCount; if no other values remain, only
+// Length and NaNCount are set.
+// The function returns an error if the slice is nil.
+//
+// Time complexity: O(n log n) where n is the length of the slice
+// Space complexity: O(n) for the sorted copy
+//
+// Example:
+//
+//	stats, err := GetSliceStatsFloat64([]float64{1, 2, 3, 4, 5, 6, 7, 100})
+//	// stats.Q1: 2.75, stats.Q2: 4.5, stats.Q3: 6.25, stats.IQR: 3.5,
+//	// stats.Outliers: [100]
+func GetSliceStatsFloat64(a []float64) (FloatStats, error) {
+	if a == nil {
+		return FloatStats{}, ErrNilSlice
+	}
+
+	stats := FloatStats{Length: len(a), Outliers: make([]float64, 0)}
+
+	sorted := make([]float64, 0, len(a))
+	var acc kahanAccumulator
+	for _, v := range a {
+		if math.IsNaN(v) {
+			stats.NaNCount++
+			continue
+		}
+		sorted = append(sorted, v)
+		acc.add(v)
+	}
+	if len(sorted) == 0 {
+		return stats, nil
+	}
+	sort.Float64s(sorted)
+
+	stats.Min = sorted[0]
+	stats.Max = sorted[len(sorted)-1]
+	stats.Range = stats.Max - stats.Min
+	stats.Sum = acc.result()
+	stats.Mean = stats.Sum / float64(len(sorted))
+
+	stats.Q1 = percentileOfSorted(sorted, 25)
+	stats.Q2 = percentileOfSorted(sorted, 50)
+	stats.Q3 = percentileOfSorted(sorted, 75)
+	stats.IQR = stats.Q3 - stats.Q1
+	stats.LowerFence = stats.Q1 - 1.5*stats.IQR
+	stats.UpperFence = stats.Q3 + 1.5*stats.IQR
+
+	for _, v := range a {
+		if v < stats.LowerFence || v > stats.UpperFence {
+			stats.Outliers = append(stats.Outliers, v)
+		}
+	}
+
+	return stats, nil
+}
+
+// sortedFloats is a helper function that returns an ascending float64 copy of a numeric slice.
+func sortedFloats[T Number](a []T) ([]float64, error) {
+	if a == nil {
+		return nil, ErrNilSlice
+	}
+	if len(a) == 0 {
+		return nil, ErrEmptySlice
+	}
+
+	sorted := make([]float64, len(a))
+	for i, v := range a {
+		sorted[i] = float64(v)
+	}
+	sort.Float64s(sorted)
+	return sorted, nil
+}
+
+// percentileOfSorted is a helper function that interpolates the p-th percentile of a sorted, non-empty slice.
+func percentileOfSorted(sorted []float64, p float64) float64 {
+	rank := p / 100 * float64(len(sorted)-1)
+	lower := int(math.Floor(rank))
+	upper := int(math.Ceil(rank))
+	if lower == upper {
+		return sorted[lower]
+	}
+	frac := rank - float64(lower)
+	return sorted[lower] + frac*(sorted[upper]-sorted[lower])
+}
@@ -0,0 +1,282 @@
+package sliceutil
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestMedian tests the Median function
+func TestMedian(t *testing.T) {
+	t.Run("Odd And Even Lengths", func(t *testing.T) {
+		median, err := Median([]int{5, 1, 3})
+		require.NoError(t, err)
+		assert.Equal(t, 3.0, median)
+
+		median, err = Median([]float32{3, 1, 4, 2})
+		require.NoError(t, err)
+		assert.Equal(t, 2.5, median)
+	})
+
+	t.Run("Does Not Modify Input", func(t *testing.T) {
+		slice := []int{3, 1, 2}
+		_, err := Median(slice)
+		require.NoError(t, err)
+		assert.Equal(t, []int{3, 1, 2}, slice)
+	})
+
+	t.Run("Nil and Empty", func(t *testing.T) {
+		_, err := Median[int](nil)
+		assert.Equal(t, ErrNilSlice, err)
+		_, err = Median([]int{})
+		assert.Equal(t, ErrEmptySlice, err)
+	})
+}
+
+// TestMode tests the Mode function
+func TestMode(t *testing.T) {
+	t.Run("Most Frequent Value", func(t *testing.T) {
+		mode, err := Mode([]int{1, 3, 3, 2, 3})
+		require.NoError(t, err)
+		assert.Equal(t, 3, mode)
+	})
+
+	t.Run("Ties Resolve To Earliest Value", func(t *testing.T) {
+		mode, err := Mode([]string{"b", "a", "a", "b"})
+		require.NoError(t, err)
+		assert.Equal(t, "b", mode)
+	})
+
+	t.Run("Nil and Empty", func(t *testing.T) {
+		_, err := Mode[int](nil)
+		assert.Equal(t, ErrNilSlice, err)
+		_, err = Mode([]int{})
+		assert.Equal(t, ErrEmptySlice, err)
+	})
+}
+
+// TestVarianceAndStdDev tests the Variance and StdDev functions
+func TestVarianceAndStdDev(t *testing.T) {
+	t.Run("Population Statistics", func(t *testing.T) {
+		slice := []int{2, 4, 4, 4, 5, 5, 7, 9}
+
+		variance, err := Variance(slice)
+		require.NoError(t, err)
+		assert.Equal(t, 4.0, variance)
+
+		stddev, err := StdDev(slice)
+		require.NoError(t, err)
+		assert.Equal(t, 2.0, stddev)
+	})
+
+	t.Run("Constant Slice", func(t *testing.T) {
+		variance, err := Variance([]float64{1.5, 1.5})
+		require.NoError(t, err)
+		assert.Equal(t, 0.0, variance)
+	})
+
+	t.Run("Small Integer Types", func(t *testing.T) {
+		variance, err := Variance([]int8{100, 100, 100})
+		require.NoError(t, err)
+		assert.Equal(t, 0.0, variance)
+
+		stddev, err := StdDev([]uint8{250, 254})
+		require.NoError(t, err)
+		assert.Equal(t, 2.0, stddev)
+	})
+
+	t.Run("Nil and Empty", func(t *testing.T) {
+		_, err := Variance[int](nil)
+		assert.Equal(t, ErrNilSlice, err)
+		_, err = StdDev([]int{})
+		assert.Equal(t, ErrEmptySlice, err)
+	})
+}
+
+// TestPercentile tests the Percentile function
+func TestPercentile(t *testing.T) {
+	slice := []int{5, 1, 4, 2, 3}
+
+	t.Run("Interpolates Between Ranks", func(t *testing.T) {
+		p, err := Percentile(slice, 90)
+		require.NoError(t, err)
+		assert.InDelta(t, 4.6, p, 1e-9)
+
+		p, err = Percentile(slice, 25)
+		require.NoError(t, err)
+		assert.Equal(t, 2.0, p)
+	})
+
+	t.Run("Bounds And Median", func(t *testing.T) {
+		p, err := Percentile(slice, 0)
+		require.NoError(t, err)
+		assert.Equal(t, 1.0, p)
+
+		p, err = Percentile(slice, 100)
+		require.NoError(t, err)
+		assert.Equal(t, 5.0, p)
+
+		p, err = Percentile(slice, 50)
+		require.NoError(t, err)
+		median, _ := Median(slice)
+		assert.Equal(t, median, p)
+	})
+
+	t.Run("Invalid Arguments", func(t *testing.T) {
+		_, err := Percentile(slice, -1)
+		assert.Equal(t, ErrOutOfRange, err)
+		_, err = Percentile(slice, 101)
+		assert.Equal(t, ErrOutOfRange, err)
+		_, err = Percentile(slice, math.NaN())
+		assert.Equal(t, ErrOutOfRange, err)
+		_, err = Percentile([]int{}, 50)
+		assert.Equal(t, ErrEmptySlice, err)
+	})
+}
+
+// TestGetExtendedStats tests the GetExtendedStats function
+func TestGetExtendedStats(t *testing.T) {
+	t.Run("Computes All Metrics", func(t *testing.T) {
+		stats, err := GetExtendedStats([]int{2, 4, 4, 4, 5, 5, 7, 9})
+		require.NoError(t, err)
+
+		assert.Equal(t, 8, stats.Length)
+		assert.Equal(t, 5.0, stats.Mean)
+		assert.Equal(t, 4.5, stats.Median)
+		assert.Equal(t, 4.0, stats.Mode)
+		assert.Equal(t, 4.0, stats.Variance)
+		assert.Equal(t, 2.0, stats.StdDev)
+	})
+
+	t.Run("Nil and Empty", func(t *testing.T) {
+		_, err := GetExtendedStats[float64](nil)
+		assert.Equal(t, ErrNilSlice, err)
+		_, err = GetExtendedStats([]float64{})
+		assert.Equal(t, ErrEmptySlice, err)
+	})
+}
+
+// TestGetSliceStatsFloat64 tests the GetSliceStatsFloat64 function
+func TestGetSliceStatsFloat64(t *testing.T) {
+	t.Run("Quartiles And Outliers", func(t *testing.T) {
+		stats, err := GetSliceStatsFloat64([]float64{100, 1, 2, 3, 4, 5, 6, 7})
+		require.NoError(t, err)
+
+		assert.Equal(t, 8, stats.Length)
+		assert.Equal(t, 1.0, stats.Min)
+		assert.Equal(t, 100.0, stats.Max)
+		assert.Equal(t, 99.0, stats.Range)
+		assert.Equal(t, 128.0, stats.Sum)
+		assert.Equal(t, 16.0, stats.Mean)
+		assert.Equal(t, 2.75, stats.Q1)
+		assert.Equal(t, 4.5, stats.Q2)
+		assert.Equal(t, 6.25, stats.Q3)
+		assert.Equal(t, 3.5, stats.IQR)
+		assert.Equal(t, -2.5, stats.LowerFence)
+		assert.Equal(t, 11.5, stats.UpperFence)
+		assert.Equal(t, []float64{100}, stats.Outliers)
+	})
+
+	t.Run("Keeps Fractional Precision", func(t *testing.T) {
+		stats, err := GetSliceStatsFloat64([]float64{0.1, 0.2, 0.3, 0.4})
+		require.NoError(t, err)
+		assert.InDelta(t, 0.25, stats.Mean, 1e-15)
+		assert.InDelta(t, 0.175, stats.Q1, 1e-15)
+		assert.Empty(t, stats.Outliers)
+	})
+
+	t.Run("Low And High Outliers In Input Order", func(t *testing.T) {
+		a := []float64{50, -40, 10, 11, 12, 13, 14}
+		stats, err := GetSliceStatsFloat64(a)
+		require.NoError(t, err)
+		assert.Equal(t, []float64{50, -40}, stats.Outliers)
+	})
+
+	t.Run("NaN Values Are Ignored", func(t *testing.T) {
+		stats, err := GetSliceStatsFloat64([]float64{1, math.NaN(), 3})
+		require.NoError(t, err)
+		assert.Equal(t, 3, stats.Length)
+		assert.Equal(t, 1, stats.NaNCount)
+		assert.Equal(t, 2.0, stats.Mean)
+		assert.Equal(t, 2.0, stats.Q2)
+
+		stats, err = GetSliceStatsFloat64([]float64{math.NaN()})
+		require.NoError(t, err)
+		assert.Equal(t, FloatStats{Length: 1, NaNCount: 1, Outliers: []float64{}}, stats)
+	})
+
+	t.Run("Single Element", func(t *testing.T) {
+		stats, err := GetSliceStatsFloat64([]float64{2.5})
+		require.NoError(t, err)
+		assert.Equal(t, 2.5, stats.Q1)
+		assert.Equal(t, 2.5, stats.Q3)
+		assert.Equal(t, 0.0, stats.IQR)
+		assert.Empty(t, stats.Outliers)
+	})
+
+	t.Run("Nil and Empty", func(t *testing.T) {
+		_, err := GetSliceStatsFloat64(nil)
+		assert.ErrorIs(t, err, ErrNilSlice)
+
+		stats, err := GetSliceStatsFloat64([]float64{})
+		require.NoError(t, err)
+		assert.Equal(t, FloatStats{Outliers: []float64{}}, stats)
+	})
+}
+
+// TestCovarianceAndPearson tests the Covariance and Pearson functions
+func TestCovarianceAndPearson(t *testing.T) {
+	t.Run("Covariance", func(t *testing.T) {
+		cov, err := Covariance([]float64{1, 2, 3}, []float64{2, 4, 6})
+		require.NoError(t, err)
+		assert.InDelta(t, 4.0/3, cov, 1e-15)
+
+		cov, err = Covariance([]int{1, 2, 3}, []int{3, 2, 1})
+		require.NoError(t, err)
+		assert.InDelta(t, -2.0/3, cov, 1e-15)
+
+		// The covariance of a slice with itself is its variance
+		a := []float64{2, 4, 4, 4, 5, 5, 7, 9}
+		cov, err = Covariance(a, a)
+		require.NoError(t, err)
+		assert.Equal(t, 4.0, cov)
+	})
+
+	t.Run("Pearson Perfect Correlation", func(t *testing.T) {
+		r, err := Pearson([]float64{1, 2, 3, 4}, []float64{10, 20, 30, 40})
+		require.NoError(t, err)
+		assert.Equal(t, 1.0, r)
+
+		r, err = Pearson([]float64{1, 2, 3, 4}, []float64{8, 6, 4, 2})
+		require.NoError(t, err)
+		assert.Equal(t, -1.0, r)
+	})
+
+	t.Run("Pearson Partial Correlation", func(t *testing.T) {
+		r, err := Pearson([]float64{1, 2, 3, 4, 5}, []float64{2, 1, 4, 3, 5})
+		require.NoError(t, err)
+		assert.InDelta(t, 0.8, r, 1e-12)
+	})
+
+	t.Run("Pearson Constant Slice", func(t *testing.T) {
+		r, err := Pearson([]float64{1, 2, 3}, []float64{5, 5, 5})
+		require.NoError(t, err)
+		assert.True(t, math.IsNaN(r))
+	})
+
+	t.Run("Length Mismatch", func(t *testing.T) {
+		_, err := Covariance([]float64{1, 2}, []float64{1})
+		assert.ErrorIs(t, err, ErrLengthMismatch)
+		_, err = Pearson([]float64{1}, []float64{})
+		assert.ErrorIs(t, err, ErrLengthMismatch)
+	})
+
+	t.Run("Nil and Empty", func(t *testing.T) {
+		_, err := Covariance(nil, []float64{1})
+		assert.ErrorIs(t, err, ErrNilSlice)
+		_, err = Pearson([]float64{}, []float64{})
+		assert.ErrorIs(t, err, ErrEmptySlice)
+	})
+}
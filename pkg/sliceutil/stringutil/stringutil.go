@@ -0,0 +1,92 @@
+// Package stringutil provides string-focused slice helpers that tolerate case and
+// whitespace noise. It complements package sliceutil, whose functions compare strings
+// byte for byte, so that comparisons of user-entered or externally sourced strings do
+// not fail on "Apple" versus " apple".
+package stringutil
+
+import (
+	"strings"
+
+	"github.com/devrob-go/sliceutil/pkg/sliceutil"
+)
+
+// NormalizeOptions selects the transformations applied by NormalizeStrings.
+// They are applied in field order: Unicode normalization, trimming, space collapsing,
+// then lower-casing.
+type NormalizeOptions struct {
+	// Unicode normalizes the Unicode representation of every string. The package has no
+	// dependencies beyond the standard library, so pass norm.NFC.String from
+	// golang.org/x/text/unicode/norm for NFC normalization. Nil skips this step.
+	Unicode func(string) string
+	// TrimSpace removes leading and trailing white space
+	TrimSpace bool
+	// CollapseSpace replaces every run of white space with a single space
+	CollapseSpace bool
+	// Lowercase maps every letter to lower case
+	Lowercase bool
+}
+
+// ContainsStringFold reports whether the slice contains a string equal to v under
+// Unicode case folding.
+//
+// Example:
+//
+//	found := ContainsStringFold([]string{"Go", "Rust"}, "GO") // returns true
+func ContainsStringFold(s []string, v string) bool {
+	for _, item := range s {
+		if strings.EqualFold(item, v) {
+			return true
+		}
+	}
+	return false
+}
+
+// CompareSlicesFold checks if two string slices are equal in order, treating strings
+// that differ only in case as equal. Nil slices follow the same rules as
+// sliceutil.CompareSlices.
+//
+// Example:
+//
+//	equal := CompareSlicesFold([]string{"a", "B"}, []string{"A", "b"}) // returns true
+func CompareSlicesFold(a, b []string) bool {
+	return sliceutil.CompareSlicesFunc(a, b, strings.EqualFold)
+}
+
+// RemoveDuplicatesFold removes strings that differ only in case from an earlier string,
+// preserving order. The first spelling of each string is retained. Strings are
+// duplicates exactly when ContainsStringFold and CompareSlicesFold consider them equal.
+//
+// Example:
+//
+//	tags := RemoveDuplicatesFold([]string{"Go", "go", "Rust", "GO"})
+//	// returns []string{"Go", "Rust"}
+func RemoveDuplicatesFold(s []string) []string {
+	return sliceutil.RemoveDuplicatesFunc(s, sliceutil.FoldKey)
+}
+
+// NormalizeStrings returns a copy of a string slice with the selected transformations
+// applied to every element. Normalizing both sides before a comparison makes it robust
+// against whitespace and case noise.
+//
+// Example:
+//
+//	opts := NormalizeOptions{TrimSpace: true, CollapseSpace: true, Lowercase: true}
+//	clean := NormalizeStrings([]string{"  New   York ", "BOSTON"}, opts)
+//	// returns []string{"new york", "boston"}
+func NormalizeStrings(s []string, opts NormalizeOptions) []string {
+	return sliceutil.Map(s, func(v string) string {
+		if opts.Unicode != nil {
+			v = opts.Unicode(v)
+		}
+		if opts.TrimSpace {
+			v = strings.TrimSpace(v)
+		}
+		if opts.CollapseSpace {
+			v = strings.Join(strings.Fields(v), " ")
+		}
+		if opts.Lowercase {
+			v = strings.ToLower(v)
+		}
+		return v
+	})
+}
@@ -0,0 +1,92 @@
+package stringutil
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestContainsStringFold tests the ContainsStringFold function
+func TestContainsStringFold(t *testing.T) {
+	t.Run("Case Insensitive", func(t *testing.T) {
+		s := []string{"Go", "Rust", "Straße"}
+		assert.True(t, ContainsStringFold(s, "GO"))
+		assert.True(t, ContainsStringFold(s, "rust"))
+		assert.True(t, ContainsStringFold(s, "STRAßE"))
+		assert.False(t, ContainsStringFold(s, "Zig"))
+	})
+
+	t.Run("Nil and Empty", func(t *testing.T) {
+		assert.False(t, ContainsStringFold(nil, "a"))
+		assert.False(t, ContainsStringFold([]string{}, ""))
+	})
+}
+
+// TestCompareSlicesFold tests the CompareSlicesFold function
+func TestCompareSlicesFold(t *testing.T) {
+	t.Run("Equal Ignoring Case", func(t *testing.T) {
+		assert.True(t, CompareSlicesFold([]string{"a", "B"}, []string{"A", "b"}))
+	})
+
+	t.Run("Different", func(t *testing.T) {
+		assert.False(t, CompareSlicesFold([]string{"a", "b"}, []string{"b", "a"}))
+		assert.False(t, CompareSlicesFold([]string{"a"}, []string{"a", "b"}))
+		assert.False(t, CompareSlicesFold([]string{"a "}, []string{"a"}))
+	})
+
+	t.Run("Nil Slices", func(t *testing.T) {
+		assert.True(t, CompareSlicesFold(nil, nil))
+		assert.False(t, CompareSlicesFold(nil, []string{}))
+	})
+}
+
+// TestRemoveDuplicatesFold tests the RemoveDuplicatesFold function
+func TestRemoveDuplicatesFold(t *testing.T) {
+	t.Run("Keeps First Spelling", func(t *testing.T) {
+		result := RemoveDuplicatesFold([]string{"Go", "go", "Rust", "GO", "rust"})
+		assert.Equal(t, []string{"Go", "Rust"}, result)
+	})
+
+	t.Run("Nil and Empty", func(t *testing.T) {
+		assert.Nil(t, RemoveDuplicatesFold(nil))
+		assert.Empty(t, RemoveDuplicatesFold([]string{}))
+	})
+}
+
+// TestNormalizeStrings tests the NormalizeStrings function
+func TestNormalizeStrings(t *testing.T) {
+	t.Run("All Options", func(t *testing.T) {
+		opts := NormalizeOptions{TrimSpace: true, CollapseSpace: true, Lowercase: true}
+		result := NormalizeStrings([]string{"  New   York ", "BOSTON", "\tSan\nJose"}, opts)
+		assert.Equal(t, []string{"new york", "boston", "san jose"}, result)
+	})
+
+	t.Run("Individual Options", func(t *testing.T) {
+		s := []string{"  A  B  "}
+		assert.Equal(t, []string{"A  B"}, NormalizeStrings(s, NormalizeOptions{TrimSpace: true}))
+		assert.Equal(t, []string{"A B"}, NormalizeStrings(s, NormalizeOptions{CollapseSpace: true}))
+		assert.Equal(t, []string{"  a  b  "}, NormalizeStrings(s, NormalizeOptions{Lowercase: true}))
+		assert.Equal(t, s, NormalizeStrings(s, NormalizeOptions{}))
+	})
+
+	t.Run("Unicode Normalization Runs First", func(t *testing.T) {
+		// Stand-in for norm.NFC.String: compose "e" + combining acute accent into "é"
+		compose := func(s string) string { return strings.ReplaceAll(s, "e\u0301", "\u00e9") }
+		opts := NormalizeOptions{Unicode: compose, Lowercase: true}
+
+		result := NormalizeStrings([]string{"Cafe\u0301", "CAF\u00c9"}, opts)
+		assert.Equal(t, []string{"caf\u00e9", "caf\u00e9"}, result)
+	})
+
+	t.Run("Input Not Modified", func(t *testing.T) {
+		s := []string{" A "}
+		NormalizeStrings(s, NormalizeOptions{TrimSpace: true, Lowercase: true})
+		assert.Equal(t, []string{" A "}, s)
+	})
+
+	t.Run("Nil and Empty", func(t *testing.T) {
+		assert.Nil(t, NormalizeStrings(nil, NormalizeOptions{Lowercase: true}))
+		assert.Equal(t, []string{}, NormalizeStrings([]string{}, NormalizeOptions{}))
+	})
+}
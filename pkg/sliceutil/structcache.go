@@ -0,0 +1,272 @@
+package sliceutil
+
+import (
+	"container/list"
+	"encoding/binary"
+	"hash"
+	"hash/fnv"
+	"math"
+	"reflect"
+	"strconv"
+)
+
+// structCacheEntry is a cached comparison result stored in the LRU list.
+type structCacheEntry struct {
+	key    string
+	result bool
+}
+
+// SetStructCacheConfig configures the memoization cache used by CompareStructs.
+// Caching is opt-in: results are only cached after the cache has been enabled.
+// Disabling the cache drops all entries, and lowering MaxEntries evicts the least
+// recently used entries that no longer fit.
+//
+// Cache keys are derived from the content of both values rather than their types or
+// addresses, so a cached result stays correct when a struct is modified in place.
+//
+// Example:
+//
+//	SetStructCacheConfig(StructCacheConfig{Enabled: true, MaxEntries: 512})
+//	defer SetStructCacheConfig(StructCacheConfig{})
+func SetStructCacheConfig(config StructCacheConfig) {
+	if config.MaxEntries <= 0 {
+		config.MaxEntries = DefaultStructCacheSize
+	}
+
+	structCache.Lock()
+	defer structCache.Unlock()
+
+	structCache.config = config
+	if !config.Enabled {
+		structCache.cache = make(map[string]*list.Element)
+		structCache.order.Init()
+		return
+	}
+	for structCache.order.Len() > config.MaxEntries {
+		evictOldestComparison()
+	}
+}
+
+// GetStructCacheConfig returns the current configuration of the struct comparison cache.
+func GetStructCacheConfig() StructCacheConfig {
+	structCache.Lock()
+	defer structCache.Unlock()
+	return structCache.config
+}
+
+// ClearStructCache clears the memoization cache for struct comparisons and resets
+// its hit, miss and eviction counters. The cache configuration is kept.
+// This function is useful when memory usage becomes a concern or when
+// you want to ensure fresh comparisons.
+func ClearStructCache() {
+	structCache.Lock()
+	defer structCache.Unlock()
+	structCache.cache = make(map[string]*list.Element)
+	structCache.order.Init()
+	structCache.hits = 0
+	structCache.misses = 0
+	structCache.evictions = 0
+}
+
+// GetStructCacheStats returns statistics about the struct comparison cache.
+// This is useful for monitoring cache performance and memory usage.
+//
+// The returned map contains "cache_size", "cache_keys", "hits", "misses",
+// "evictions", "max_entries" and "enabled".
+func GetStructCacheStats() map[string]interface{} {
+	structCache.Lock()
+	defer structCache.Unlock()
+
+	return map[string]interface{}{
+		"cache_size":  len(structCache.cache),
+		"cache_keys":  reflect.ValueOf(structCache.cache).MapKeys(),
+		"hits":        structCache.hits,
+		"misses":      structCache.misses,
+		"evictions":   structCache.evictions,
+		"max_entries": structCache.config.MaxEntries,
+		"enabled":     structCache.config.Enabled,
+	}
+}
+
+// structCacheKey is a helper function that builds the cache key for comparing two values.
+// It reports false when the cache is disabled, so no hashing work is done in that case.
+func structCacheKey(a, b interface{}) (string, bool) {
+	structCache.Lock()
+	enabled := structCache.config.Enabled
+	structCache.Unlock()
+	if !enabled || a == nil || b == nil {
+		return "", false
+	}
+
+	h := fnv.New64a()
+	writeContentHash(h, reflect.ValueOf(a), make(map[uintptr]bool))
+	hashA := h.Sum64()
+
+	h.Reset()
+	writeContentHash(h, reflect.ValueOf(b), make(map[uintptr]bool))
+	hashB := h.Sum64()
+
+	key := reflect.TypeOf(a).String() + ":" + strconv.FormatUint(hashA, 16) +
+		"|" + reflect.TypeOf(b).String() + ":" + strconv.FormatUint(hashB, 16)
+	return key, true
+}
+
+// getCachedComparison retrieves a cached comparison result and marks it as recently used.
+// Returns the result and a boolean indicating if the result was found.
+func getCachedComparison(key string) (bool, bool) {
+	structCache.Lock()
+	defer structCache.Unlock()
+
+	elem, found := structCache.cache[key]
+	if !found {
+		structCache.misses++
+		return false, false
+	}
+	structCache.hits++
+	structCache.order.MoveToFront(elem)
+	return elem.Value.(*structCacheEntry).result, true
+}
+
+// cacheComparisonResult stores the comparison result in the cache, evicting the least
+// recently used entry when the cache is full. This function is thread-safe.
+func cacheComparisonResult(key string, result bool) {
+	structCache.Lock()
+	defer structCache.Unlock()
+
+	if !structCache.config.Enabled {
+		return
+	}
+	if elem, found := structCache.cache[key]; found {
+		elem.Value.(*structCacheEntry).result = result
+		structCache.order.MoveToFront(elem)
+		return
+	}
+
+	structCache.cache[key] = structCache.order.PushFront(&structCacheEntry{key: key, result: result})
+	for structCache.order.Len() > structCache.config.MaxEntries {
+		evictOldestComparison()
+	}
+}
+
+// evictOldestComparison is a helper function that removes the least recently used entry.
+// The caller must hold the cache lock.
+func evictOldestComparison() {
+	oldest := structCache.order.Back()
+	if oldest == nil {
+		return
+	}
+	structCache.order.Remove(oldest)
+	delete(structCache.cache, oldest.Value.(*structCacheEntry).key)
+	structCache.evictions++
+}
+
+// writeContentHash is a helper function that writes a hash of the content of a value,
+// following pointers, slices, maps and interfaces. Map entries are combined in an
+// order-insensitive way, and pointers already being visited are hashed by address so
+// that cyclic values terminate.
+func writeContentHash(h hash.Hash64, v reflect.Value, visited map[uintptr]bool) {
+	var buf [9]byte
+	if !v.IsValid() {
+		buf[0] = 'n'
+		_, _ = h.Write(buf[:1])
+		return
+	}
+
+	switch v.Kind() {
+	case reflect.Bool:
+		buf[0] = 'b'
+		if v.Bool() {
+			buf[1] = 1
+		}
+		_, _ = h.Write(buf[:2])
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		buf[0] = 'i'
+		binary.LittleEndian.PutUint64(buf[1:], uint64(v.Int()))
+		_, _ = h.Write(buf[:])
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		buf[0] = 'u'
+		binary.LittleEndian.PutUint64(buf[1:], v.Uint())
+		_, _ = h.Write(buf[:])
+	case reflect.Float32, reflect.Float64:
+		buf[0] = 'f'
+		binary.LittleEndian.PutUint64(buf[1:], math.Float64bits(v.Float()))
+		_, _ = h.Write(buf[:])
+	case reflect.Complex64, reflect.Complex128:
+		c := v.Complex()
+		buf[0] = 'c'
+		binary.LittleEndian.PutUint64(buf[1:], math.Float64bits(real(c)))
+		_, _ = h.Write(buf[:])
+		binary.LittleEndian.PutUint64(buf[1:], math.Float64bits(imag(c)))
+		_, _ = h.Write(buf[1:])
+	case reflect.String:
+		buf[0] = 's'
+		_, _ = h.Write(buf[:1])
+		writeHashLength(h, v.Len())
+		_, _ = h.Write([]byte(v.String()))
+	case reflect.Ptr:
+		if v.IsNil() {
+			buf[0] = 'n'
+			_, _ = h.Write(buf[:1])
+			return
+		}
+		if visited[v.Pointer()] {
+			buf[0] = 'r'
+			binary.LittleEndian.PutUint64(buf[1:], uint64(v.Pointer()))
+			_, _ = h.Write(buf[:])
+			return
+		}
+		visited[v.Pointer()] = true
+		buf[0] = 'p'
+		_, _ = h.Write(buf[:1])
+		writeContentHash(h, v.Elem(), visited)
+		delete(visited, v.Pointer())
+	case reflect.Interface:
+		buf[0] = 'e'
+		_, _ = h.Write(buf[:1])
+		if !v.IsNil() {
+			_, _ = h.Write([]byte(v.Elem().Type().String()))
+		}
+		writeContentHash(h, v.Elem(), visited)
+	case reflect.Struct:
+		buf[0] = 't'
+		_, _ = h.Write(buf[:1])
+		for i := 0; i < v.NumField(); i++ {
+			writeContentHash(h, v.Field(i), visited)
+		}
+	case reflect.Slice, reflect.Array:
+		buf[0] = 'a'
+		if v.Kind() == reflect.Slice && v.IsNil() {
+			buf[0] = 'n'
+		}
+		_, _ = h.Write(buf[:1])
+		writeHashLength(h, v.Len())
+		for i := 0; i < v.Len(); i++ {
+			writeContentHash(h, v.Index(i), visited)
+		}
+	case reflect.Map:
+		if v.IsNil() {
+			buf[0] = 'n'
+			_, _ = h.Write(buf[:1])
+			return
+		}
+		// Combine entry hashes with a commutative operation, as map order is random
+		var sum uint64
+		entry := fnv.New64a()
+		iter := v.MapRange()
+		for iter.Next() {
+			entry.Reset()
+			writeContentHash(entry, iter.Key(), visited)
+			writeContentHash(entry, iter.Value(), visited)
+			sum += mixHash(entry.Sum64())
+		}
+		buf[0] = 'm'
+		binary.LittleEndian.PutUint64(buf[1:], sum)
+		_, _ = h.Write(buf[:])
+		writeHashLength(h, v.Len())
+	default:
+		// Functions, channels and unsafe pointers are identified by address
+		buf[0] = 'x'
+		binary.LittleEndian.PutUint64(buf[1:], uint64(v.Pointer()))
+		_, _ = h.Write(buf[:])
+	}
+}
@@ -0,0 +1,147 @@
+package sliceutil
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// enableStructCache turns the struct cache on for the duration of a test.
+func enableStructCache(t *testing.T, maxEntries int) {
+	t.Helper()
+	ClearStructCache()
+	SetStructCacheConfig(StructCacheConfig{Enabled: true, MaxEntries: maxEntries})
+	t.Cleanup(func() {
+		SetStructCacheConfig(StructCacheConfig{})
+		ClearStructCache()
+	})
+}
+
+// TestStructCacheConfig tests the SetStructCacheConfig and GetStructCacheConfig functions
+func TestStructCacheConfig(t *testing.T) {
+	type Person struct {
+		Name string
+		Age  int
+	}
+
+	t.Run("Disabled By Default", func(t *testing.T) {
+		ClearStructCache()
+		assert.False(t, GetStructCacheConfig().Enabled)
+
+		CompareStructs(Person{Name: "Alice"}, Person{Name: "Alice"})
+		stats := GetStructCacheStats()
+		assert.Equal(t, 0, stats["cache_size"])
+		assert.Equal(t, 0, stats["misses"])
+	})
+
+	t.Run("Default Size", func(t *testing.T) {
+		enableStructCache(t, 0)
+		assert.Equal(t, DefaultStructCacheSize, GetStructCacheConfig().MaxEntries)
+	})
+
+	t.Run("Disabling Drops Entries", func(t *testing.T) {
+		enableStructCache(t, 10)
+		CompareStructs(Person{Name: "Alice"}, Person{Name: "Bob"})
+		assert.Equal(t, 1, GetStructCacheStats()["cache_size"])
+
+		SetStructCacheConfig(StructCacheConfig{})
+		assert.Equal(t, 0, GetStructCacheStats()["cache_size"])
+	})
+}
+
+// TestStructCacheHitsAndMisses tests that results are reused and counted
+func TestStructCacheHitsAndMisses(t *testing.T) {
+	type Person struct {
+		Name string
+		Age  int
+	}
+	enableStructCache(t, 10)
+
+	a := Person{Name: "Alice", Age: 30}
+	b := Person{Name: "Alice", Age: 30}
+
+	assert.True(t, CompareStructs(a, b))
+	assert.True(t, CompareStructs(a, b))
+
+	stats := GetStructCacheStats()
+	assert.Equal(t, 1, stats["cache_size"])
+	assert.Equal(t, 1, stats["misses"])
+	assert.Equal(t, 1, stats["hits"])
+}
+
+// TestStructCacheContentKeys tests that cache keys follow content rather than identity
+func TestStructCacheContentKeys(t *testing.T) {
+	type Address struct {
+		City string
+	}
+	type Person struct {
+		Name    string
+		Address *Address
+		Tags    map[string]int
+	}
+	enableStructCache(t, 10)
+
+	t.Run("Mutation In Place Is Not Stale", func(t *testing.T) {
+		a := &Person{Name: "Alice", Address: &Address{City: "NY"}}
+		b := &Person{Name: "Alice", Address: &Address{City: "NY"}}
+		assert.True(t, CompareStructs(a, b))
+
+		b.Address.City = "LA"
+		assert.False(t, CompareStructs(a, b))
+
+		b.Address.City = "NY"
+		assert.True(t, CompareStructs(a, b))
+	})
+
+	t.Run("Same Type Different Values", func(t *testing.T) {
+		assert.True(t, CompareStructs(Person{Name: "x"}, Person{Name: "x"}))
+		assert.False(t, CompareStructs(Person{Name: "x"}, Person{Name: "y"}))
+	})
+
+	t.Run("Equal Content At Different Addresses Hits", func(t *testing.T) {
+		ClearStructCache()
+		CompareStructs(&Person{Name: "Carol", Tags: map[string]int{"a": 1, "b": 2}}, &Person{Name: "Carol"})
+		CompareStructs(&Person{Name: "Carol", Tags: map[string]int{"b": 2, "a": 1}}, &Person{Name: "Carol"})
+		assert.Equal(t, 1, GetStructCacheStats()["hits"])
+	})
+
+	t.Run("Cyclic Values", func(t *testing.T) {
+		type Node struct {
+			Value int
+			Next  *Node
+		}
+		x := &Node{Value: 1}
+		x.Next = x
+		key, ok := structCacheKey(x, x)
+		assert.True(t, ok)
+		assert.NotEmpty(t, key)
+	})
+}
+
+// TestStructCacheEviction tests the LRU eviction policy
+func TestStructCacheEviction(t *testing.T) {
+	type Item struct {
+		ID int
+	}
+	enableStructCache(t, 2)
+
+	CompareStructs(Item{1}, Item{1})
+	CompareStructs(Item{2}, Item{2})
+	CompareStructs(Item{1}, Item{1}) // Touch 1 so that 2 becomes least recently used
+	CompareStructs(Item{3}, Item{3}) // Evicts 2
+
+	stats := GetStructCacheStats()
+	assert.Equal(t, 2, stats["cache_size"])
+	assert.Equal(t, 1, stats["evictions"])
+
+	CompareStructs(Item{1}, Item{1})
+	assert.Equal(t, 2, GetStructCacheStats()["hits"])
+
+	CompareStructs(Item{2}, Item{2})
+	assert.Equal(t, 4, GetStructCacheStats()["misses"])
+
+	t.Run("Shrinking Evicts", func(t *testing.T) {
+		SetStructCacheConfig(StructCacheConfig{Enabled: true, MaxEntries: 1})
+		assert.Equal(t, 1, GetStructCacheStats()["cache_size"])
+	})
+}
@@ -0,0 +1,627 @@
+package sliceutil
+
+import (
+	"fmt"
+	"maps"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// StructCompareOption configures CompareStructsWithOptions and CompareStructsWithResult.
+type StructCompareOption func(*structComparer)
+
+// FieldDiffKind describes where in a struct a difference was found
+type FieldDiffKind string
+
+const (
+	// FieldDiffValue marks a difference in a field of the root struct
+	FieldDiffValue FieldDiffKind = "VALUE"
+	// FieldDiffStruct marks a difference inside a nested struct
+	FieldDiffStruct FieldDiffKind = "STRUCT"
+	// FieldDiffSlice marks a difference inside a slice or array, or in its length
+	FieldDiffSlice FieldDiffKind = "SLICE"
+	// FieldDiffPointer marks a difference behind a pointer, or in whether it is nil
+	FieldDiffPointer FieldDiffKind = "POINTER"
+	// FieldDiffMap marks a difference in a map entry, or in whether the map is nil
+	FieldDiffMap FieldDiffKind = "MAP"
+	// FieldDiffInterface marks a difference in the dynamic type of an interface value
+	FieldDiffInterface FieldDiffKind = "INTERFACE"
+)
+
+// FieldDiff describes a single difference between two structs
+type FieldDiff struct {
+	// Path locates the value, such as "Address.City", "Items[2].Name" or "Labels[env]"
+	Path string        `json:"path"`
+	Old  interface{}   `json:"old"`
+	New  interface{}   `json:"new"`
+	Kind FieldDiffKind `json:"kind"`
+}
+
+// StructElementDiff lists the differing fields of the elements at one position of two
+// struct slices
+type StructElementDiff struct {
+	Index      int         `json:"index"`
+	FieldDiffs []FieldDiff `json:"field_diffs"`
+}
+
+// StructSliceDiff describes how two slices of structs differ element by element
+type StructSliceDiff struct {
+	Equal bool `json:"equal"`
+	// ANil and BNil report which input was nil when only one of them is
+	ANil bool `json:"a_nil,omitempty"`
+	BNil bool `json:"b_nil,omitempty"`
+	// LengthA and LengthB are the lengths of the inputs; elements beyond the shorter
+	// length are not compared
+	LengthA int `json:"length_a"`
+	LengthB int `json:"length_b"`
+	// Elements lists the positions present in both slices whose elements differ
+	Elements []StructElementDiff `json:"elements,omitempty"`
+}
+
+// Reconciliation describes how a keyed slice of structs changed between two versions
+type Reconciliation[T any] struct {
+	// Added holds the elements whose key only occurs in the new slice, in its order
+	Added []T `json:"added"`
+	// Removed holds the elements whose key only occurs in the old slice, in its order
+	Removed []T `json:"removed"`
+	// Changed lists the differing fields of elements present in both slices. Paths are
+	// prefixed with the key of the element, such as "[42].Address.City".
+	Changed []FieldDiff `json:"changed"`
+}
+
+// IgnoreFields excludes fields from a struct comparison. Fields are named by their
+// dotted path from the root struct, such as "UpdatedAt" or "Address.City".
+// Slice, array and map positions are not part of the path, so "Items.UpdatedAt"
+// ignores the UpdatedAt field of every element of the Items slice.
+//
+// Example:
+//
+//	equal := CompareStructsWithOptions(a, b, IgnoreFields("UpdatedAt", "Address.Geo"))
+func IgnoreFields(fields ...string) StructCompareOption {
+	return func(c *structComparer) {
+		for _, field := range fields {
+			c.ignored[field] = true
+		}
+	}
+}
+
+// WithComparator registers a custom equality function for values of type T. It is used
+// wherever a value of that type occurs: at the top level, in fields, behind pointers
+// and inside slices, and takes precedence over comparators registered with
+// RegisterComparator. This is useful for types whose fields are unexported.
+//
+// Example:
+//
+//	equal := CompareStructsWithOptions(a, b, WithComparator(func(x, y time.Time) bool {
+//		return x.Truncate(time.Second).Equal(y.Truncate(time.Second))
+//	}))
+func WithComparator[T any](eq func(a, b T) bool) StructCompareOption {
+	return func(c *structComparer) {
+		c.comparators[reflect.TypeFor[T]()] = func(a, b reflect.Value) bool {
+			return eq(a.Interface().(T), b.Interface().(T))
+		}
+	}
+}
+
+// WithTimeTolerance treats time.Time values that are at most d apart as equal. It
+// replaces the built-in time.Time comparison, which requires the same instant, and
+// is useful for timestamps that were rounded or truncated by a database.
+//
+// Example:
+//
+//	equal := CompareStructsWithOptions(a, b, WithTimeTolerance(time.Millisecond))
+func WithTimeTolerance(d time.Duration) StructCompareOption {
+	return WithComparator(func(a, b time.Time) bool {
+		diff := a.Sub(b)
+		return diff <= d && diff >= -d
+	})
+}
+
+// withFloatTolerance is a helper function that returns an option treating float32 and
+// float64 values, including named float types, that differ by at most epsilon as equal.
+func withFloatTolerance(epsilon float64) StructCompareOption {
+	return func(c *structComparer) {
+		c.floatEqual = func(x, y float64) bool {
+			return floatsApproxEqual(x, y, epsilon)
+		}
+	}
+}
+
+// comparatorRegistry holds the comparators used by every struct comparison. The map is
+// replaced rather than modified on registration, so comparisons read it without locking.
+var comparatorRegistry = struct {
+	sync.Mutex
+	comparators atomic.Pointer[map[reflect.Type]func(a, b reflect.Value) bool]
+}{}
+
+func init() {
+	comparatorRegistry.comparators.Store(&map[reflect.Type]func(a, b reflect.Value) bool{
+		reflect.TypeFor[time.Time](): func(a, b reflect.Value) bool {
+			return a.Interface().(time.Time).Equal(b.Interface().(time.Time))
+		},
+	})
+}
+
+// RegisterComparator registers an equality function for values of type T that is used
+// by every struct comparison, including CompareStructs. This is intended for opaque
+// types from other packages, such as UUIDs or decimals, whose fields are unexported
+// and would otherwise be skipped. Comparators passed with WithComparator take
+// precedence over registered ones.
+//
+// time.Time is registered by default and compared with time.Time.Equal, so the
+// location and monotonic clock reading do not affect the result. Registering a
+// comparator clears the struct comparison cache, as cached results may no longer hold.
+// RegisterComparator is safe for concurrent use, but is typically called during
+// program initialization.
+//
+// Example:
+//
+//	RegisterComparator(func(a, b decimal.Decimal) bool {
+//		return a.Equal(b)
+//	})
+func RegisterComparator[T any](eq func(a, b T) bool) {
+	comparatorRegistry.Lock()
+	defer comparatorRegistry.Unlock()
+
+	comparators := maps.Clone(*comparatorRegistry.comparators.Load())
+	comparators[reflect.TypeFor[T]()] = func(a, b reflect.Value) bool {
+		return eq(a.Interface().(T), b.Interface().(T))
+	}
+	comparatorRegistry.comparators.Store(&comparators)
+	ClearStructCache()
+}
+
+// CompareStructsWithOptions compares two structs deeply like CompareStructs, with
+// control over which fields take part in the comparison and how values are compared.
+//
+// Fields tagged with `sliceutil:"-"` are always skipped, fields named with IgnoreFields
+// are skipped, and types registered with WithComparator or RegisterComparator use the
+// custom equality function. time.Time values are equal when they denote the same instant.
+// Unexported fields are ignored as they cannot be accessed via reflection.
+//
+// Example:
+//
+//	type Record struct {
+//		ID        int
+//		Name      string
+//		Revision  int       `sliceutil:"-"`
+//		UpdatedAt time.Time
+//	}
+//	equal := CompareStructsWithOptions(a, b, IgnoreFields("UpdatedAt"))
+func CompareStructsWithOptions(a, b interface{}, opts ...StructCompareOption) bool {
+	// If both are nil, they are equal
+	if a == nil && b == nil {
+		return true
+	}
+
+	// If one is nil, they are not equal
+	if a == nil || b == nil {
+		return false
+	}
+
+	// Check if the types are the same
+	if reflect.TypeOf(a) != reflect.TypeOf(b) {
+		return false
+	}
+
+	c := newStructComparer(opts)
+	return c.equal(reflect.ValueOf(a), reflect.ValueOf(b), valuePath{kind: FieldDiffValue})
+}
+
+// CompareStructsWithResult compares two structs deeply and reports every differing field.
+// It accepts the same options as CompareStructsWithOptions. When the structs differ,
+// Details.FieldDiffs holds the path, old and new value and kind of each difference,
+// and Details.DifferenceCount holds the number of differences.
+//
+// Example:
+//
+//	result := CompareStructsWithResult(before, after)
+//	for _, diff := range result.Details.FieldDiffs {
+//		fmt.Printf("%s: %v -> %v\n", diff.Path, diff.Old, diff.New)
+//	}
+//	// Address.City: New York -> Boston
+func CompareStructsWithResult(a, b interface{}, opts ...StructCompareOption) CompareResult {
+	result := CompareResult{
+		Equal:   true,
+		Message: "Structs are equal",
+	}
+
+	// Check for nil values
+	if a == nil || b == nil {
+		if a == nil && b == nil {
+			return result
+		}
+		result.Equal = false
+		result.Message = "One struct is nil while the other is not"
+		result.Details.ANil = a == nil
+		result.Details.BNil = b == nil
+		return result
+	}
+
+	// Check if the types are the same
+	if reflect.TypeOf(a) != reflect.TypeOf(b) {
+		result.Equal = false
+		result.Message = "Structs have different types"
+		result.Details.TypeA = reflect.TypeOf(a).String()
+		result.Details.TypeB = reflect.TypeOf(b).String()
+		return result
+	}
+
+	c := newStructComparer(opts)
+	c.report = true
+	if !c.equal(reflect.ValueOf(a), reflect.ValueOf(b), valuePath{kind: FieldDiffValue}) {
+		result.Equal = false
+		result.Message = "Structs differ in specific fields"
+		result.Details.FieldDiffs = c.diffs
+		result.Details.DifferenceCount = len(c.diffs)
+	}
+
+	return result
+}
+
+// CompareStructSlices compares two slices of structs element by element like
+// CompareStructsWithResult and reports the index and differing fields of every
+// mismatched pair. It accepts the same options as CompareStructsWithOptions, which
+// apply to every element. A nil slice only equals another nil slice, and slices of
+// different lengths are never equal; their common prefix is still compared.
+//
+// Time complexity: O(n*m) where n is the length of the shorter slice and m the size of an element
+// Space complexity: O(d) where d is the number of differing fields
+//
+// Example:
+//
+//	diff := CompareStructSlices(before, after, IgnoreFields("UpdatedAt"))
+//	for _, element := range diff.Elements {
+//		for _, field := range element.FieldDiffs {
+//			fmt.Printf("[%d] %s: %v -> %v\n", element.Index, field.Path, field.Old, field.New)
+//		}
+//	}
+//	// [2] Address.City: New York -> Boston
+func CompareStructSlices[T any](a, b []T, opts ...StructCompareOption) StructSliceDiff {
+	diff := StructSliceDiff{
+		ANil:    a == nil && b != nil,
+		BNil:    b == nil && a != nil,
+		LengthA: len(a),
+		LengthB: len(b),
+	}
+
+	c := newStructComparer(opts)
+	c.report = true
+	for i := range min(len(a), len(b)) {
+		// Pointer pairs seen in earlier elements must be compared again
+		c.diffs = nil
+		clear(c.visited)
+		valueA := reflect.ValueOf(&a[i]).Elem()
+		valueB := reflect.ValueOf(&b[i]).Elem()
+		if !c.equal(valueA, valueB, valuePath{kind: FieldDiffValue}) {
+			diff.Elements = append(diff.Elements, StructElementDiff{Index: i, FieldDiffs: c.diffs})
+		}
+	}
+
+	diff.Equal = !diff.ANil && !diff.BNil && len(a) == len(b) && len(diff.Elements) == 0
+	return diff
+}
+
+// ReconcileByKey matches the elements of an old and a new version of a slice by key and
+// reports which elements were added, which were removed and which fields of the
+// remaining elements changed, for example to sync database rows with an API response.
+// Matched elements are compared like CompareStructsWithResult, accepting the same
+// options, and their differences are reported in the order of the old slice.
+//
+// Keys occurring several times are matched positionally like AlignByKey: the first
+// occurrence in old is paired with the first occurrence in new, and so on. The result
+// slices are never nil.
+//
+// Time complexity: O(n + m) comparisons where n and m are the lengths of the slices
+// Space complexity: O(n + m) for the lookup index and results
+//
+// Example:
+//
+//	report := ReconcileByKey(rows, response, func(u User) int { return u.ID },
+//		IgnoreFields("UpdatedAt"))
+//	for _, diff := range report.Changed {
+//		fmt.Printf("%s: %v -> %v\n", diff.Path, diff.Old, diff.New)
+//	}
+//	// [42].Email: old@example.com -> new@example.com
+func ReconcileByKey[T any, K comparable](old, new []T, key func(T) K, opts ...StructCompareOption) Reconciliation[T] {
+	pairs, removed, added := AlignByKey(old, new, key)
+	report := Reconciliation[T]{Added: added, Removed: removed, Changed: make([]FieldDiff, 0)}
+
+	c := newStructComparer(opts)
+	c.report = true
+	for _, pair := range pairs {
+		c.diffs = nil
+		clear(c.visited)
+		valueA := reflect.ValueOf(&pair.First).Elem()
+		valueB := reflect.ValueOf(&pair.Second).Elem()
+		if c.equal(valueA, valueB, valuePath{kind: FieldDiffValue}) {
+			continue
+		}
+
+		prefix := "[" + fmt.Sprint(key(pair.First)) + "]"
+		for _, diff := range c.diffs {
+			switch {
+			case diff.Path == "":
+				diff.Path = prefix
+			case strings.HasPrefix(diff.Path, "["):
+				diff.Path = prefix + diff.Path
+			default:
+				diff.Path = prefix + "." + diff.Path
+			}
+			report.Changed = append(report.Changed, diff)
+		}
+	}
+	return report
+}
+
+// structComparer walks two values of the same type and compares them field by field
+// according to the configured options. When report is set, it keeps walking after the
+// first difference and records every difference in diffs.
+type structComparer struct {
+	ignored     map[string]bool
+	comparators map[reflect.Type]func(a, b reflect.Value) bool
+	registered  map[reflect.Type]func(a, b reflect.Value) bool
+	floatEqual  func(x, y float64) bool
+	report      bool
+	diffs       []FieldDiff
+	visited     map[structVisit]bool
+}
+
+// structVisit identifies a pair of pointers being compared, used to detect cycles.
+type structVisit struct {
+	a, b uintptr
+	typ  reflect.Type
+}
+
+// valuePath locates a value within the root struct during a comparison.
+type valuePath struct {
+	// field is the dotted field path without positions, used to match ignored fields
+	field string
+	// full is the path including slice positions, used to report differences
+	full string
+	// kind is the kind of container the value was reached through
+	kind FieldDiffKind
+}
+
+// newStructComparer is a helper function that builds a structComparer from options.
+func newStructComparer(opts []StructCompareOption) *structComparer {
+	c := &structComparer{
+		ignored:     make(map[string]bool),
+		comparators: make(map[reflect.Type]func(a, b reflect.Value) bool),
+		registered:  *comparatorRegistry.comparators.Load(),
+		visited:     make(map[structVisit]bool),
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// equal recursively compares two values of the same type found at the given path.
+func (c *structComparer) equal(a, b reflect.Value, path valuePath) bool {
+	if eq, ok := c.comparator(a.Type()); ok && a.CanInterface() && b.CanInterface() {
+		return eq(a, b) || c.mismatch(a, b, path)
+	}
+
+	switch a.Kind() {
+	case reflect.Float32, reflect.Float64:
+		if c.floatEqual != nil {
+			return c.floatEqual(a.Float(), b.Float()) || c.mismatch(a, b, path)
+		}
+		return a.Float() == b.Float() || c.mismatch(a, b, path)
+	case reflect.Ptr:
+		if a.IsNil() || b.IsNil() {
+			if a.IsNil() && b.IsNil() {
+				return true
+			}
+			return c.mismatch(a, b, path.within(FieldDiffPointer))
+		}
+
+		// A pair of pointers already under comparison is part of a cycle. Like
+		// reflect.DeepEqual, assume it is equal; any difference is found elsewhere.
+		visit := structVisit{a: a.Pointer(), b: b.Pointer(), typ: a.Type()}
+		if a.Pointer() == b.Pointer() || c.visited[visit] {
+			return true
+		}
+		c.visited[visit] = true
+		return c.equal(a.Elem(), b.Elem(), path.within(FieldDiffPointer))
+	case reflect.Struct:
+		// Fields of a plain nested struct are nested, all others keep the container kind
+		fieldKind := path.kind
+		if path.full != "" && path.kind == FieldDiffValue {
+			fieldKind = FieldDiffStruct
+		}
+
+		equal := true
+		for i := 0; i < a.NumField(); i++ {
+			field := a.Type().Field(i)
+
+			// Skip unexported and excluded fields
+			if !field.IsExported() || isSkippedField(field) {
+				continue
+			}
+			fieldPath := path.child(field.Name, fieldKind)
+			if c.ignored[fieldPath.field] {
+				continue
+			}
+
+			if !c.equal(a.Field(i), b.Field(i), fieldPath) {
+				equal = false
+				if !c.report {
+					return false
+				}
+			}
+		}
+		return equal
+	case reflect.Slice, reflect.Array:
+		if a.Kind() == reflect.Slice && a.IsNil() != b.IsNil() {
+			return c.mismatch(a, b, path.within(FieldDiffSlice))
+		}
+		if a.Len() != b.Len() {
+			return c.mismatch(a, b, path.within(FieldDiffSlice))
+		}
+
+		equal := true
+		for i := 0; i < a.Len(); i++ {
+			if !c.equal(a.Index(i), b.Index(i), path.index(i)) {
+				equal = false
+				if !c.report {
+					return false
+				}
+			}
+		}
+		return equal
+	case reflect.Map:
+		if a.IsNil() || b.IsNil() {
+			if a.IsNil() && b.IsNil() {
+				return true
+			}
+			return c.mismatch(a, b, path.within(FieldDiffMap))
+		}
+
+		visit := structVisit{a: a.Pointer(), b: b.Pointer(), typ: a.Type()}
+		if a.Pointer() == b.Pointer() || c.visited[visit] {
+			return true
+		}
+		c.visited[visit] = true
+		if a.Len() != b.Len() && !c.report {
+			return false
+		}
+
+		equal := true
+		for _, key := range sortedMapKeys(a, b) {
+			valueA := a.MapIndex(key)
+			valueB := b.MapIndex(key)
+			keyPath := path.key(key)
+			if !valueA.IsValid() || !valueB.IsValid() {
+				// The key is present in only one of the maps
+				equal = c.mismatch(valueA, valueB, keyPath)
+			} else if !c.equal(valueA, valueB, keyPath) {
+				equal = false
+			}
+			if !equal && !c.report {
+				return false
+			}
+		}
+		return equal
+	case reflect.Interface:
+		if a.IsNil() || b.IsNil() {
+			if a.IsNil() && b.IsNil() {
+				return true
+			}
+			return c.mismatch(a, b, path.within(FieldDiffInterface))
+		}
+		if a.Elem().Type() != b.Elem().Type() {
+			return c.mismatch(a, b, path.within(FieldDiffInterface))
+		}
+		return c.equal(a.Elem(), b.Elem(), path)
+	default:
+		if !a.CanInterface() || !b.CanInterface() {
+			return false
+		}
+		return reflect.DeepEqual(a.Interface(), b.Interface()) || c.mismatch(a, b, path)
+	}
+}
+
+// comparator returns the custom equality function for a type, preferring comparators
+// passed as options over registered ones.
+func (c *structComparer) comparator(t reflect.Type) (func(a, b reflect.Value) bool, bool) {
+	if eq, ok := c.comparators[t]; ok {
+		return eq, true
+	}
+	eq, ok := c.registered[t]
+	return eq, ok
+}
+
+// mismatch records a difference when reporting and always returns false.
+func (c *structComparer) mismatch(a, b reflect.Value, path valuePath) bool {
+	if c.report {
+		c.diffs = append(c.diffs, FieldDiff{
+			Path: path.full,
+			Old:  interfaceOrNil(a),
+			New:  interfaceOrNil(b),
+			Kind: path.kind,
+		})
+	}
+	return false
+}
+
+// child returns the path of a struct field reached through a container of the given kind.
+func (p valuePath) child(name string, kind FieldDiffKind) valuePath {
+	return valuePath{
+		field: joinFieldPath(p.field, name),
+		full:  joinFieldPath(p.full, name),
+		kind:  kind,
+	}
+}
+
+// index returns the path of a slice or array element.
+func (p valuePath) index(i int) valuePath {
+	return valuePath{
+		field: p.field,
+		full:  p.full + "[" + strconv.Itoa(i) + "]",
+		kind:  FieldDiffSlice,
+	}
+}
+
+// key returns the path of a map entry.
+func (p valuePath) key(k reflect.Value) valuePath {
+	return valuePath{
+		field: p.field,
+		full:  p.full + "[" + fmt.Sprint(interfaceOrNil(k)) + "]",
+		kind:  FieldDiffMap,
+	}
+}
+
+// within returns the same path reached through a container of the given kind.
+// The root keeps its kind, so the fields of a struct passed by pointer are top-level.
+func (p valuePath) within(kind FieldDiffKind) valuePath {
+	if p.full != "" {
+		p.kind = kind
+	}
+	return p
+}
+
+// sortedMapKeys is a helper function that returns the union of the keys of two maps,
+// ordered by their printed form so that differences are reported deterministically.
+func sortedMapKeys(a, b reflect.Value) []reflect.Value {
+	keys := a.MapKeys()
+	for _, key := range b.MapKeys() {
+		if !a.MapIndex(key).IsValid() {
+			keys = append(keys, key)
+		}
+	}
+	sort.SliceStable(keys, func(i, j int) bool {
+		return fmt.Sprint(interfaceOrNil(keys[i])) < fmt.Sprint(interfaceOrNil(keys[j]))
+	})
+	return keys
+}
+
+// interfaceOrNil is a helper function that returns the value held by v, or nil if v is
+// invalid or cannot be accessed.
+func interfaceOrNil(v reflect.Value) interface{} {
+	if !v.IsValid() || !v.CanInterface() {
+		return nil
+	}
+	return v.Interface()
+}
+
+// isSkippedField is a helper function that reports whether a field carries the
+// `sliceutil:"-"` tag.
+func isSkippedField(field reflect.StructField) bool {
+	tag, _, _ := strings.Cut(field.Tag.Get("sliceutil"), ",")
+	return tag == "-"
+}
+
+// joinFieldPath is a helper function that appends a field name to a dotted path.
+func joinFieldPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
@@ -0,0 +1,428 @@
+package sliceutil
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestCompareStructsWithOptions tests the CompareStructsWithOptions function
+func TestCompareStructsWithOptions(t *testing.T) {
+	type Address struct {
+		City string
+		Zip  string
+	}
+
+	type Record struct {
+		ID        int
+		Name      string
+		Address   Address
+		Revision  int `sliceutil:"-"`
+		UpdatedAt time.Time
+	}
+
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	t.Run("No Options Behaves Like CompareStructs", func(t *testing.T) {
+		a := Record{ID: 1, Name: "Alice"}
+		b := Record{ID: 1, Name: "Alice"}
+		assert.True(t, CompareStructsWithOptions(a, b))
+
+		b.Name = "Bob"
+		assert.False(t, CompareStructsWithOptions(a, b))
+	})
+
+	t.Run("Tagged Fields Are Skipped", func(t *testing.T) {
+		a := Record{ID: 1, Revision: 1}
+		b := Record{ID: 1, Revision: 2}
+		assert.True(t, CompareStructsWithOptions(a, b))
+	})
+
+	t.Run("Ignore Top Level Field", func(t *testing.T) {
+		a := Record{ID: 1, UpdatedAt: now}
+		b := Record{ID: 1, UpdatedAt: now.Add(time.Hour)}
+
+		equalTime := WithComparator(func(x, y time.Time) bool { return x.Equal(y) })
+		assert.False(t, CompareStructsWithOptions(a, b, equalTime))
+		assert.True(t, CompareStructsWithOptions(a, b, equalTime, IgnoreFields("UpdatedAt")))
+	})
+
+	t.Run("Ignore Nested Field", func(t *testing.T) {
+		a := Record{ID: 1, Address: Address{City: "NY", Zip: "10001"}}
+		b := Record{ID: 1, Address: Address{City: "NY", Zip: "10002"}}
+		assert.False(t, CompareStructsWithOptions(a, b))
+		assert.True(t, CompareStructsWithOptions(a, b, IgnoreFields("Address.Zip")))
+
+		// Only the full path matches
+		assert.False(t, CompareStructsWithOptions(a, b, IgnoreFields("Zip")))
+	})
+
+	t.Run("Ignore Field Inside Slice Elements", func(t *testing.T) {
+		type Order struct {
+			Items []Record
+		}
+		a := Order{Items: []Record{{ID: 1, Name: "a"}, {ID: 2, Name: "b"}}}
+		b := Order{Items: []Record{{ID: 1, Name: "x"}, {ID: 2, Name: "y"}}}
+		assert.False(t, CompareStructsWithOptions(a, b))
+		assert.True(t, CompareStructsWithOptions(a, b, IgnoreFields("Items.Name")))
+	})
+
+	t.Run("Custom Comparator", func(t *testing.T) {
+		a := Record{ID: 1, UpdatedAt: now}
+		b := Record{ID: 1, UpdatedAt: now.In(time.FixedZone("UTC+2", 2*60*60))}
+
+		// Same instant in different locations is only equal with the comparator
+		assert.False(t, CompareStructsWithOptions(a, b, WithComparator(func(x, y time.Time) bool { return x == y })))
+		assert.True(t, CompareStructsWithOptions(a, b, WithComparator(func(x, y time.Time) bool { return x.Equal(y) })))
+	})
+
+	t.Run("Comparator Applies Behind Pointers", func(t *testing.T) {
+		type Event struct {
+			At *time.Time
+		}
+		other := now.In(time.FixedZone("UTC+2", 2*60*60))
+		a := Event{At: &now}
+		b := Event{At: &other}
+		assert.True(t, CompareStructsWithOptions(a, b, WithComparator(func(x, y time.Time) bool { return x.Equal(y) })))
+	})
+
+	t.Run("Time Fields Compare Instants", func(t *testing.T) {
+		// The monotonic clock reading and location do not matter
+		current := time.Now()
+		assert.True(t, CompareStructs(Record{UpdatedAt: current}, Record{UpdatedAt: current.Round(0)}))
+		assert.True(t, CompareStructs(Record{UpdatedAt: now}, Record{UpdatedAt: now.In(time.FixedZone("UTC+2", 2*60*60))}))
+		assert.False(t, CompareStructs(Record{UpdatedAt: now}, Record{UpdatedAt: now.Add(time.Nanosecond)}))
+		assert.True(t, CompareStructsApprox(Record{UpdatedAt: current}, Record{UpdatedAt: current.Round(0)}, 0))
+		assert.False(t, CompareStructsApprox(Record{UpdatedAt: now}, Record{UpdatedAt: now.Add(time.Second)}, 1))
+	})
+
+	t.Run("Time Tolerance", func(t *testing.T) {
+		a := Record{ID: 1, UpdatedAt: now}
+		b := Record{ID: 1, UpdatedAt: now.Add(-500 * time.Microsecond)}
+		assert.False(t, CompareStructsWithOptions(a, b))
+		assert.True(t, CompareStructsWithOptions(a, b, WithTimeTolerance(time.Millisecond)))
+		assert.True(t, CompareStructsWithOptions(b, a, WithTimeTolerance(time.Millisecond)))
+		assert.False(t, CompareStructsWithOptions(a, b, WithTimeTolerance(time.Microsecond)))
+	})
+
+	t.Run("Nil and Different Types", func(t *testing.T) {
+		assert.True(t, CompareStructsWithOptions(nil, nil))
+		assert.False(t, CompareStructsWithOptions(Record{}, nil))
+		assert.False(t, CompareStructsWithOptions(Record{}, &Record{}))
+		assert.True(t, CompareStructsWithOptions(&Record{ID: 1}, &Record{ID: 1}))
+	})
+}
+
+// TestCompareStructsWithResult tests the CompareStructsWithResult function
+func TestCompareStructsWithResult(t *testing.T) {
+	type Address struct {
+		City   string
+		Street string
+	}
+
+	type Person struct {
+		Name    string
+		Age     int
+		Address Address
+		Manager *Person
+		Tags    []string
+		Items   []Address
+	}
+
+	diffs := func(result CompareResult) []FieldDiff {
+		return result.Details.FieldDiffs
+	}
+
+	t.Run("Equal Structs", func(t *testing.T) {
+		a := Person{Name: "Alice", Tags: []string{"x"}}
+		b := Person{Name: "Alice", Tags: []string{"x"}}
+		result := CompareStructsWithResult(a, b)
+
+		assert.True(t, result.Equal)
+		assert.Equal(t, "Structs are equal", result.Message)
+		assert.Empty(t, result.Details.FieldDiffs)
+	})
+
+	t.Run("Reports Every Differing Field", func(t *testing.T) {
+		a := Person{Name: "Alice", Age: 30, Address: Address{City: "New York", Street: "5th Ave"}}
+		b := Person{Name: "Alice", Age: 31, Address: Address{City: "Boston", Street: "5th Ave"}}
+		result := CompareStructsWithResult(a, b)
+
+		assert.False(t, result.Equal)
+		assert.Equal(t, 2, result.Details.DifferenceCount)
+		assert.Equal(t, []FieldDiff{
+			{Path: "Age", Old: 30, New: 31, Kind: FieldDiffValue},
+			{Path: "Address.City", Old: "New York", New: "Boston", Kind: FieldDiffStruct},
+		}, diffs(result))
+	})
+
+	t.Run("Slice Differences", func(t *testing.T) {
+		a := Person{Tags: []string{"a", "b"}, Items: []Address{{City: "NY"}, {City: "LA"}}}
+		b := Person{Tags: []string{"a"}, Items: []Address{{City: "NY"}, {City: "SF"}}}
+		result := CompareStructsWithResult(a, b)
+
+		assert.Equal(t, []FieldDiff{
+			{Path: "Tags", Old: []string{"a", "b"}, New: []string{"a"}, Kind: FieldDiffSlice},
+			{Path: "Items[1].City", Old: "LA", New: "SF", Kind: FieldDiffSlice},
+		}, diffs(result))
+	})
+
+	t.Run("Pointer Differences", func(t *testing.T) {
+		boss := &Person{Name: "Carol"}
+		a := Person{Manager: boss}
+		b := Person{Manager: &Person{Name: "Dave"}}
+		result := CompareStructsWithResult(a, b)
+		assert.Equal(t, []FieldDiff{
+			{Path: "Manager.Name", Old: "Carol", New: "Dave", Kind: FieldDiffPointer},
+		}, diffs(result))
+
+		result = CompareStructsWithResult(a, Person{})
+		assert.Len(t, diffs(result), 1)
+		assert.Equal(t, "Manager", diffs(result)[0].Path)
+		assert.Equal(t, FieldDiffPointer, diffs(result)[0].Kind)
+		assert.Nil(t, diffs(result)[0].New.(*Person))
+	})
+
+	t.Run("Map Differences", func(t *testing.T) {
+		type Config struct {
+			Labels map[string]int
+		}
+		a := Config{Labels: map[string]int{"a": 1, "b": 2, "c": 3}}
+		b := Config{Labels: map[string]int{"a": 1, "b": 5, "d": 4}}
+		result := CompareStructsWithResult(a, b)
+
+		assert.Equal(t, []FieldDiff{
+			{Path: "Labels[b]", Old: 2, New: 5, Kind: FieldDiffMap},
+			{Path: "Labels[c]", Old: 3, New: nil, Kind: FieldDiffMap},
+			{Path: "Labels[d]", Old: nil, New: 4, Kind: FieldDiffMap},
+		}, diffs(result))
+	})
+
+	t.Run("Interface Type Differences", func(t *testing.T) {
+		type Event struct {
+			Payload interface{}
+		}
+		result := CompareStructsWithResult(Event{Payload: 1}, Event{Payload: "1"})
+		assert.Equal(t, []FieldDiff{
+			{Path: "Payload", Old: 1, New: "1", Kind: FieldDiffInterface},
+		}, diffs(result))
+	})
+
+	t.Run("Root Pointers", func(t *testing.T) {
+		result := CompareStructsWithResult(&Person{Name: "Alice"}, &Person{Name: "Bob"})
+		assert.Equal(t, []FieldDiff{
+			{Path: "Name", Old: "Alice", New: "Bob", Kind: FieldDiffValue},
+		}, diffs(result))
+	})
+
+	t.Run("Respects Options", func(t *testing.T) {
+		a := Person{Name: "Alice", Age: 30}
+		b := Person{Name: "Alice", Age: 31}
+		result := CompareStructsWithResult(a, b, IgnoreFields("Age"))
+		assert.True(t, result.Equal)
+	})
+
+	t.Run("Nil and Different Types", func(t *testing.T) {
+		assert.True(t, CompareStructsWithResult(nil, nil).Equal)
+
+		result := CompareStructsWithResult(Person{}, nil)
+		assert.False(t, result.Equal)
+		assert.True(t, result.Details.BNil)
+
+		result = CompareStructsWithResult(Person{}, Address{})
+		assert.False(t, result.Equal)
+		assert.Equal(t, "Structs have different types", result.Message)
+		assert.Contains(t, result.Details.TypeA, "Person")
+		assert.Contains(t, result.Details.TypeB, "Address")
+	})
+}
+
+// TestRegisterComparator tests the RegisterComparator function
+func TestRegisterComparator(t *testing.T) {
+	// opaqueID mimics a type from another package whose fields are all unexported
+	type opaqueID struct {
+		hi, lo uint64
+	}
+	type Account struct {
+		ID   opaqueID
+		Name string
+	}
+
+	a := Account{ID: opaqueID{1, 2}, Name: "Alice"}
+	b := Account{ID: opaqueID{1, 3}, Name: "Alice"}
+
+	t.Run("Registered Comparator Is Used Everywhere", func(t *testing.T) {
+		// Without a comparator the unexported fields are skipped
+		assert.True(t, CompareStructs(a, b))
+
+		RegisterComparator(func(x, y opaqueID) bool { return x == y })
+		assert.False(t, CompareStructs(a, b))
+		assert.False(t, CompareStructsWithOptions(a, b))
+		assert.False(t, CompareStructsWithResult(a, b).Equal)
+		assert.False(t, CompareStructsApprox(a, b, 1))
+		assert.True(t, CompareStructs(a, a))
+	})
+
+	t.Run("Options Take Precedence", func(t *testing.T) {
+		ignoreLow := WithComparator(func(x, y opaqueID) bool { return x.hi == y.hi })
+		assert.True(t, CompareStructsWithOptions(a, b, ignoreLow))
+	})
+
+	t.Run("Registration Clears Cache", func(t *testing.T) {
+		type Tagged struct {
+			Tag opaqueID
+		}
+		SetStructCacheConfig(StructCacheConfig{Enabled: true})
+		defer SetStructCacheConfig(StructCacheConfig{})
+
+		x, y := Tagged{opaqueID{1, 1}}, Tagged{opaqueID{2, 2}}
+		RegisterComparator(func(p, q opaqueID) bool { return true })
+		assert.True(t, CompareStructs(x, y))
+
+		RegisterComparator(func(p, q opaqueID) bool { return p == q })
+		assert.False(t, CompareStructs(x, y))
+	})
+}
+
+// TestCompareStructSlices tests the CompareStructSlices function
+func TestCompareStructSlices(t *testing.T) {
+	type Address struct {
+		City string
+	}
+	type Person struct {
+		Name      string
+		Address   *Address
+		UpdatedAt time.Time
+	}
+
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	t.Run("Equal Slices", func(t *testing.T) {
+		a := []Person{{Name: "Alice", Address: &Address{City: "NY"}}, {Name: "Bob"}}
+		b := []Person{{Name: "Alice", Address: &Address{City: "NY"}}, {Name: "Bob"}}
+		diff := CompareStructSlices(a, b)
+		assert.True(t, diff.Equal)
+		assert.Empty(t, diff.Elements)
+		assert.Equal(t, 2, diff.LengthA)
+	})
+
+	t.Run("Reports Indices And Fields", func(t *testing.T) {
+		a := []Person{{Name: "Alice", Address: &Address{City: "NY"}}, {Name: "Bob"}, {Name: "Carol"}}
+		b := []Person{{Name: "Alice", Address: &Address{City: "Boston"}}, {Name: "Bob"}, {Name: "Caroline"}}
+		diff := CompareStructSlices(a, b)
+		assert.False(t, diff.Equal)
+		assert.Equal(t, []StructElementDiff{
+			{Index: 0, FieldDiffs: []FieldDiff{{Path: "Address.City", Old: "NY", New: "Boston", Kind: FieldDiffPointer}}},
+			{Index: 2, FieldDiffs: []FieldDiff{{Path: "Name", Old: "Carol", New: "Caroline", Kind: FieldDiffValue}}},
+		}, diff.Elements)
+	})
+
+	t.Run("Shared Pointers Across Elements", func(t *testing.T) {
+		x, y := &Address{City: "NY"}, &Address{City: "LA"}
+		a := []Person{{Address: x}, {Address: x}}
+		b := []Person{{Address: y}, {Address: y}}
+		diff := CompareStructSlices(a, b)
+		assert.Len(t, diff.Elements, 2)
+	})
+
+	t.Run("Options Apply To Every Element", func(t *testing.T) {
+		a := []Person{{Name: "Alice", UpdatedAt: now}, {Name: "Bob", UpdatedAt: now}}
+		b := []Person{{Name: "Alice", UpdatedAt: now.Add(time.Hour)}, {Name: "Bob", UpdatedAt: now.Add(time.Minute)}}
+		assert.Len(t, CompareStructSlices(a, b).Elements, 2)
+		assert.True(t, CompareStructSlices(a, b, IgnoreFields("UpdatedAt")).Equal)
+		assert.Equal(t, []int{0}, Map(CompareStructSlices(a, b, WithTimeTolerance(time.Minute)).Elements,
+			func(e StructElementDiff) int { return e.Index }))
+	})
+
+	t.Run("Different Lengths", func(t *testing.T) {
+		a := []Person{{Name: "Alice"}, {Name: "Bob"}}
+		b := []Person{{Name: "Alicia"}}
+		diff := CompareStructSlices(a, b)
+		assert.False(t, diff.Equal)
+		assert.Equal(t, 2, diff.LengthA)
+		assert.Equal(t, 1, diff.LengthB)
+		assert.Len(t, diff.Elements, 1)
+	})
+
+	t.Run("Pointer Elements", func(t *testing.T) {
+		a := []*Person{{Name: "Alice"}, nil}
+		b := []*Person{{Name: "Alice"}, {Name: "Bob"}}
+		diff := CompareStructSlices(a, b)
+		assert.False(t, diff.Equal)
+		assert.Len(t, diff.Elements, 1)
+		assert.Equal(t, 1, diff.Elements[0].Index)
+	})
+
+	t.Run("Nil and Empty", func(t *testing.T) {
+		assert.True(t, CompareStructSlices[Person](nil, nil).Equal)
+		assert.True(t, CompareStructSlices([]Person{}, []Person{}).Equal)
+
+		diff := CompareStructSlices(nil, []Person{})
+		assert.False(t, diff.Equal)
+		assert.True(t, diff.ANil)
+		assert.False(t, diff.BNil)
+	})
+}
+
+// TestReconcileByKey tests the ReconcileByKey function
+func TestReconcileByKey(t *testing.T) {
+	type User struct {
+		ID        int
+		Email     string
+		Tags      []string
+		UpdatedAt time.Time
+	}
+	id := func(u User) int { return u.ID }
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	t.Run("Added Removed And Changed", func(t *testing.T) {
+		rows := []User{{ID: 1, Email: "a@x"}, {ID: 2, Email: "b@x"}, {ID: 3, Email: "c@x"}}
+		response := []User{{ID: 4, Email: "d@x"}, {ID: 3, Email: "c@y"}, {ID: 1, Email: "a@x"}, {ID: 5}}
+
+		report := ReconcileByKey(rows, response, id)
+		assert.Equal(t, []User{{ID: 4, Email: "d@x"}, {ID: 5}}, report.Added)
+		assert.Equal(t, []User{{ID: 2, Email: "b@x"}}, report.Removed)
+		assert.Equal(t, []FieldDiff{{Path: "[3].Email", Old: "c@x", New: "c@y", Kind: FieldDiffValue}}, report.Changed)
+	})
+
+	t.Run("Nested Paths", func(t *testing.T) {
+		rows := []User{{ID: 7, Tags: []string{"a", "b"}}}
+		response := []User{{ID: 7, Tags: []string{"a", "c"}}}
+		report := ReconcileByKey(rows, response, id)
+		assert.Equal(t, []FieldDiff{{Path: "[7].Tags[1]", Old: "b", New: "c", Kind: FieldDiffSlice}}, report.Changed)
+	})
+
+	t.Run("Options", func(t *testing.T) {
+		rows := []User{{ID: 1, UpdatedAt: now}}
+		response := []User{{ID: 1, UpdatedAt: now.Add(time.Hour)}}
+		assert.Len(t, ReconcileByKey(rows, response, id).Changed, 1)
+		assert.Empty(t, ReconcileByKey(rows, response, id, IgnoreFields("UpdatedAt")).Changed)
+	})
+
+	t.Run("Non-Struct Elements", func(t *testing.T) {
+		report := ReconcileByKey([]string{"apple", "banana"}, []string{"Apple", "cherry"},
+			func(s string) byte { return s[0] | 0x20 })
+		assert.Equal(t, []string{"cherry"}, report.Added)
+		assert.Equal(t, []string{"banana"}, report.Removed)
+		assert.Equal(t, []FieldDiff{{Path: "[97]", Old: "apple", New: "Apple", Kind: FieldDiffValue}}, report.Changed)
+	})
+
+	t.Run("Duplicate Keys", func(t *testing.T) {
+		rows := []User{{ID: 1, Email: "first"}, {ID: 1, Email: "second"}}
+		response := []User{{ID: 1, Email: "first"}}
+		report := ReconcileByKey(rows, response, id)
+		assert.Equal(t, []User{{ID: 1, Email: "second"}}, report.Removed)
+		assert.Empty(t, report.Changed)
+	})
+
+	t.Run("Nil and Empty", func(t *testing.T) {
+		report := ReconcileByKey(nil, nil, id)
+		assert.Equal(t, []User{}, report.Added)
+		assert.Equal(t, []User{}, report.Removed)
+		assert.Equal(t, []FieldDiff{}, report.Changed)
+
+		report = ReconcileByKey(nil, []User{{ID: 1}}, id)
+		assert.Equal(t, []User{{ID: 1}}, report.Added)
+	})
+}
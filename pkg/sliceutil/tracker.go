@@ -0,0 +1,234 @@
+package sliceutil
+
+import (
+	"sync"
+)
+
+// DefaultHistoryLimit is the number of snapshots a new Tracker keeps in memory
+const DefaultHistoryLimit = 64
+
+// Move describes an element that is present in both versions of a slice but changed position
+type Move[T any] struct {
+	Value T
+	From  int
+	To    int
+}
+
+// Changes describes how a slice changed between two versions.
+// Added holds indices in the newer version, Removed holds indices in the older version.
+type Changes[T any] struct {
+	Added   []IndexedValue[T]
+	Removed []IndexedValue[T]
+	Moved   []Move[T]
+}
+
+// IsEmpty reports whether no changes were recorded
+func (c Changes[T]) IsEmpty() bool {
+	return len(c.Added) == 0 && len(c.Removed) == 0 && len(c.Moved) == 0
+}
+
+// Tracker records snapshots of a slice over time and reports what changed between them.
+// It observes the slice through a pointer, so appends and reassignments made by the
+// owner of the slice are visible to the tracker. Versions can also be recorded
+// explicitly with Commit, which makes a tracker without a source a change log of
+// successive values, for example of a cache or a watched configuration.
+// A Tracker is safe for concurrent use, but callers must synchronize mutations of the
+// tracked slice themselves.
+type Tracker[T comparable] struct {
+	mu        sync.RWMutex
+	source    *[]T
+	snapshots map[int][]T
+	version   int
+	oldest    int
+	limit     int
+}
+
+// NewTracker creates a tracker that observes the slice referenced by source.
+// No snapshot is taken until Snapshot or Commit is called. Source may be nil when
+// versions are only recorded with Commit. The tracker keeps the latest
+// DefaultHistoryLimit snapshots; use SetHistoryLimit to change the bound.
+//
+// Example:
+//
+//	items := []string{"a", "b"}
+//	tracker := NewTracker(&items)
+//	v := tracker.Snapshot()
+//	items = append(items, "c")
+//	changes, _ := tracker.ChangesSince(v) // changes.Added: [{2 c}]
+func NewTracker[T comparable](source *[]T) *Tracker[T] {
+	return &Tracker[T]{
+		source:    source,
+		snapshots: make(map[int][]T),
+		oldest:    1,
+		limit:     DefaultHistoryLimit,
+	}
+}
+
+// Snapshot copies the current contents of the tracked slice and returns the
+// version number assigned to the copy. Versions start at 1 and increase monotonically.
+func (t *Tracker[T]) Snapshot() int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.record(t.current())
+}
+
+// Commit records a copy of s as a new version and returns its version number.
+// Unlike Snapshot, it does not read the tracked slice, so it can be used to record
+// values that are not held in a single variable.
+//
+// Example:
+//
+//	tracker := NewTracker[string](nil)
+//	v := tracker.Commit([]string{"a", "b"})
+//	tracker.Commit([]string{"a", "c"})
+//	ops, _ := tracker.DiffSince(v) // KEEP a, DELETE b, INSERT c
+func (t *Tracker[T]) Commit(s []T) int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.record(s)
+}
+
+// SetHistoryLimit bounds the number of snapshots kept in memory. Once more than n
+// snapshots are recorded, the oldest are discarded and asking for changes since
+// them returns ErrUnknownVersion. The default is DefaultHistoryLimit. A limit of 0
+// or less keeps every snapshot, so memory grows with every recorded version.
+func (t *Tracker[T]) SetHistoryLimit(n int) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.limit = n
+	t.trimHistory()
+}
+
+// Version returns the number of the latest snapshot, or 0 if none was taken.
+func (t *Tracker[T]) Version() int {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.version
+}
+
+// ChangesSince reports the changes between the snapshot with the given version
+// and the current contents of the tracked slice.
+// The function returns ErrUnknownVersion if no such snapshot exists.
+func (t *Tracker[T]) ChangesSince(version int) (Changes[T], error) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	old, ok := t.snapshots[version]
+	if !ok {
+		return Changes[T]{}, ErrUnknownVersion
+	}
+	return computeChanges(old, t.current()), nil
+}
+
+// DiffSince returns an edit script, as produced by ComputeDiff, that transforms the
+// snapshot with the given version into the latest snapshot. Applying it with
+// ApplyPatch to the older snapshot reproduces the latest one.
+// The function returns ErrUnknownVersion if no such snapshot exists.
+func (t *Tracker[T]) DiffSince(version int) ([]EditOp[T], error) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	old, ok := t.snapshots[version]
+	if !ok {
+		return nil, ErrUnknownVersion
+	}
+	return ComputeDiff(old, t.snapshots[t.version]), nil
+}
+
+// ChangesBetween reports the changes between two recorded snapshots.
+// The function returns ErrUnknownVersion if either snapshot does not exist.
+func (t *Tracker[T]) ChangesBetween(from, to int) (Changes[T], error) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	old, ok := t.snapshots[from]
+	if !ok {
+		return Changes[T]{}, ErrUnknownVersion
+	}
+	updated, ok := t.snapshots[to]
+	if !ok {
+		return Changes[T]{}, ErrUnknownVersion
+	}
+	return computeChanges(old, updated), nil
+}
+
+// current is a helper method that returns the tracked slice, treating a nil source as empty.
+func (t *Tracker[T]) current() []T {
+	if t.source == nil {
+		return nil
+	}
+	return *t.source
+}
+
+// record is a helper method that stores a copy of s as the next version.
+// The caller must hold the write lock.
+func (t *Tracker[T]) record(s []T) int {
+	t.version++
+	t.snapshots[t.version] = append([]T{}, s...)
+	t.trimHistory()
+	return t.version
+}
+
+// trimHistory is a helper method that discards the oldest snapshots until the history
+// limit is respected. The caller must hold the write lock.
+func (t *Tracker[T]) trimHistory() {
+	if t.limit <= 0 {
+		return
+	}
+	for len(t.snapshots) > t.limit {
+		delete(t.snapshots, t.oldest)
+		t.oldest++
+	}
+}
+
+// computeChanges is a helper function that classifies the differences between two versions.
+// Elements kept by the ComputeDiff edit script are considered unchanged. Of the remaining
+// elements, equal values found in both versions are reported as moves, and the rest
+// as additions or removals.
+func computeChanges[T comparable](old, updated []T) Changes[T] {
+	changes := Changes[T]{
+		Added:   make([]IndexedValue[T], 0),
+		Removed: make([]IndexedValue[T], 0),
+		Moved:   make([]Move[T], 0),
+	}
+
+	keptOld := make([]bool, len(old))
+	keptNew := make([]bool, len(updated))
+	for _, op := range ComputeDiff(old, updated) {
+		if op.Kind == EditKeep {
+			keptOld[op.AIndex] = true
+			keptNew[op.BIndex] = true
+		}
+	}
+
+	// Queue the positions of old elements that are not part of the common subsequence
+	pending := make(map[T][]int)
+	for i, v := range old {
+		if !keptOld[i] {
+			pending[v] = append(pending[v], i)
+		}
+	}
+
+	movedOld := make([]bool, len(old))
+	for i, v := range updated {
+		if keptNew[i] {
+			continue
+		}
+		if queue := pending[v]; len(queue) > 0 {
+			pending[v] = queue[1:]
+			movedOld[queue[0]] = true
+			changes.Moved = append(changes.Moved, Move[T]{Value: v, From: queue[0], To: i})
+			continue
+		}
+		changes.Added = append(changes.Added, IndexedValue[T]{Index: i, Value: v})
+	}
+
+	for i, v := range old {
+		if !keptOld[i] && !movedOld[i] {
+			changes.Removed = append(changes.Removed, IndexedValue[T]{Index: i, Value: v})
+		}
+	}
+
+	return changes
+}
@@ -0,0 +1,206 @@
+package sliceutil
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestTracker tests the Tracker type
+func TestTracker(t *testing.T) {
+	t.Run("Reports Additions And Removals", func(t *testing.T) {
+		items := []string{"a", "b", "c"}
+		tracker := NewTracker(&items)
+		v := tracker.Snapshot()
+
+		items = append(items[:1], items[2:]...) // remove "b"
+		items = append(items, "d")
+
+		changes, err := tracker.ChangesSince(v)
+		require.NoError(t, err)
+
+		assert.Equal(t, []IndexedValue[string]{{Index: 2, Value: "d"}}, changes.Added)
+		assert.Equal(t, []IndexedValue[string]{{Index: 1, Value: "b"}}, changes.Removed)
+		assert.Empty(t, changes.Moved)
+	})
+
+	t.Run("Reports Moves", func(t *testing.T) {
+		items := []int{1, 2, 3, 4}
+		tracker := NewTracker(&items)
+		v := tracker.Snapshot()
+
+		items = []int{2, 3, 4, 1}
+
+		changes, err := tracker.ChangesSince(v)
+		require.NoError(t, err)
+
+		assert.Empty(t, changes.Added)
+		assert.Empty(t, changes.Removed)
+		assert.Equal(t, []Move[int]{{Value: 1, From: 0, To: 3}}, changes.Moved)
+	})
+
+	t.Run("No Changes", func(t *testing.T) {
+		items := []int{1, 2}
+		tracker := NewTracker(&items)
+		v := tracker.Snapshot()
+
+		changes, err := tracker.ChangesSince(v)
+		require.NoError(t, err)
+		assert.True(t, changes.IsEmpty())
+	})
+
+	t.Run("Snapshots Are Copies", func(t *testing.T) {
+		items := []int{1, 2}
+		tracker := NewTracker(&items)
+		v1 := tracker.Snapshot()
+		items[0] = 9
+		v2 := tracker.Snapshot()
+
+		changes, err := tracker.ChangesBetween(v1, v2)
+		require.NoError(t, err)
+		assert.Equal(t, []IndexedValue[int]{{Index: 0, Value: 9}}, changes.Added)
+		assert.Equal(t, []IndexedValue[int]{{Index: 0, Value: 1}}, changes.Removed)
+	})
+
+	t.Run("Versions", func(t *testing.T) {
+		var items []int
+		tracker := NewTracker(&items)
+		assert.Equal(t, 0, tracker.Version())
+		assert.Equal(t, 1, tracker.Snapshot())
+		assert.Equal(t, 2, tracker.Snapshot())
+		assert.Equal(t, 2, tracker.Version())
+	})
+
+	t.Run("Unknown Version", func(t *testing.T) {
+		items := []int{1}
+		tracker := NewTracker(&items)
+
+		_, err := tracker.ChangesSince(1)
+		assert.ErrorIs(t, err, ErrUnknownVersion)
+
+		v := tracker.Snapshot()
+		_, err = tracker.ChangesBetween(v, v+1)
+		assert.ErrorIs(t, err, ErrUnknownVersion)
+	})
+
+	t.Run("Commit And DiffSince", func(t *testing.T) {
+		tracker := NewTracker[string](nil)
+		v1 := tracker.Commit([]string{"a", "b", "c"})
+		v2 := tracker.Commit([]string{"a", "c", "d"})
+		assert.Equal(t, 2, v2)
+
+		ops, err := tracker.DiffSince(v1)
+		require.NoError(t, err)
+		assert.Equal(t, []EditOp[string]{
+			{Kind: EditKeep, Value: "a", AIndex: 0, BIndex: 0},
+			{Kind: EditDelete, Value: "b", AIndex: 1, BIndex: 1},
+			{Kind: EditKeep, Value: "c", AIndex: 2, BIndex: 1},
+			{Kind: EditInsert, Value: "d", AIndex: 3, BIndex: 2},
+		}, ops)
+
+		patched, err := ApplyPatch([]string{"a", "b", "c"}, ops)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"a", "c", "d"}, patched)
+
+		ops, err = tracker.DiffSince(v2)
+		require.NoError(t, err)
+		assert.Len(t, ops, 3)
+	})
+
+	t.Run("Commit Copies Input", func(t *testing.T) {
+		tracker := NewTracker[int](nil)
+		s := []int{1, 2}
+		v := tracker.Commit(s)
+		s[0] = 9
+		tracker.Commit(s)
+
+		ops, err := tracker.DiffSince(v)
+		require.NoError(t, err)
+		assert.Equal(t, EditDelete, ops[0].Kind)
+		assert.Equal(t, 1, ops[0].Value)
+	})
+
+	t.Run("Commit And Snapshot Share Versions", func(t *testing.T) {
+		items := []int{1}
+		tracker := NewTracker(&items)
+		assert.Equal(t, 1, tracker.Snapshot())
+		assert.Equal(t, 2, tracker.Commit([]int{1, 2}))
+
+		changes, err := tracker.ChangesBetween(1, 2)
+		require.NoError(t, err)
+		assert.Equal(t, []IndexedValue[int]{{Index: 1, Value: 2}}, changes.Added)
+	})
+
+	t.Run("History Limit", func(t *testing.T) {
+		tracker := NewTracker[int](nil)
+		tracker.SetHistoryLimit(2)
+		for i := range 5 {
+			tracker.Commit([]int{i})
+		}
+
+		_, err := tracker.DiffSince(3)
+		assert.ErrorIs(t, err, ErrUnknownVersion)
+		_, err = tracker.DiffSince(4)
+		assert.NoError(t, err)
+		assert.Equal(t, 5, tracker.Version())
+
+		// Lowering the limit discards snapshots immediately
+		tracker.SetHistoryLimit(1)
+		_, err = tracker.DiffSince(4)
+		assert.ErrorIs(t, err, ErrUnknownVersion)
+		_, err = tracker.DiffSince(5)
+		assert.NoError(t, err)
+	})
+
+	t.Run("Default History Limit", func(t *testing.T) {
+		tracker := NewTracker[int](nil)
+		for i := range DefaultHistoryLimit + 1 {
+			tracker.Commit([]int{i})
+		}
+
+		_, err := tracker.DiffSince(1)
+		assert.ErrorIs(t, err, ErrUnknownVersion)
+		_, err = tracker.DiffSince(2)
+		assert.NoError(t, err)
+
+		// A limit of 0 keeps every snapshot
+		tracker.SetHistoryLimit(0)
+		for i := range DefaultHistoryLimit {
+			tracker.Commit([]int{i})
+		}
+		_, err = tracker.DiffSince(2)
+		assert.NoError(t, err)
+	})
+
+	t.Run("Nil and Empty", func(t *testing.T) {
+		tracker := NewTracker[int](nil)
+		_, err := tracker.DiffSince(0)
+		assert.ErrorIs(t, err, ErrUnknownVersion)
+
+		v := tracker.Snapshot()
+		ops, err := tracker.DiffSince(v)
+		require.NoError(t, err)
+		assert.Empty(t, ops)
+	})
+
+	t.Run("Concurrent Snapshots", func(t *testing.T) {
+		items := []int{1, 2, 3}
+		tracker := NewTracker(&items)
+
+		var wg sync.WaitGroup
+		for i := 0; i < 10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				v := tracker.Snapshot()
+				_, err := tracker.ChangesSince(v)
+				assert.NoError(t, err)
+			}()
+		}
+		wg.Wait()
+
+		assert.Equal(t, 10, tracker.Version())
+	})
+}
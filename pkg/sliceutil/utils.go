@@ -1,6 +1,12 @@
 package sliceutil
 
 import (
+	"cmp"
+	"context"
+	"fmt"
+	"math"
+	"math/big"
+	"slices"
 	"sort"
 )
 
@@ -22,27 +28,49 @@ import (
 //	b := []int{3, 4, 5, 6}
 //	result := FindDifferences(a, b) // returns []int{1, 2, 5, 6}
 func FindDifferences[T comparable](a, b []T) []T {
+	// The background context is never done, so no error can occur
+	result, _ := findDifferences(context.Background(), a, b)
+	return result
+}
+
+// findDifferences is a helper function that computes the symmetric difference for
+// FindDifferences and FindDifferencesCtx, checking the context periodically.
+func findDifferences[T comparable](ctx context.Context, a, b []T) ([]T, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
 	// Handle nil slices
 	if a == nil && b == nil {
-		return []T{}
+		return []T{}, nil
 	}
 	if a == nil {
-		return append([]T{}, b...)
+		return append([]T{}, b...), nil
 	}
 	if b == nil {
-		return append([]T{}, a...)
+		return append([]T{}, a...), nil
 	}
 
 	// Create a map to track element frequencies
 	m := make(map[T]int)
 
 	// Add all elements from slice a to the map
-	for _, v := range a {
+	for i, v := range a {
+		if i%ctxCheckInterval == 0 {
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+		}
 		m[v]++
 	}
 
 	// Process slice b: if value exists, decrement; if not, add as unique
-	for _, v := range b {
+	for i, v := range b {
+		if i%ctxCheckInterval == 0 {
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+		}
 		if count, exists := m[v]; exists {
 			if count == 1 {
 				// Value exists in both slices, remove it
@@ -65,7 +93,7 @@ func FindDifferences[T comparable](a, b []T) []T {
 		}
 	}
 
-	return result
+	return result, nil
 }
 
 // FindDifferencesWithCount returns differences along with their frequency counts.
@@ -118,7 +146,172 @@ func FindDifferencesWithCount[T comparable](a, b []T) map[T]int {
 	return result
 }
 
-// MaxInt returns the largest number in an int slice.
+// FindDifferencesFunc returns the elements of both slices whose key does not occur in
+// the other slice. Unlike FindDifferences, T need not be comparable: elements are
+// identified by the key function, such as a struct's ID, and the full elements are
+// returned. Each key is reported once, using its first element. The result holds the
+// elements from slice A in their order of appearance, followed by those from slice B.
+//
+// Time complexity: O(n + m) where n and m are the lengths of the slices
+// Space complexity: O(n + m) for the key sets and result
+//
+// Example:
+//
+//	byID := func(u User) int { return u.ID }
+//	diff := FindDifferencesFunc(oldUsers, newUsers, byID)
+//	// returns the users that were removed, followed by the users that were added
+func FindDifferencesFunc[T any, K comparable](a, b []T, key func(T) K) []T {
+	keysA := make(map[K]struct{}, len(a))
+	for _, v := range a {
+		keysA[key(v)] = struct{}{}
+	}
+	keysB := make(map[K]struct{}, len(b))
+	for _, v := range b {
+		keysB[key(v)] = struct{}{}
+	}
+
+	result := make([]T, 0)
+	collect := func(s []T, other map[K]struct{}) {
+		seen := make(map[K]struct{})
+		for _, v := range s {
+			k := key(v)
+			if _, ok := other[k]; ok {
+				continue
+			}
+			if _, dup := seen[k]; !dup {
+				seen[k] = struct{}{}
+				result = append(result, v)
+			}
+		}
+	}
+	collect(a, keysB)
+	collect(b, keysA)
+
+	return result
+}
+
+// FindDifferencesDetailed compares two slices like FindDifferences but reports which
+// side every distinct element was found on: only in A, only in B, or in both.
+// Element counts are ignored. OnlyInA and InBoth preserve the order of first appearance
+// in slice A, and OnlyInB the order of first appearance in slice B. None of the result
+// slices is nil.
+//
+// Time complexity: O(n + m) where n and m are the lengths of the slices
+// Space complexity: O(n + m) for the lookup sets and result
+//
+// Example:
+//
+//	diff := FindDifferencesDetailed([]int{1, 2, 3, 4}, []int{3, 4, 5, 6})
+//	// diff.OnlyInA: [1 2], diff.OnlyInB: [5 6], diff.InBoth: [3 4]
+func FindDifferencesDetailed[T comparable](a, b []T) Differences[T] {
+	inA := ToSet(a)
+	inB := ToSet(b)
+	result := Differences[T]{
+		OnlyInA: make([]T, 0),
+		OnlyInB: make([]T, 0),
+		InBoth:  make([]T, 0),
+	}
+
+	seen := make(map[T]struct{}, len(inA))
+	for _, v := range a {
+		if _, dup := seen[v]; dup {
+			continue
+		}
+		seen[v] = struct{}{}
+		if _, ok := inB[v]; ok {
+			result.InBoth = append(result.InBoth, v)
+		} else {
+			result.OnlyInA = append(result.OnlyInA, v)
+		}
+	}
+
+	for _, v := range b {
+		if _, ok := inA[v]; ok {
+			continue
+		}
+		if _, dup := seen[v]; !dup {
+			seen[v] = struct{}{}
+			result.OnlyInB = append(result.OnlyInB, v)
+		}
+	}
+
+	return result
+}
+
+// FindDifferencesByIndex compares two slices position by position, which suits data
+// where position matters, such as CSV rows or configuration arrays. Positions present in
+// both slices that hold different values are reported as mismatches with both values,
+// and when the lengths differ, the trailing elements of the longer slice are reported as
+// extras with their indices. None of the result slices is nil.
+//
+// Time complexity: O(n + m) where n and m are the lengths of the slices
+// Space complexity: O(d) where d is the number of differences
+//
+// Example:
+//
+//	diff := FindDifferencesByIndex([]string{"id", "name", "email"}, []string{"id", "full_name"})
+//	// diff.Mismatches: [{1 name full_name}], diff.ExtraInA: [{2 email}], diff.ExtraInB: []
+func FindDifferencesByIndex[T comparable](a, b []T) IndexDifferences[T] {
+	result := IndexDifferences[T]{
+		Mismatches: make([]ElementDiff[T], 0),
+		ExtraInA:   make([]IndexedValue[T], 0),
+		ExtraInB:   make([]IndexedValue[T], 0),
+	}
+
+	common := min(len(a), len(b))
+	for i := 0; i < common; i++ {
+		if a[i] != b[i] {
+			result.Mismatches = append(result.Mismatches, ElementDiff[T]{Index: i, AValue: a[i], BValue: b[i]})
+		}
+	}
+	for i := common; i < len(a); i++ {
+		result.ExtraInA = append(result.ExtraInA, IndexedValue[T]{Index: i, Value: a[i]})
+	}
+	for i := common; i < len(b); i++ {
+		result.ExtraInB = append(result.ExtraInB, IndexedValue[T]{Index: i, Value: b[i]})
+	}
+
+	return result
+}
+
+// MissingFrom returns the elements of slice A that are absent from slice B,
+// together with their indices in slice A. Unlike FindDifferences, the result
+// is one-directional and preserves the order of slice A, which makes it easier
+// to act upon the reported elements.
+//
+// Every occurrence of a missing element is reported, so duplicates in slice A
+// appear once per index.
+//
+// Time complexity: O(n + m) where n and m are the lengths of the slices
+// Space complexity: O(m) for the lookup set
+//
+// Example:
+//
+//	a := []string{"x", "y", "z"}
+//	b := []string{"y"}
+//	missing := MissingFrom(a, b) // returns [{0 x} {2 z}]
+func MissingFrom[T comparable](a, b []T) []IndexedValue[T] {
+	result := make([]IndexedValue[T], 0)
+	if len(a) == 0 {
+		return result
+	}
+
+	// Build a lookup set for slice b
+	present := make(map[T]struct{}, len(b))
+	for _, v := range b {
+		present[v] = struct{}{}
+	}
+
+	for i, v := range a {
+		if _, ok := present[v]; !ok {
+			result = append(result, IndexedValue[T]{Index: i, Value: v})
+		}
+	}
+
+	return result
+}
+
+// Max returns the largest element of a slice of any ordered type.
 // The function returns an error if the slice is empty or nil.
 //
 // Time complexity: O(n) where n is the length of the slice
@@ -126,14 +319,15 @@ func FindDifferencesWithCount[T comparable](a, b []T) map[T]int {
 //
 // Example:
 //
-//	slice := []int{1, 5, 3, 9, 2}
-//	max, err := MaxInt(slice) // returns 9, nil
-func MaxInt(a []int) (int, error) {
+//	slice := []uint8{4, 9, 1}
+//	max, err := Max(slice) // returns 9, nil
+func Max[T cmp.Ordered](a []T) (T, error) {
+	var zero T
 	if a == nil {
-		return 0, ErrNilSlice
+		return zero, ErrNilSlice
 	}
 	if len(a) == 0 {
-		return 0, ErrEmptySlice
+		return zero, ErrEmptySlice
 	}
 
 	// Initialize max to the first element
@@ -149,7 +343,7 @@ func MaxInt(a []int) (int, error) {
 	return max, nil
 }
 
-// MinInt returns the smallest number in an int slice.
+// Min returns the smallest element of a slice of any ordered type.
 // The function returns an error if the slice is empty or nil.
 //
 // Time complexity: O(n) where n is the length of the slice
@@ -157,14 +351,15 @@ func MaxInt(a []int) (int, error) {
 //
 // Example:
 //
-//	slice := []int{1, 5, 3, 9, 2}
-//	min, err := MinInt(slice) // returns 1, nil
-func MinInt(a []int) (int, error) {
+//	slice := []float32{2.5, 0.5, 1}
+//	min, err := Min(slice) // returns 0.5, nil
+func Min[T cmp.Ordered](a []T) (T, error) {
+	var zero T
 	if a == nil {
-		return 0, ErrNilSlice
+		return zero, ErrNilSlice
 	}
 	if len(a) == 0 {
-		return 0, ErrEmptySlice
+		return zero, ErrEmptySlice
 	}
 
 	// Initialize min to the first element
@@ -180,75 +375,149 @@ func MinInt(a []int) (int, error) {
 	return min, nil
 }
 
-// MaxFloat64 returns the largest number in a float64 slice.
-// The function returns an error if the slice is empty or nil.
-func MaxFloat64(a []float64) (float64, error) {
+// MaxBy returns the element with the largest key and its index in the slice.
+// The key function is called once per element, and ties are resolved in favour of the
+// earliest element. The function returns an error if the slice is empty or nil.
+//
+// Time complexity: O(n) where n is the length of the slice
+// Space complexity: O(1)
+//
+// Example:
+//
+//	product, index, err := MaxBy(products, func(p Product) float64 { return p.Price })
+func MaxBy[T any, K cmp.Ordered](a []T, key func(T) K) (T, int, error) {
+	return extremeBy(a, key, func(candidate, best K) bool { return candidate > best })
+}
+
+// MinBy returns the element with the smallest key and its index in the slice.
+// The key function is called once per element, and ties are resolved in favour of the
+// earliest element. The function returns an error if the slice is empty or nil.
+//
+// Example:
+//
+//	youngest, index, err := MinBy(users, func(u User) int { return u.Age })
+func MinBy[T any, K cmp.Ordered](a []T, key func(T) K) (T, int, error) {
+	return extremeBy(a, key, func(candidate, best K) bool { return candidate < best })
+}
+
+// ArgMax returns the index of the largest element of a slice of any ordered type.
+// If the largest value occurs several times, the index of the first occurrence is returned.
+// The function returns -1 and an error if the slice is empty or nil, like Max.
+//
+// Example:
+//
+//	index, err := ArgMax([]float64{0.2, 0.9, 0.4}) // returns 1, nil
+func ArgMax[T cmp.Ordered](a []T) (int, error) {
+	_, index, err := MaxBy(a, func(v T) T { return v })
+	return index, err
+}
+
+// ArgMin returns the index of the smallest element of a slice of any ordered type.
+// If the smallest value occurs several times, the index of the first occurrence is returned.
+// The function returns -1 and an error if the slice is empty or nil, like Min.
+//
+// Example:
+//
+//	index, err := ArgMin([]int{3, 1, 2, 1}) // returns 1, nil
+func ArgMin[T cmp.Ordered](a []T) (int, error) {
+	_, index, err := MinBy(a, func(v T) T { return v })
+	return index, err
+}
+
+// extremeBy is a helper function that finds the first element whose key beats every other
+// key according to better, returning the element and its index.
+func extremeBy[T any, K cmp.Ordered](a []T, key func(T) K, better func(candidate, best K) bool) (T, int, error) {
+	var zero T
 	if a == nil {
-		return 0, ErrNilSlice
+		return zero, -1, ErrNilSlice
 	}
 	if len(a) == 0 {
-		return 0, ErrEmptySlice
+		return zero, -1, ErrEmptySlice
 	}
 
-	max := a[0]
-	for _, v := range a {
-		if v > max {
-			max = v
+	bestIndex := 0
+	bestKey := key(a[0])
+	for i := 1; i < len(a); i++ {
+		if k := key(a[i]); better(k, bestKey) {
+			bestIndex = i
+			bestKey = k
 		}
 	}
-	return max, nil
+
+	return a[bestIndex], bestIndex, nil
 }
 
-// MinFloat64 returns the smallest number in a float64 slice.
-// The function returns an error if the slice is empty or nil.
-func MinFloat64(a []float64) (float64, error) {
+// Sum calculates the sum of all elements in a numeric slice. The sum is accumulated in
+// the element type, so it may overflow for small integer types; Average does not.
+// The function returns an error if the slice is nil.
+//
+// Example:
+//
+//	sum, err := Sum([]int64{1, 2, 3}) // returns 6, nil
+func Sum[T Number](a []T) (T, error) {
+	var sum T
 	if a == nil {
-		return 0, ErrNilSlice
-	}
-	if len(a) == 0 {
-		return 0, ErrEmptySlice
+		return sum, ErrNilSlice
 	}
 
-	min := a[0]
 	for _, v := range a {
-		if v < min {
-			min = v
-		}
+		sum += v
 	}
-	return min, nil
+	return sum, nil
 }
 
-// SumInt calculates the sum of all integers in a slice.
-// The function returns an error if the slice is nil.
-func SumInt(a []int) (int, error) {
+// Average calculates the average of all elements in a numeric slice as a float64.
+// Integer elements are added in a 64-bit accumulator that widens to a big.Int when it
+// overflows, so unlike Sum the result never wraps around for small integer types.
+// Float elements are added with compensated summation (see SumFloat64Kahan), so the
+// result stays accurate for long slices and values of very different magnitudes.
+// The function returns an error if the slice is empty or nil.
+//
+// Example:
+//
+//	avg, err := Average([]uint{1, 2, 3, 4}) // returns 2.5, nil
+func Average[T Number](a []T) (float64, error) {
 	if a == nil {
 		return 0, ErrNilSlice
 	}
+	if len(a) == 0 {
+		return 0, ErrEmptySlice
+	}
 
-	sum := 0
+	acc := newNumberAccumulator[T]()
 	for _, v := range a {
-		sum += v
+		acc.add(v)
 	}
-	return sum, nil
+	return acc.result() / float64(len(a)), nil
 }
 
-// SumFloat64 calculates the sum of all float64 values in a slice.
+// SumBy calculates the sum of the values projected from every element, without
+// allocating an intermediate slice. The sum is accumulated in the projected type.
 // The function returns an error if the slice is nil.
-func SumFloat64(a []float64) (float64, error) {
+//
+// Example:
+//
+//	total, err := SumBy(orders, func(o Order) float64 { return o.Amount })
+func SumBy[T any, N Number](a []T, f func(T) N) (N, error) {
+	var sum N
 	if a == nil {
-		return 0, ErrNilSlice
+		return sum, ErrNilSlice
 	}
 
-	sum := 0.0
 	for _, v := range a {
-		sum += v
+		sum += f(v)
 	}
 	return sum, nil
 }
 
-// AverageInt calculates the average of all integers in a slice.
+// AverageBy calculates the average of the values projected from every element as a
+// float64, without allocating an intermediate slice. Like Average, it does not overflow.
 // The function returns an error if the slice is empty or nil.
-func AverageInt(a []int) (float64, error) {
+//
+// Example:
+//
+//	avgAmount, err := AverageBy(orders, func(o Order) float64 { return o.Amount })
+func AverageBy[T any, N Number](a []T, f func(T) N) (float64, error) {
 	if a == nil {
 		return 0, ErrNilSlice
 	}
@@ -256,115 +525,478 @@ func AverageInt(a []int) (float64, error) {
 		return 0, ErrEmptySlice
 	}
 
-	sum, err := SumInt(a)
-	if err != nil {
-		return 0, err
+	acc := newNumberAccumulator[N]()
+	for _, v := range a {
+		acc.add(f(v))
 	}
+	return acc.result() / float64(len(a)), nil
+}
 
-	return float64(sum) / float64(len(a)), nil
+// MaxInt returns the largest number in an int slice.
+// The function returns an error if the slice is empty or nil.
+//
+// Time complexity: O(n) where n is the length of the slice
+// Space complexity: O(1)
+//
+// Example:
+//
+//	slice := []int{1, 5, 3, 9, 2}
+//	max, err := MaxInt(slice) // returns 9, nil
+func MaxInt(a []int) (int, error) {
+	return Max(a)
 }
 
-// AverageFloat64 calculates the average of all float64 values in a slice.
+// MinInt returns the smallest number in an int slice.
 // The function returns an error if the slice is empty or nil.
-func AverageFloat64(a []float64) (float64, error) {
+//
+// Time complexity: O(n) where n is the length of the slice
+// Space complexity: O(1)
+//
+// Example:
+//
+//	slice := []int{1, 5, 3, 9, 2}
+//	min, err := MinInt(slice) // returns 1, nil
+func MinInt(a []int) (int, error) {
+	return Min(a)
+}
+
+// MaxFloat64 returns the largest number in a float64 slice.
+// The function returns an error if the slice is empty or nil.
+func MaxFloat64(a []float64) (float64, error) {
+	return Max(a)
+}
+
+// MinFloat64 returns the smallest number in a float64 slice.
+// The function returns an error if the slice is empty or nil.
+func MinFloat64(a []float64) (float64, error) {
+	return Min(a)
+}
+
+// SumInt calculates the sum of all integers in a slice.
+// The function returns an error if the slice is nil.
+func SumInt(a []int) (int, error) {
+	return Sum(a)
+}
+
+// SumIntChecked calculates the sum of all integers in a slice like SumInt, but detects
+// overflow instead of silently wrapping around.
+// The function returns ErrNilSlice if the slice is nil, and an error wrapping
+// ErrOverflow if the sum does not fit in an int at any point. Use SumBig when an exact
+// total of very large values is needed.
+//
+// Example:
+//
+//	_, err := SumIntChecked([]int{math.MaxInt, 1}) // errors.Is(err, ErrOverflow) is true
+func SumIntChecked(a []int) (int, error) {
 	if a == nil {
 		return 0, ErrNilSlice
 	}
-	if len(a) == 0 {
-		return 0, ErrEmptySlice
+
+	sum := 0
+	for i, v := range a {
+		next, ok := addIntChecked(sum, v)
+		if !ok {
+			return 0, fmt.Errorf("%w: sum exceeds int range at index %d", ErrOverflow, i)
+		}
+		sum = next
 	}
+	return sum, nil
+}
 
-	sum, err := SumFloat64(a)
-	if err != nil {
-		return 0, err
+// SumBig calculates the exact sum of all values in an int64 slice as a big.Int, so the
+// result is correct no matter how large the values are. Values are accumulated in an
+// int64 and only moved into the big.Int when that would overflow, so the common case
+// costs little more than a plain sum. A nil or empty slice sums to 0.
+//
+// Time complexity: O(n) where n is the length of the slice
+// Space complexity: O(1) apart from the size of the result
+//
+// Example:
+//
+//	total := SumBig([]int64{math.MaxInt64, math.MaxInt64}) // 18446744073709551614
+func SumBig(a []int64) *big.Int {
+	total := new(big.Int)
+	var acc int64
+	for _, v := range a {
+		next := acc + v
+		// Signed overflow happened if both operands have the sign the result lacks
+		if (acc^next)&(v^next) < 0 {
+			total.Add(total, big.NewInt(acc))
+			next = v
+		}
+		acc = next
 	}
+	return total.Add(total, big.NewInt(acc))
+}
 
-	return sum / float64(len(a)), nil
+// addIntChecked is a helper function that adds two ints and reports whether the
+// result is exact.
+func addIntChecked(x, y int) (int, bool) {
+	sum := x + y
+	return sum, (x^sum)&(y^sum) >= 0
 }
 
-// CompareSum compares two int slices and determines which one has a greater sum.
-// The function returns a Result type indicating the comparison outcome.
+// SumFloat64 calculates the sum of all float64 values in a slice.
+// Rounding errors accumulate with every addition; use SumFloat64Kahan when accuracy
+// on long slices matters more than speed.
+// The function returns an error if the slice is nil.
+func SumFloat64(a []float64) (float64, error) {
+	return Sum(a)
+}
+
+// SumFloat64Kahan calculates the sum of all float64 values in a slice using compensated
+// (Kahan-Babuska-Neumaier) summation. The rounding error of every addition is carried in
+// a separate term and added back at the end, so the result is accurate even when large
+// values cancel out or many small values are added to a large total, at the cost of a
+// few extra operations per element.
+// The function returns an error if the slice is nil.
 //
-// Time complexity: O(n + m) where n and m are the lengths of the slices
+// Time complexity: O(n) where n is the length of the slice
 // Space complexity: O(1)
 //
 // Example:
 //
-//	a := []int{1, 2, 3}
-//	b := []int{4, 5, 6}
-//	result := CompareSum(a, b) // returns ResultBGreater
-func CompareSum(a, b []int) Result {
-	// Calculate sum of elements in slice a
-	sumA, errA := SumInt(a)
-	if errA != nil {
-		sumA = 0
+//	naive, _ := SumFloat64([]float64{1e16, 1, -1e16})      // returns 0
+//	exact, _ := SumFloat64Kahan([]float64{1e16, 1, -1e16}) // returns 1
+func SumFloat64Kahan(a []float64) (float64, error) {
+	if a == nil {
+		return 0, ErrNilSlice
 	}
 
-	// Calculate sum of elements in slice b
-	sumB, errB := SumInt(b)
-	if errB != nil {
-		sumB = 0
+	var acc kahanAccumulator
+	for _, v := range a {
+		acc.add(v)
 	}
+	return acc.result(), nil
+}
 
-	// Compare the sums and return the result
-	if sumA > sumB {
-		return ResultAGreater
-	} else if sumA < sumB {
-		return ResultBGreater
-	}
-	return ResultEqual
+// kahanAccumulator is a helper type that sums floats with Neumaier's variant of Kahan
+// summation. The zero value is an empty sum.
+type kahanAccumulator struct {
+	sum          float64
+	compensation float64
 }
 
-// CompareSumWithDetails compares two int slices and provides detailed comparison results.
+// add adds v to the sum, keeping the low-order bits lost by the addition in the compensation.
+func (k *kahanAccumulator) add(v float64) {
+	t := k.sum + v
+	if math.Abs(k.sum) >= math.Abs(v) {
+		k.compensation += (k.sum - t) + v
+	} else {
+		k.compensation += (v - t) + k.sum
+	}
+	k.sum = t
+}
+
+// result returns the compensated sum. An infinite sum is returned as is, since its
+// compensation is meaningless (NaN).
+func (k *kahanAccumulator) result() float64 {
+	if math.IsInf(k.sum, 0) {
+		return k.sum
+	}
+	return k.sum + k.compensation
+}
+
+// numberAccumulator is a helper type that sums values of any Number type without
+// overflowing. Integers are added in an int64 or uint64 and move to a big.Int once that
+// would overflow; floats are added with compensated summation.
+type numberAccumulator[T Number] struct {
+	float  bool
+	signed bool
+	kahan  kahanAccumulator
+	i      int64
+	u      uint64
+	big    *big.Int
+}
+
+// newNumberAccumulator returns an empty accumulator for T. The kind of T is detected
+// arithmetically so that named types such as `type celsius float64` are handled too.
+func newNumberAccumulator[T Number]() *numberAccumulator[T] {
+	var zero, one T = 0, 1
+	return &numberAccumulator[T]{float: one/2 != zero, signed: zero-one < zero}
+}
+
+// add adds v to the sum.
+func (n *numberAccumulator[T]) add(v T) {
+	switch {
+	case n.float:
+		n.kahan.add(float64(v))
+	case n.big != nil:
+		n.big.Add(n.big, n.bigOf(v))
+	case n.signed:
+		x := int64(v)
+		next := n.i + x
+		if (x > 0 && next < n.i) || (x < 0 && next > n.i) {
+			n.big = new(big.Int).SetInt64(n.i)
+			n.big.Add(n.big, n.bigOf(v))
+			return
+		}
+		n.i = next
+	default:
+		x := uint64(v)
+		next := n.u + x
+		if next < n.u {
+			n.big = new(big.Int).SetUint64(n.u)
+			n.big.Add(n.big, n.bigOf(v))
+			return
+		}
+		n.u = next
+	}
+}
+
+// bigOf converts an integer value of T to a big.Int.
+func (n *numberAccumulator[T]) bigOf(v T) *big.Int {
+	if n.signed {
+		return big.NewInt(int64(v))
+	}
+	return new(big.Int).SetUint64(uint64(v))
+}
+
+// result returns the sum as a float64, rounded to the nearest representable value.
+func (n *numberAccumulator[T]) result() float64 {
+	switch {
+	case n.float:
+		return n.kahan.result()
+	case n.big != nil:
+		f, _ := new(big.Float).SetInt(n.big).Float64()
+		return f
+	case n.signed:
+		return float64(n.i)
+	default:
+		return float64(n.u)
+	}
+}
+
+// bigInt returns an integer sum as a big.Int.
+func (n *numberAccumulator[T]) bigInt() *big.Int {
+	switch {
+	case n.big != nil:
+		return n.big
+	case n.signed:
+		return big.NewInt(n.i)
+	default:
+		return new(big.Int).SetUint64(n.u)
+	}
+}
+
+// compare returns -1, 0 or +1 depending on whether the sum is less than, equal to or
+// greater than the other sum. Integer sums are compared exactly; a NaN float sum
+// compares equal to anything.
+func (n *numberAccumulator[T]) compare(other *numberAccumulator[T]) int {
+	if !n.float {
+		return n.bigInt().Cmp(other.bigInt())
+	}
+	x, y := n.result(), other.result()
+	switch {
+	case x > y:
+		return 1
+	case x < y:
+		return -1
+	default:
+		return 0
+	}
+}
+
+// difference returns the sum minus the other sum as a float64. Integer sums are
+// subtracted exactly before rounding.
+func (n *numberAccumulator[T]) difference(other *numberAccumulator[T]) float64 {
+	if n.float {
+		return n.result() - other.result()
+	}
+	d := new(big.Int).Sub(n.bigInt(), other.bigInt())
+	f, _ := new(big.Float).SetInt(d).Float64()
+	return f
+}
+
+// wideSum is a helper function that adds the elements of a numeric slice in a
+// numberAccumulator. A nil slice yields an empty sum and ErrNilSlice.
+func wideSum[T Number](a []T) (*numberAccumulator[T], error) {
+	acc := newNumberAccumulator[T]()
+	if a == nil {
+		return acc, ErrNilSlice
+	}
+	for _, v := range a {
+		acc.add(v)
+	}
+	return acc, nil
+}
+
+// AverageInt calculates the average of all integers in a slice.
+// The function returns an error if the slice is empty or nil.
+func AverageInt(a []int) (float64, error) {
+	return Average(a)
+}
+
+// AverageFloat64 calculates the average of all float64 values in a slice.
+// The function returns an error if the slice is empty or nil.
+func AverageFloat64(a []float64) (float64, error) {
+	return Average(a)
+}
+
+// SumCompareOption configures CompareSumWithOptions and CompareSumWithDetails.
+type SumCompareOption func(*sumComparer)
+
+// sumComparer holds the settings applied by SumCompareOption values
+type sumComparer struct {
+	nilPolicy NilPolicy
+}
+
+// WithNilPolicy sets how sum comparisons handle nil slices. The default, NilAsZero,
+// treats a nil slice as summing to zero; NilError makes the comparison fail instead.
+func WithNilPolicy(policy NilPolicy) SumCompareOption {
+	return func(c *sumComparer) {
+		c.nilPolicy = policy
+	}
+}
+
+// CompareSum compares two numeric slices and determines which one has a greater sum.
+// The function returns a Result type indicating the comparison outcome.
+// A nil slice is treated as summing to zero; use CompareSumWithOptions to reject it.
+//
+// Time complexity: O(n + m) where n and m are the lengths of the slices
+// Space complexity: O(1)
+//
+// Example:
+//
+//	a := []int{1, 2, 3}
+//	b := []int{4, 5, 6}
+//	result := CompareSum(a, b) // returns ResultBGreater
+func CompareSum[T Number](a, b []T) Result {
+	return CompareSumWithDetails(a, b).Details.Outcome
+}
+
+// CompareSumWithOptions compares two numeric slices like CompareSum, with configurable
+// handling of nil slices.
+// With WithNilPolicy(NilError), the function returns an error wrapping ErrNilSlice if
+// either slice is nil. An unknown policy returns an error wrapping ErrInvalidPolicy.
+//
+// Example:
+//
+//	result, err := CompareSumWithOptions(a, nil, WithNilPolicy(NilError))
+//	// errors.Is(err, ErrNilSlice) is true
+func CompareSumWithOptions[T Number](a, b []T, opts ...SumCompareOption) (Result, error) {
+	result, err := compareSum(a, b, opts)
+	if err != nil {
+		return "", err
+	}
+	return result.Details.Outcome, nil
+}
+
+// CompareSumWithDetails compares two numeric slices and provides detailed comparison results.
 // This function is useful when you need more information about the comparison.
-func CompareSumWithDetails(a, b []int) CompareResult {
-	result := CompareResult{
-		Details: make(map[string]interface{}),
+// When an input is nil, Details.NilPolicy reports the policy that was applied. If the
+// policy rejects the input, Equal is false, Outcome is empty and the reason is stored
+// in Message and ErrorA or ErrorB.
+func CompareSumWithDetails[T Number](a, b []T, opts ...SumCompareOption) CompareResult {
+	result, err := compareSum(a, b, opts)
+	if err != nil {
+		result.Equal = false
+		result.Message = err.Error()
+	}
+	return result
+}
+
+// compareSum is a helper function that compares the sums of two slices under the
+// configured nil policy, filling in the details as it goes.
+func compareSum[T Number](a, b []T, opts []SumCompareOption) (CompareResult, error) {
+	config := sumComparer{nilPolicy: NilAsZero}
+	for _, opt := range opts {
+		opt(&config)
+	}
+
+	var result CompareResult
+	if config.nilPolicy != NilAsZero && config.nilPolicy != NilError {
+		return result, fmt.Errorf("%w: %q", ErrInvalidPolicy, config.nilPolicy)
 	}
 
-	// Calculate sums
-	sumA, errA := SumInt(a)
+	// Calculate sums in wide accumulators so that small integer types cannot wrap
+	sumA, errA := wideSum(a)
 	if errA != nil {
-		result.Details["error_a"] = errA.Error()
-		sumA = 0
+		result.Details.ErrorA = errA.Error()
+		result.Details.NilPolicy = config.nilPolicy
 	}
 
-	sumB, errB := SumInt(b)
+	sumB, errB := wideSum(b)
 	if errB != nil {
-		result.Details["error_b"] = errB.Error()
-		sumB = 0
+		result.Details.ErrorB = errB.Error()
+		result.Details.NilPolicy = config.nilPolicy
 	}
 
-	// Store sums in details
-	result.Details["sum_a"] = sumA
-	result.Details["sum_b"] = sumB
-	result.Details["difference"] = sumA - sumB
+	if config.nilPolicy == NilError {
+		if errA != nil {
+			return result, fmt.Errorf("slice A: %w", errA)
+		}
+		if errB != nil {
+			return result, fmt.Errorf("slice B: %w", errB)
+		}
+	}
+
+	// Store sums in details; the difference is computed exactly before rounding so that
+	// it cannot wrap around for unsigned types
+	result.Details.SumA = sumA.result()
+	result.Details.SumB = sumB.result()
+	result.Details.SumDifference = sumA.difference(sumB)
 
 	// Determine result
-	if sumA > sumB {
+	order := sumA.compare(sumB)
+	if order > 0 {
 		result.Equal = false
 		result.Message = "Slice A has greater sum"
-		result.Details["result"] = ResultAGreater
-	} else if sumA < sumB {
+		result.Details.Outcome = ResultAGreater
+	} else if order < 0 {
 		result.Equal = false
 		result.Message = "Slice B has greater sum"
-		result.Details["result"] = ResultBGreater
+		result.Details.Outcome = ResultBGreater
 	} else {
 		result.Equal = true
 		result.Message = "Both slices have equal sums"
-		result.Details["result"] = ResultEqual
+		result.Details.Outcome = ResultEqual
 	}
 
-	return result
+	return result, nil
+}
+
+// StatsOption configures GetSliceStats.
+type StatsOption func(*statsConfig)
+
+// statsConfig holds the settings applied by StatsOption values
+type statsConfig struct {
+	checked bool
+}
+
+// WithCheckedArithmetic makes GetSliceStats return an error wrapping ErrOverflow when
+// the running sum or the range of the slice overflows an int, instead of reporting a
+// wrapped-around value.
+func WithCheckedArithmetic() StatsOption {
+	return func(c *statsConfig) {
+		c.checked = true
+	}
 }
 
 // GetSliceStats provides comprehensive statistical information about a slice.
 // This function is useful for analyzing slice characteristics.
-func GetSliceStats(a []int) (SliceStats, error) {
+// All metrics are computed in a single pass over the slice.
+// By default, the sum and range wrap around on overflow like plain int arithmetic;
+// pass WithCheckedArithmetic to get an error instead.
+//
+// Time complexity: O(n) where n is the length of the slice
+// Space complexity: O(d) where d is the number of distinct values
+//
+// Example:
+//
+//	stats, err := GetSliceStats([]int{-2, 0, 3, 3})
+//	// stats.Min: -2, stats.Max: 3, stats.Range: 5, stats.DistinctCount: 3,
+//	// stats.ZeroCount: 1, stats.NegativeCount: 1
+func GetSliceStats(a []int, opts ...StatsOption) (SliceStats, error) {
 	if a == nil {
 		return SliceStats{}, ErrNilSlice
 	}
 
+	var config statsConfig
+	for _, opt := range opts {
+		opt(&config)
+	}
+
 	stats := SliceStats{
 		Length: len(a),
 	}
@@ -373,73 +1005,103 @@ func GetSliceStats(a []int) (SliceStats, error) {
 		return stats, nil
 	}
 
-	// Calculate min, max, sum
-	min, err := MinInt(a)
-	if err != nil {
-		return stats, err
+	// Calculate min, max, sum and value counts in one pass
+	min, max, sum := a[0], a[0], 0
+	overflow := false
+	seen := make(map[int]struct{})
+	for _, v := range a {
+		if v < min {
+			min = v
+		}
+		if v > max {
+			max = v
+		}
+		next, ok := addIntChecked(sum, v)
+		overflow = overflow || !ok
+		sum = next
+
+		if v == 0 {
+			stats.ZeroCount++
+		} else if v < 0 {
+			stats.NegativeCount++
+		}
+		seen[v] = struct{}{}
 	}
-	stats.Min = min
 
-	max, err := MaxInt(a)
-	if err != nil {
-		return stats, err
+	// The true range is never negative, so a negative result means it wrapped around
+	valueRange := max - min
+	overflow = overflow || valueRange < 0
+	if config.checked && overflow {
+		return SliceStats{}, fmt.Errorf("%w: sum or range exceeds int range", ErrOverflow)
 	}
-	stats.Max = max
 
-	sum, err := SumInt(a)
-	if err != nil {
-		return stats, err
-	}
+	stats.Min = min
+	stats.Max = max
+	stats.Range = valueRange
 	stats.Sum = sum
-
-	// Calculate average
 	stats.Average = float64(sum) / float64(len(a))
-
-	// Check for duplicates
-	seen := make(map[int]bool)
-	for _, v := range a {
-		if seen[v] {
-			stats.HasDuplicates = true
-			break
-		}
-		seen[v] = true
-	}
+	stats.DistinctCount = len(seen)
+	stats.HasDuplicates = stats.DistinctCount < len(a)
 
 	return stats, nil
 }
 
 // IsSorted checks if a slice is sorted in ascending order.
 // The function uses Go's sort.IsSorted for efficient checking.
+//
+// Deprecated: IsSorted requires a sort.Interface. Use IsSortedOrder for slices of an
+// ordered type, or IsSortedFunc with a custom less function.
 func IsSorted[T sort.Interface](a T) bool {
 	return sort.IsSorted(a)
 }
 
-// IsSortedInt checks if an int slice is sorted in ascending order.
-func IsSortedInt(a []int) bool {
-	if a == nil || len(a) <= 1 {
+// IsSortedOrder checks if a slice of an ordered type is sorted in the specified order.
+// Equal neighbours are allowed in either order. Like Sort, OrderNone places no
+// requirement on the order and any order other than OrderDesc checks ascending order,
+// so IsSortedOrder(a, order) holds after Sort(a, order).
+//
+// Time complexity: O(n) where n is the length of the slice
+// Space complexity: O(1)
+//
+// Example:
+//
+//	IsSortedOrder([]float64{3.5, 2, 2, 1}, OrderDesc) // returns true
+//	IsSortedOrder([]float64{1, 3, 2}, OrderAsc)       // returns false
+func IsSortedOrder[T cmp.Ordered](a []T, order OrderType) bool {
+	if order == OrderNone {
 		return true
 	}
+	if order == OrderDesc {
+		return IsSortedFunc(a, func(x, y T) bool { return cmp.Less(y, x) })
+	}
+	return IsSortedFunc(a, cmp.Less[T])
+}
 
+// IsSortedFunc checks if a slice is sorted according to a custom less function, which
+// makes it usable for structs and custom keys. It is the counterpart of SortBy:
+// IsSortedFunc(a, less) holds after SortBy(a, less).
+//
+// Example:
+//
+//	byAge := func(a, b Person) bool { return a.Age < b.Age }
+//	sorted := IsSortedFunc(people, byAge)
+func IsSortedFunc[T any](a []T, less func(a, b T) bool) bool {
 	for i := 1; i < len(a); i++ {
-		if a[i] < a[i-1] {
+		if less(a[i], a[i-1]) {
 			return false
 		}
 	}
 	return true
 }
 
+// IsSortedInt checks if an int slice is sorted in ascending order.
+func IsSortedInt(a []int) bool {
+	return IsSortedOrder(a, OrderAsc)
+}
+
 // IsSortedString checks if a string slice is sorted in ascending order.
 func IsSortedString(a []string) bool {
-	if a == nil || len(a) <= 1 {
-		return true
-	}
-
-	for i := 1; i < len(a); i++ {
-		if a[i] < a[i-1] {
-			return false
-		}
-	}
-	return true
+	return IsSortedOrder(a, OrderAsc)
 }
 
 // Reverse reverses the order of elements in a slice.
@@ -466,6 +1128,91 @@ func ReverseCopy[T any](a []T) []T {
 	return result
 }
 
+// Rotate rotates the elements of a slice k positions to the left in place, so that the
+// element at index k becomes the first one. A negative k rotates to the right, and k may
+// exceed the length of the slice, in which case it wraps around.
+//
+// Time complexity: O(n) where n is the length of the slice
+// Space complexity: O(1)
+//
+// Example:
+//
+//	slice := []int{1, 2, 3, 4, 5}
+//	Rotate(slice, 2)  // slice is now []int{3, 4, 5, 1, 2}
+//	Rotate(slice, -2) // slice is back to []int{1, 2, 3, 4, 5}
+func Rotate[T any](a []T, k int) {
+	if len(a) <= 1 {
+		return
+	}
+
+	k %= len(a)
+	if k < 0 {
+		k += len(a)
+	}
+	if k == 0 {
+		return
+	}
+
+	// Rotation by three reversals
+	Reverse(a[:k])
+	Reverse(a[k:])
+	Reverse(a)
+}
+
+// RotateCopy creates a rotated copy of a slice without modifying the original.
+func RotateCopy[T any](a []T, k int) []T {
+	if a == nil {
+		return nil
+	}
+
+	result := make([]T, len(a))
+	copy(result, a)
+	Rotate(result, k)
+	return result
+}
+
+// Swap exchanges the elements at indices i and j in place.
+// It returns ErrOutOfRange if either index is outside the slice.
+func Swap[T any](a []T, i, j int) error {
+	if err := checkEditIndex(i, len(a)); err != nil {
+		return err
+	}
+	if err := checkEditIndex(j, len(a)); err != nil {
+		return err
+	}
+
+	a[i], a[j] = a[j], a[i]
+	return nil
+}
+
+// SwapRanges exchanges the n elements starting at index i with the n elements starting
+// at index j in place. The ranges must lie within the slice and must not overlap;
+// otherwise ErrOutOfRange is returned and the slice is left unchanged.
+//
+// Example:
+//
+//	slice := []int{1, 2, 3, 4, 5, 6}
+//	err := SwapRanges(slice, 0, 4, 2) // slice is now []int{5, 6, 3, 4, 1, 2}
+func SwapRanges[T any](a []T, i, j, n int) error {
+	if n < 0 {
+		return fmt.Errorf("%w: negative length %d", ErrOutOfRange, n)
+	}
+	if err := checkEditRange(i, i+n, len(a)); err != nil {
+		return err
+	}
+	if err := checkEditRange(j, j+n, len(a)); err != nil {
+		return err
+	}
+	if i < j+n && j < i+n && n > 0 && i != j {
+		return fmt.Errorf("%w: ranges [%d:%d] and [%d:%d] overlap", ErrOutOfRange, i, i+n, j, j+n)
+	}
+
+	for k := 0; k < n && i != j; k++ {
+		a[i+k], a[j+k] = a[j+k], a[i+k]
+	}
+	return nil
+}
+
 // RemoveDuplicates removes duplicate elements from a slice while preserving order.
 // The function returns a new slice with duplicates removed.
 func RemoveDuplicates[T comparable](a []T) []T {
@@ -489,6 +1236,111 @@ func RemoveDuplicates[T comparable](a []T) []T {
 	return result
 }
 
+// RemoveDuplicatesWithPolicy removes duplicate elements from a slice according to the given policy.
+// With KeepFirst the earliest occurrence of each element is retained, which matches RemoveDuplicates.
+// With KeepLast the most recent occurrence is retained and the result is ordered by the position
+// of those last occurrences. Any other policy value behaves like KeepFirst.
+//
+// Example:
+//
+//	slice := []int{1, 2, 1, 3, 2}
+//	result := RemoveDuplicatesWithPolicy(slice, KeepLast) // returns []int{1, 3, 2}
+func RemoveDuplicatesWithPolicy[T comparable](a []T, policy KeepPolicy) []T {
+	return distinctByKey(a, func(v T) T { return v }, policy)
+}
+
+// DistinctBy removes elements that share the same key according to the given policy,
+// like RemoveDuplicatesWithPolicy, for elements that are not comparable themselves or
+// that should be deduplicated by a single field such as an ID.
+//
+// Time complexity: O(n) where n is the length of the slice
+// Space complexity: O(k) where k is the number of distinct keys
+//
+// Example:
+//
+//	events := []Event{{ID: 1, Seq: 1}, {ID: 2, Seq: 2}, {ID: 1, Seq: 3}}
+//	latest := DistinctBy(events, func(e Event) int { return e.ID }, KeepLast)
+//	// returns [{2 2} {1 3}]
+func DistinctBy[T any, K comparable](a []T, key func(T) K, policy KeepPolicy) []T {
+	return distinctByKey(a, key, policy)
+}
+
+// RemoveDuplicatesFunc removes elements that share the same key while preserving order.
+// The first occurrence of each key is retained, matching the semantics of RemoveDuplicates.
+// Unlike RemoveDuplicates, the elements themselves do not need to be comparable.
+//
+// Example:
+//
+//	users := []User{{ID: 1, Name: "Alice"}, {ID: 1, Name: "Alicia"}, {ID: 2, Name: "Bob"}}
+//	unique := RemoveDuplicatesFunc(users, func(u User) int { return u.ID })
+//	// returns [{1 Alice} {2 Bob}]
+func RemoveDuplicatesFunc[T any, K comparable](a []T, key func(T) K) []T {
+	return distinctByKey(a, key, KeepFirst)
+}
+
+// Compact returns a copy of a slice in which every run of consecutive equal elements
+// is replaced by a single element, like slices.Compact, which it delegates to. Unlike
+// slices.Compact, the original slice is not modified. Use RemoveDuplicates to remove
+// duplicates that are not adjacent. If the slice is nil, the result is nil.
+//
+// Time complexity: O(n) where n is the length of the slice
+// Space complexity: O(n) for the result slice
+//
+// Example:
+//
+//	result := Compact([]int{1, 1, 2, 2, 1}) // returns []int{1, 2, 1}
+func Compact[T comparable](a []T) []T {
+	return slices.Clip(slices.Compact(slices.Clone(a)))
+}
+
+// CompactFunc works like Compact, but uses a custom equality function, like
+// slices.CompactFunc. The first element of every run is kept.
+//
+// Example:
+//
+//	words := CompactFunc([]string{"Go", "go", "Rust"}, strings.EqualFold)
+//	// returns []string{"Go", "Rust"}
+func CompactFunc[T any](a []T, eq func(a, b T) bool) []T {
+	return slices.Clip(slices.CompactFunc(slices.Clone(a), eq))
+}
+
+// distinctByKey is a helper function that removes elements sharing the same key,
+// retaining either the first or the last occurrence depending on the policy.
+func distinctByKey[T any, K comparable](a []T, key func(T) K, policy KeepPolicy) []T {
+	if a == nil {
+		return nil
+	}
+	if len(a) <= 1 {
+		return append([]T{}, a...)
+	}
+
+	seen := make(map[K]bool)
+	result := make([]T, 0, len(a))
+
+	if policy != KeepLast {
+		for _, v := range a {
+			k := key(v)
+			if !seen[k] {
+				seen[k] = true
+				result = append(result, v)
+			}
+		}
+		return result
+	}
+
+	// Walk backwards so the last occurrence wins, then restore the original order
+	for i := len(a) - 1; i >= 0; i-- {
+		k := key(a[i])
+		if !seen[k] {
+			seen[k] = true
+			result = append(result, a[i])
+		}
+	}
+	Reverse(result)
+
+	return result
+}
+
 // Contains checks if a slice contains a specific element.
 func Contains[T comparable](a []T, element T) bool {
 	if a == nil {
@@ -503,6 +1355,29 @@ func Contains[T comparable](a []T, element T) bool {
 	return false
 }
 
+// ContainsAll checks if a slice contains every one of the given elements. It returns
+// true when no elements are given. Pass a Set with ContainsAll(a, set.ToSlice()...).
+//
+// Time complexity: O(n + m) where n is the length of the slice and m the number of elements
+// Space complexity: O(n) for the lookup set
+//
+// Example:
+//
+//	ok := ContainsAll([]string{"read", "write", "admin"}, "read", "write") // returns true
+func ContainsAll[T comparable](a []T, elements ...T) bool {
+	if len(elements) == 0 {
+		return true
+	}
+
+	set := ToSet(a)
+	for _, v := range elements {
+		if _, ok := set[v]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
 // IndexOf returns the index of the first occurrence of an element in a slice.
 // Returns -1 if the element is not found.
 func IndexOf[T comparable](a []T, element T) int {
@@ -518,6 +1393,83 @@ func IndexOf[T comparable](a []T, element T) int {
 	return -1
 }
 
+// LastIndexOf returns the index of the last occurrence of an element in a slice.
+// Returns -1 if the element is not found.
+func LastIndexOf[T comparable](a []T, element T) int {
+	for i := len(a) - 1; i >= 0; i-- {
+		if a[i] == element {
+			return i
+		}
+	}
+	return -1
+}
+
+// IndexOfFunc returns the index of the first element that satisfies the predicate.
+// Returns -1 if no element matches.
+//
+// Example:
+//
+//	index := IndexOfFunc(users, func(u User) bool { return u.Email == "bob@example.com" })
+func IndexOfFunc[T any](a []T, pred func(T) bool) int {
+	for i, v := range a {
+		if pred(v) {
+			return i
+		}
+	}
+	return -1
+}
+
+// LastIndexOfFunc returns the index of the last element that satisfies the predicate.
+// Returns -1 if no element matches.
+func LastIndexOfFunc[T any](a []T, pred func(T) bool) int {
+	for i := len(a) - 1; i >= 0; i-- {
+		if pred(a[i]) {
+			return i
+		}
+	}
+	return -1
+}
+
+// FindFirst returns the first element that satisfies the predicate and true,
+// or the zero value and false if no element matches.
+//
+// Example:
+//
+//	admin, ok := FindFirst(users, func(u User) bool { return u.Role == "admin" })
+func FindFirst[T any](a []T, pred func(T) bool) (T, bool) {
+	if i := IndexOfFunc(a, pred); i >= 0 {
+		return a[i], true
+	}
+	var zero T
+	return zero, false
+}
+
+// FindLast returns the last element that satisfies the predicate and true,
+// or the zero value and false if no element matches.
+func FindLast[T any](a []T, pred func(T) bool) (T, bool) {
+	if i := LastIndexOfFunc(a, pred); i >= 0 {
+		return a[i], true
+	}
+	var zero T
+	return zero, false
+}
+
+// AllIndicesOf returns the indices of every occurrence of an element in a slice,
+// in ascending order. Returns an empty slice if the element is not found.
+//
+// Example:
+//
+//	indices := AllIndicesOf([]int{1, 2, 1, 3, 1}, 1) // returns []int{0, 2, 4}
+func AllIndicesOf[T comparable](a []T, element T) []int {
+	indices := make([]int, 0)
+	for i, v := range a {
+		if v == element {
+			indices = append(indices, i)
+		}
+	}
+	return indices
+}
+
 // CountOccurrences counts how many times an element appears in a slice.
 func CountOccurrences[T comparable](a []T, element T) int {
 	if a == nil {
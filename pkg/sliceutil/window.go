@@ -0,0 +1,110 @@
+package sliceutil
+
+import "math"
+
+// Shingles returns every overlapping run of n consecutive elements (n-grams) in a slice.
+// Shingles are produced in order of their starting position, and each shingle is a
+// fresh copy. If n is not positive or exceeds the length of the slice, the result is empty.
+// The resulting shingles are a common input for near-duplicate detection of sequences.
+//
+// Time complexity: O(n * k) where k is the number of shingles
+// Space complexity: O(n * k) for the result
+//
+// Example:
+//
+//	shingles := Shingles([]string{"the", "quick", "brown", "fox"}, 2)
+//	// returns [["the" "quick"] ["quick" "brown"] ["brown" "fox"]]
+func Shingles[T any](s []T, n int) [][]T {
+	return SlidingWindow(s, n, 1)
+}
+
+// SlidingWindow returns windows of size consecutive elements, starting a new window
+// every step elements. Only complete windows are returned, so trailing elements that do
+// not fill a window are dropped. Each window is a fresh copy. If size or step is not
+// positive, or size exceeds the length of the slice, the result is empty.
+// A step equal to size produces non-overlapping windows.
+//
+// Time complexity: O(size * k) where k is the number of windows
+// Space complexity: O(size * k) for the result
+//
+// Example:
+//
+//	windows := SlidingWindow([]int{1, 2, 3, 4, 5}, 3, 2)
+//	// returns [][]int{{1, 2, 3}, {3, 4, 5}}
+func SlidingWindow[T any](s []T, size, step int) [][]T {
+	if size <= 0 || step <= 0 || size > len(s) {
+		return [][]T{}
+	}
+
+	result := make([][]T, 0, (len(s)-size)/step+1)
+	for i := 0; i+size <= len(s); i += step {
+		result = append(result, append([]T{}, s[i:i+size]...))
+	}
+
+	return result
+}
+
+// Pairwise returns every pair of neighbouring elements in a slice, in order.
+// A slice with fewer than two elements has no pairs.
+//
+// Example:
+//
+//	pairs := Pairwise([]int{1, 2, 3})
+//	// returns [{1 2} {2 3}]
+func Pairwise[T any](s []T) []Pair[T, T] {
+	if len(s) < 2 {
+		return []Pair[T, T]{}
+	}
+
+	result := make([]Pair[T, T], 0, len(s)-1)
+	for i := 1; i < len(s); i++ {
+		result = append(result, Pair[T, T]{First: s[i-1], Second: s[i]})
+	}
+
+	return result
+}
+
+// RollingAverage returns the average of every window of consecutive elements, which
+// smooths a time series. The result has one value per complete window, so it holds
+// len(s)-window+1 values. If window is not positive or exceeds the length of the
+// slice, the result is empty. The window sum is kept with compensated summation, so a
+// large value leaving the window does not wipe out the small values that remain, and a
+// NaN or infinity only affects the windows that contain it.
+//
+// Time complexity: O(n) where n is the length of the slice, rising to O(n * window)
+// while windows hold NaN or infinite values
+// Space complexity: O(n) for the result
+//
+// Example:
+//
+//	averages := RollingAverage([]float64{1, 2, 3, 4, 5}, 3)
+//	// returns []float64{2, 3, 4}
+func RollingAverage[T Number](s []T, window int) []float64 {
+	if window <= 0 || window > len(s) {
+		return []float64{}
+	}
+
+	result := make([]float64, 0, len(s)-window+1)
+	var acc kahanAccumulator
+	for i, v := range s {
+		acc.add(float64(v))
+		if i >= window {
+			acc.add(-float64(s[i-window]))
+		}
+		sum := acc.result()
+		if math.IsNaN(sum) || math.IsInf(sum, 0) {
+			// Inf - Inf is NaN, so a running sum cannot recover once a non-finite
+			// value has left the window; recompute it from the window instead
+			acc = kahanAccumulator{}
+			for _, w := range s[max(0, i-window+1) : i+1] {
+				acc.add(float64(w))
+			}
+			sum = acc.result()
+		}
+		if i >= window-1 {
+			result = append(result, sum/float64(window))
+		}
+	}
+
+	return result
+}
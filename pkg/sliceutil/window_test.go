@@ -0,0 +1,121 @@
+package sliceutil
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestShingles tests the Shingles function
+func TestShingles(t *testing.T) {
+	t.Run("Word Bigrams", func(t *testing.T) {
+		result := Shingles([]string{"the", "quick", "brown", "fox"}, 2)
+		expected := [][]string{{"the", "quick"}, {"quick", "brown"}, {"brown", "fox"}}
+		assert.Equal(t, expected, result)
+	})
+
+	t.Run("Size Equal To Length", func(t *testing.T) {
+		assert.Equal(t, [][]int{{1, 2, 3}}, Shingles([]int{1, 2, 3}, 3))
+	})
+
+	t.Run("Shingles Do Not Alias Input", func(t *testing.T) {
+		s := []int{1, 2, 3}
+		result := Shingles(s, 2)
+		result[0][1] = 99
+		assert.Equal(t, []int{1, 2, 3}, s)
+		assert.Equal(t, 2, result[1][0])
+	})
+
+	t.Run("Invalid Sizes", func(t *testing.T) {
+		assert.Empty(t, Shingles([]int{1, 2}, 0))
+		assert.Empty(t, Shingles([]int{1, 2}, -1))
+		assert.Empty(t, Shingles([]int{1, 2}, 3))
+		assert.Empty(t, Shingles[int](nil, 1))
+	})
+}
+
+// TestSlidingWindow tests the SlidingWindow function
+func TestSlidingWindow(t *testing.T) {
+	t.Run("Overlapping Windows", func(t *testing.T) {
+		result := SlidingWindow([]int{1, 2, 3, 4, 5}, 3, 2)
+		assert.Equal(t, [][]int{{1, 2, 3}, {3, 4, 5}}, result)
+	})
+
+	t.Run("Tumbling Windows Drop Remainder", func(t *testing.T) {
+		result := SlidingWindow([]int{1, 2, 3, 4, 5}, 2, 2)
+		assert.Equal(t, [][]int{{1, 2}, {3, 4}}, result)
+	})
+
+	t.Run("Step Larger Than Size", func(t *testing.T) {
+		result := SlidingWindow([]int{1, 2, 3, 4, 5, 6}, 1, 3)
+		assert.Equal(t, [][]int{{1}, {4}}, result)
+	})
+
+	t.Run("Windows Do Not Alias Input", func(t *testing.T) {
+		s := []int{1, 2, 3}
+		result := SlidingWindow(s, 2, 1)
+		result[0][1] = 99
+		assert.Equal(t, []int{1, 2, 3}, s)
+	})
+
+	t.Run("Invalid Sizes", func(t *testing.T) {
+		assert.Empty(t, SlidingWindow([]int{1, 2}, 0, 1))
+		assert.Empty(t, SlidingWindow([]int{1, 2}, 1, 0))
+		assert.Empty(t, SlidingWindow([]int{1, 2}, 3, 1))
+		assert.Empty(t, SlidingWindow[int](nil, 1, 1))
+	})
+}
+
+// TestPairwise tests the Pairwise function
+func TestPairwise(t *testing.T) {
+	t.Run("Neighbouring Pairs", func(t *testing.T) {
+		result := Pairwise([]string{"a", "b", "c"})
+		assert.Equal(t, []Pair[string, string]{{"a", "b"}, {"b", "c"}}, result)
+	})
+
+	t.Run("Nil and Short Slices", func(t *testing.T) {
+		assert.Empty(t, Pairwise[int](nil))
+		assert.Empty(t, Pairwise([]int{1}))
+	})
+}
+
+// TestRollingAverage tests the RollingAverage function
+func TestRollingAverage(t *testing.T) {
+	t.Run("Float Series", func(t *testing.T) {
+		result := RollingAverage([]float64{1, 2, 3, 4, 5}, 3)
+		assert.InDeltaSlice(t, []float64{2, 3, 4}, result, 1e-9)
+	})
+
+	t.Run("Integer Series", func(t *testing.T) {
+		result := RollingAverage([]int{2, 4, 6, 8}, 2)
+		assert.Equal(t, []float64{3, 5, 7}, result)
+	})
+
+	t.Run("Window Of One", func(t *testing.T) {
+		assert.Equal(t, []float64{1, 2}, RollingAverage([]int{1, 2}, 1))
+	})
+
+	t.Run("Recovers From Cancellation", func(t *testing.T) {
+		assert.Equal(t, []float64{1e17, 1, 3, 5, 7}, RollingAverage([]float64{1e17, 1, 3, 5, 7}, 1))
+		assert.Equal(t, []float64{1, 1}, RollingAverage([]float64{1e16, 1, 1, 1}, 2)[1:])
+		assert.Equal(t, []float64{2, 4, 6}, RollingAverage([]float64{1e17, 1, 3, 5, 7}, 2)[1:])
+	})
+
+	t.Run("Recovers From Non-Finite Values", func(t *testing.T) {
+		result := RollingAverage([]float64{1, math.Inf(1), 3, 5, 7}, 2)
+		assert.Equal(t, []float64{math.Inf(1), math.Inf(1), 4, 6}, result)
+
+		result = RollingAverage([]float64{math.Inf(-1), 2, math.NaN(), 4, 6, 8}, 2)
+		assert.Equal(t, math.Inf(-1), result[0])
+		assert.True(t, math.IsNaN(result[1]))
+		assert.True(t, math.IsNaN(result[2]))
+		assert.Equal(t, []float64{5, 7}, result[3:])
+	})
+
+	t.Run("Invalid Windows", func(t *testing.T) {
+		assert.Empty(t, RollingAverage([]float64{1, 2}, 0))
+		assert.Empty(t, RollingAverage([]float64{1, 2}, 3))
+		assert.Empty(t, RollingAverage[float64](nil, 1))
+	})
+}
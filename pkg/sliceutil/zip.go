@@ -0,0 +1,82 @@
+package sliceutil
+
+import "fmt"
+
+// Zip pairs up the elements of two slices by position. The policy decides what happens
+// when the slices have different lengths: LengthTruncate stops at the end of the shorter
+// slice, and LengthError returns ErrLengthMismatch. An unknown policy returns ErrInvalidPolicy.
+//
+// Time complexity: O(n) where n is the length of the result
+// Space complexity: O(n) for the result
+//
+// Example:
+//
+//	pairs, err := Zip([]string{"a", "b", "c"}, []int{1, 2}, LengthTruncate)
+//	// returns [{a 1} {b 2}], nil
+func Zip[A, B any](a []A, b []B, policy LengthPolicy) ([]Pair[A, B], error) {
+	return ZipWith(a, b, func(x A, y B) Pair[A, B] {
+		return Pair[A, B]{First: x, Second: y}
+	}, policy)
+}
+
+// ZipWith combines the elements of two slices by position using a function, following
+// the same length policy as Zip.
+//
+// Example:
+//
+//	sums, err := ZipWith([]int{1, 2}, []int{10, 20}, func(x, y int) int { return x + y }, LengthError)
+//	// returns []int{11, 22}, nil
+func ZipWith[A, B, C any](a []A, b []B, f func(A, B) C, policy LengthPolicy) ([]C, error) {
+	n, err := zipLength(len(a), len(b), policy)
+	if err != nil {
+		return nil, err
+	}
+	if a == nil && b == nil {
+		return nil, nil
+	}
+
+	result := make([]C, n)
+	for i := 0; i < n; i++ {
+		result[i] = f(a[i], b[i])
+	}
+
+	return result, nil
+}
+
+// Unzip splits a slice of pairs into a slice of first values and a slice of second values.
+// It is the inverse of Zip.
+//
+// Example:
+//
+//	names, ages := Unzip([]Pair[string, int]{{"Alice", 30}, {"Bob", 25}})
+//	// names: []string{"Alice", "Bob"}, ages: []int{30, 25}
+func Unzip[A, B any](pairs []Pair[A, B]) ([]A, []B) {
+	if pairs == nil {
+		return nil, nil
+	}
+
+	first := make([]A, len(pairs))
+	second := make([]B, len(pairs))
+	for i, p := range pairs {
+		first[i] = p.First
+		second[i] = p.Second
+	}
+
+	return first, second
+}
+
+// zipLength is a helper function that returns the number of elements to combine for
+// slices of the given lengths under a length policy.
+func zipLength(lenA, lenB int, policy LengthPolicy) (int, error) {
+	switch policy {
+	case LengthTruncate:
+		return min(lenA, lenB), nil
+	case LengthError:
+		if lenA != lenB {
+			return 0, fmt.Errorf("%w: %d != %d", ErrLengthMismatch, lenA, lenB)
+		}
+		return lenA, nil
+	default:
+		return 0, fmt.Errorf("%w: %q", ErrInvalidPolicy, policy)
+	}
+}
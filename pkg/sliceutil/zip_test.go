@@ -0,0 +1,99 @@
+package sliceutil
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestZip tests the Zip function
+func TestZip(t *testing.T) {
+	t.Run("Equal Lengths", func(t *testing.T) {
+		result, err := Zip([]string{"a", "b"}, []int{1, 2}, LengthError)
+		require.NoError(t, err)
+		assert.Equal(t, []Pair[string, int]{{"a", 1}, {"b", 2}}, result)
+	})
+
+	t.Run("Truncate", func(t *testing.T) {
+		result, err := Zip([]string{"a", "b", "c"}, []int{1, 2}, LengthTruncate)
+		require.NoError(t, err)
+		assert.Equal(t, []Pair[string, int]{{"a", 1}, {"b", 2}}, result)
+	})
+
+	t.Run("Length Mismatch Error", func(t *testing.T) {
+		result, err := Zip([]string{"a"}, []int{1, 2}, LengthError)
+		assert.ErrorIs(t, err, ErrLengthMismatch)
+		assert.Nil(t, result)
+	})
+
+	t.Run("Invalid Policy", func(t *testing.T) {
+		_, err := Zip([]string{"a"}, []int{1}, LengthPolicy("PAD"))
+		assert.ErrorIs(t, err, ErrInvalidPolicy)
+	})
+
+	t.Run("Nil and Empty", func(t *testing.T) {
+		result, err := Zip[int, int](nil, nil, LengthError)
+		require.NoError(t, err)
+		assert.Nil(t, result)
+
+		result, err = Zip[int, int](nil, []int{1}, LengthTruncate)
+		require.NoError(t, err)
+		assert.Empty(t, result)
+	})
+}
+
+// TestZipWith tests the ZipWith function
+func TestZipWith(t *testing.T) {
+	add := func(x, y int) int { return x + y }
+
+	t.Run("Combines Elements", func(t *testing.T) {
+		result, err := ZipWith([]int{1, 2, 3}, []int{10, 20, 30}, add, LengthError)
+		require.NoError(t, err)
+		assert.Equal(t, []int{11, 22, 33}, result)
+	})
+
+	t.Run("Different Result Type", func(t *testing.T) {
+		result, err := ZipWith([]float64{1.5, 2}, []int{2, 3}, func(p float64, q int) float64 {
+			return p * float64(q)
+		}, LengthTruncate)
+		require.NoError(t, err)
+		assert.Equal(t, []float64{3, 6}, result)
+	})
+
+	t.Run("Length Mismatch Error", func(t *testing.T) {
+		_, err := ZipWith([]int{1, 2}, []int{1}, add, LengthError)
+		assert.ErrorIs(t, err, ErrLengthMismatch)
+		assert.Contains(t, err.Error(), "2 != 1")
+	})
+}
+
+// TestUnzip tests the Unzip function
+func TestUnzip(t *testing.T) {
+	t.Run("Splits Pairs", func(t *testing.T) {
+		names, ages := Unzip([]Pair[string, int]{{"Alice", 30}, {"Bob", 25}})
+		assert.Equal(t, []string{"Alice", "Bob"}, names)
+		assert.Equal(t, []int{30, 25}, ages)
+	})
+
+	t.Run("Round Trip", func(t *testing.T) {
+		a := []int{1, 2, 3}
+		b := []string{"x", "y", "z"}
+		pairs, err := Zip(a, b, LengthError)
+		require.NoError(t, err)
+
+		gotA, gotB := Unzip(pairs)
+		assert.Equal(t, a, gotA)
+		assert.Equal(t, b, gotB)
+	})
+
+	t.Run("Nil and Empty", func(t *testing.T) {
+		first, second := Unzip[int, int](nil)
+		assert.Nil(t, first)
+		assert.Nil(t, second)
+
+		first, second = Unzip([]Pair[int, int]{})
+		assert.Empty(t, first)
+		assert.Empty(t, second)
+	})
+}